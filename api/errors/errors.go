@@ -7,3 +7,27 @@ var ErrNilHTTPServer = errors.New("nil http server")
 
 // ErrNilFacadeHandler signals that a nil facade handler has been provided
 var ErrNilFacadeHandler = errors.New("nil facade handler")
+
+// ErrInvalidTLSConfig signals that an invalid TLS configuration has been provided
+var ErrInvalidTLSConfig = errors.New("invalid TLS config")
+
+// ErrObserverCertificateRequired signals that a request without a verified observer certificate has been received
+var ErrObserverCertificateRequired = errors.New("a verified observer client certificate is required")
+
+// ErrInvalidObserversAuthConfig signals that an invalid observers authentication configuration has been provided
+var ErrInvalidObserversAuthConfig = errors.New("invalid observers auth config")
+
+// ErrObserverUnauthorized signals that the observer could not be authenticated
+var ErrObserverUnauthorized = errors.New("observer is not authorized")
+
+// ErrServiceUnhealthy signals that a component required for liveness is unhealthy
+var ErrServiceUnhealthy = errors.New("service unhealthy")
+
+// ErrServiceNotReady signals that at least one component is unhealthy, so the service can not handle requests
+var ErrServiceNotReady = errors.New("service not ready")
+
+// ErrEmptyAdminCredentials signals that the admin endpoints were enabled without credentials
+var ErrEmptyAdminCredentials = errors.New("empty admin credentials")
+
+// ErrInvalidDispatcherID signals that an invalid dispatcher ID has been provided
+var ErrInvalidDispatcherID = errors.New("invalid dispatcher ID")
@@ -0,0 +1,128 @@
+package gin
+
+import (
+	"context"
+	"crypto/tls"
+	"os"
+	"sync"
+	"time"
+)
+
+type certificateReloader struct {
+	certFile       string
+	keyFile        string
+	reloadInterval time.Duration
+
+	mutCertificate sync.RWMutex
+	certificate    *tls.Certificate
+	certModTime    time.Time
+	keyModTime     time.Time
+	cancelFunc     func()
+}
+
+// newCertificateReloader loads the provided key pair and keeps watching the files for changes,
+// so that a renewed certificate is served without restarting the web server
+func newCertificateReloader(certFile string, keyFile string, reloadInterval time.Duration) (*certificateReloader, error) {
+	cr := &certificateReloader{
+		certFile:       certFile,
+		keyFile:        keyFile,
+		reloadInterval: reloadInterval,
+	}
+
+	err := cr.reload()
+	if err != nil {
+		return nil, err
+	}
+
+	var ctx context.Context
+	ctx, cr.cancelFunc = context.WithCancel(context.Background())
+	go cr.watch(ctx)
+
+	return cr, nil
+}
+
+func (cr *certificateReloader) watch(ctx context.Context) {
+	ticker := time.NewTicker(cr.reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("certificate reloader is stopping...")
+			return
+		case <-ticker.C:
+			if !cr.filesChanged() {
+				continue
+			}
+
+			err := cr.reload()
+			if err != nil {
+				log.Error("failed to reload TLS certificate, keeping the previous one", "err", err.Error())
+				continue
+			}
+
+			log.Info("reloaded TLS certificate", "cert file", cr.certFile)
+		}
+	}
+}
+
+func (cr *certificateReloader) filesChanged() bool {
+	certModTime, keyModTime, err := cr.getModTimes()
+	if err != nil {
+		log.Warn("could not check TLS certificate files", "err", err.Error())
+		return false
+	}
+
+	cr.mutCertificate.RLock()
+	defer cr.mutCertificate.RUnlock()
+
+	return !certModTime.Equal(cr.certModTime) || !keyModTime.Equal(cr.keyModTime)
+}
+
+func (cr *certificateReloader) reload() error {
+	certModTime, keyModTime, err := cr.getModTimes()
+	if err != nil {
+		return err
+	}
+
+	certificate, err := tls.LoadX509KeyPair(cr.certFile, cr.keyFile)
+	if err != nil {
+		return err
+	}
+
+	cr.mutCertificate.Lock()
+	cr.certificate = &certificate
+	cr.certModTime = certModTime
+	cr.keyModTime = keyModTime
+	cr.mutCertificate.Unlock()
+
+	return nil
+}
+
+func (cr *certificateReloader) getModTimes() (time.Time, time.Time, error) {
+	certInfo, err := os.Stat(cr.certFile)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	keyInfo, err := os.Stat(cr.keyFile)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	return certInfo.ModTime(), keyInfo.ModTime(), nil
+}
+
+// GetCertificate returns the currently loaded certificate, it is meant to be used as tls.Config.GetCertificate
+func (cr *certificateReloader) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	cr.mutCertificate.RLock()
+	defer cr.mutCertificate.RUnlock()
+
+	return cr.certificate, nil
+}
+
+// Close will stop watching the certificate files
+func (cr *certificateReloader) Close() {
+	if cr.cancelFunc != nil {
+		cr.cancelFunc()
+	}
+}
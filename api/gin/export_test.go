@@ -0,0 +1,26 @@
+package gin
+
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/multiversx/mx-chain-notifier-go/config"
+)
+
+// CertificateReloader -
+type CertificateReloader = certificateReloader
+
+// NewCertificateReloader -
+func NewCertificateReloader(certFile string, keyFile string, reloadInterval time.Duration) (*CertificateReloader, error) {
+	return newCertificateReloader(certFile, keyFile, reloadInterval)
+}
+
+// CreateServerTLSConfig -
+func CreateServerTLSConfig(cfg config.ServerTLSConfig, reloader *CertificateReloader) (*tls.Config, error) {
+	return createServerTLSConfig(cfg, reloader)
+}
+
+// GetCertificateReloadInterval -
+func GetCertificateReloadInterval(cfg config.ServerTLSConfig) time.Duration {
+	return getCertificateReloadInterval(cfg)
+}
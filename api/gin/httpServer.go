@@ -11,7 +11,8 @@ import (
 const contextTimeout = 5 * time.Second
 
 type httpServerWrapper struct {
-	server HTTPServerHandler
+	server  HTTPServerHandler
+	withTLS bool
 }
 
 // NewHTTPServerWrapper returns a new instance of httpServer
@@ -25,9 +26,26 @@ func NewHTTPServerWrapper(server HTTPServerHandler) (*httpServerWrapper, error)
 	}, nil
 }
 
+// NewHTTPSServerWrapper returns a new instance of httpServer which serves over TLS.
+// The certificates are expected to be provided by the server's TLS config
+func NewHTTPSServerWrapper(server HTTPServerHandler) (*httpServerWrapper, error) {
+	h, err := NewHTTPServerWrapper(server)
+	if err != nil {
+		return nil, err
+	}
+	h.withTLS = true
+
+	return h, nil
+}
+
 // Start will handle the starting of the gin web server
 func (h *httpServerWrapper) Start() {
-	err := h.server.ListenAndServe()
+	var err error
+	if h.withTLS {
+		err = h.server.ListenAndServeTLS("", "")
+	} else {
+		err = h.server.ListenAndServe()
+	}
 	if err != nil {
 		if err != http.ErrServerClosed {
 			log.Error("could not start webserver",
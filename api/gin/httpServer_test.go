@@ -51,3 +51,39 @@ func TestNewHTTPServer(t *testing.T) {
 		assert.True(t, shutdownWasCalled)
 	})
 }
+
+func TestNewHTTPSServerWrapper(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil http server", func(t *testing.T) {
+		t.Parallel()
+
+		server, err := gin.NewHTTPSServerWrapper(nil)
+		require.True(t, check.IfNil(server))
+		require.Equal(t, apiErrors.ErrNilHTTPServer, err)
+	})
+
+	t.Run("should serve over TLS", func(t *testing.T) {
+		t.Parallel()
+
+		listenAndServeTLSWasCalled := false
+		httpServer := &mocks.HTTPServerStub{
+			ListenAndServeCalled: func() error {
+				require.Fail(t, "should not serve without TLS")
+				return nil
+			},
+			ListenAndServeTLSCalled: func(certFile, keyFile string) error {
+				listenAndServeTLSWasCalled = true
+				assert.Empty(t, certFile)
+				assert.Empty(t, keyFile)
+				return nil
+			},
+		}
+
+		server, err := gin.NewHTTPSServerWrapper(httpServer)
+		require.Nil(t, err)
+
+		server.Start()
+		assert.True(t, listenAndServeTLSWasCalled)
+	})
+}
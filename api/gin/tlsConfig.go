@@ -0,0 +1,54 @@
+package gin
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	apiErrors "github.com/multiversx/mx-chain-notifier-go/api/errors"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+)
+
+const defaultCertificateReloadInterval = 30 * time.Second
+
+func createServerTLSConfig(cfg config.ServerTLSConfig, reloader *certificateReloader) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}
+
+	if cfg.ObserversCACertFile == "" {
+		if cfg.RequireObserverCertificate {
+			return nil, fmt.Errorf("%w: observers CA file is required for verifying observer certificates", apiErrors.ErrInvalidTLSConfig)
+		}
+
+		return tlsConfig, nil
+	}
+
+	caCert, err := ioutil.ReadFile(cfg.ObserversCACertFile)
+	if err != nil {
+		return nil, err
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("%w: no certificate found in %s", apiErrors.ErrInvalidTLSConfig, cfg.ObserversCACertFile)
+	}
+
+	// client certificates are verified only when presented, so websocket clients can connect
+	// without one; the events group decides whether a verified certificate is mandatory
+	tlsConfig.ClientCAs = certPool
+	tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+
+	return tlsConfig, nil
+}
+
+func getCertificateReloadInterval(cfg config.ServerTLSConfig) time.Duration {
+	if cfg.ReloadIntervalInSec == 0 {
+		return defaultCertificateReloadInterval
+	}
+
+	return time.Second * time.Duration(cfg.ReloadIntervalInSec)
+}
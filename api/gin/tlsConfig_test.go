@@ -0,0 +1,199 @@
+package gin_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	apiErrors "github.com/multiversx/mx-chain-notifier-go/api/errors"
+	"github.com/multiversx/mx-chain-notifier-go/api/gin"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestCertificate(t *testing.T, dir string, commonName string) (string, string) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.Nil(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	require.Nil(t, err)
+
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	err = ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes}), 0600)
+	require.Nil(t, err)
+	err = ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600)
+	require.Nil(t, err)
+
+	return certFile, keyFile
+}
+
+func getServedCommonName(t *testing.T, reloader *gin.CertificateReloader) string {
+	certificate, err := reloader.GetCertificate(nil)
+	require.Nil(t, err)
+
+	leaf, err := x509.ParseCertificate(certificate.Certificate[0])
+	require.Nil(t, err)
+
+	return leaf.Subject.CommonName
+}
+
+func TestNewCertificateReloader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing files should error", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		reloader, err := gin.NewCertificateReloader(filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem"), time.Second)
+		require.NotNil(t, err)
+		require.Nil(t, reloader)
+	})
+
+	t.Run("should reload renewed certificate", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		certFile, keyFile := writeTestCertificate(t, dir, "first")
+
+		reloader, err := gin.NewCertificateReloader(certFile, keyFile, 10*time.Millisecond)
+		require.Nil(t, err)
+		defer reloader.Close()
+
+		require.Equal(t, "first", getServedCommonName(t, reloader))
+
+		_, _ = writeTestCertificate(t, dir, "second")
+		future := time.Now().Add(time.Minute)
+		require.Nil(t, os.Chtimes(certFile, future, future))
+		require.Nil(t, os.Chtimes(keyFile, future, future))
+
+		require.Eventually(t, func() bool {
+			return getServedCommonName(t, reloader) == "second"
+		}, 2*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("invalid renewed certificate should keep the previous one", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		certFile, keyFile := writeTestCertificate(t, dir, "first")
+
+		reloader, err := gin.NewCertificateReloader(certFile, keyFile, 10*time.Millisecond)
+		require.Nil(t, err)
+		defer reloader.Close()
+
+		require.Nil(t, ioutil.WriteFile(certFile, []byte("invalid"), 0600))
+		future := time.Now().Add(time.Minute)
+		require.Nil(t, os.Chtimes(certFile, future, future))
+
+		time.Sleep(100 * time.Millisecond)
+		require.Equal(t, "first", getServedCommonName(t, reloader))
+	})
+}
+
+func TestCreateServerTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("required observer certificate without CA should error", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		certFile, keyFile := writeTestCertificate(t, dir, "notifier")
+		reloader, err := gin.NewCertificateReloader(certFile, keyFile, time.Second)
+		require.Nil(t, err)
+		defer reloader.Close()
+
+		cfg := config.ServerTLSConfig{
+			Enabled:                    true,
+			RequireObserverCertificate: true,
+		}
+		tlsConfig, err := gin.CreateServerTLSConfig(cfg, reloader)
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidTLSConfig))
+		require.Nil(t, tlsConfig)
+	})
+
+	t.Run("invalid CA file should error", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		certFile, keyFile := writeTestCertificate(t, dir, "notifier")
+		reloader, err := gin.NewCertificateReloader(certFile, keyFile, time.Second)
+		require.Nil(t, err)
+		defer reloader.Close()
+
+		caFile := filepath.Join(dir, "ca.pem")
+		require.Nil(t, ioutil.WriteFile(caFile, []byte("invalid"), 0600))
+
+		cfg := config.ServerTLSConfig{
+			Enabled:             true,
+			ObserversCACertFile: caFile,
+		}
+		tlsConfig, err := gin.CreateServerTLSConfig(cfg, reloader)
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidTLSConfig))
+		require.Nil(t, tlsConfig)
+	})
+
+	t.Run("without CA should not verify client certificates", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		certFile, keyFile := writeTestCertificate(t, dir, "notifier")
+		reloader, err := gin.NewCertificateReloader(certFile, keyFile, time.Second)
+		require.Nil(t, err)
+		defer reloader.Close()
+
+		tlsConfig, err := gin.CreateServerTLSConfig(config.ServerTLSConfig{Enabled: true}, reloader)
+		require.Nil(t, err)
+		require.Equal(t, uint16(tls.VersionTLS12), tlsConfig.MinVersion)
+		require.Equal(t, tls.NoClientCert, tlsConfig.ClientAuth)
+		require.NotNil(t, tlsConfig.GetCertificate)
+	})
+
+	t.Run("with CA should verify client certificates if given", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		certFile, keyFile := writeTestCertificate(t, dir, "notifier")
+		reloader, err := gin.NewCertificateReloader(certFile, keyFile, time.Second)
+		require.Nil(t, err)
+		defer reloader.Close()
+
+		cfg := config.ServerTLSConfig{
+			Enabled:                    true,
+			ObserversCACertFile:        certFile,
+			RequireObserverCertificate: true,
+		}
+		tlsConfig, err := gin.CreateServerTLSConfig(cfg, reloader)
+		require.Nil(t, err)
+		require.Equal(t, tls.VerifyClientCertIfGiven, tlsConfig.ClientAuth)
+		require.NotNil(t, tlsConfig.ClientCAs)
+	})
+}
+
+func TestGetCertificateReloadInterval(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 30*time.Second, gin.GetCertificateReloadInterval(config.ServerTLSConfig{}))
+	require.Equal(t, 5*time.Second, gin.GetCertificateReloadInterval(config.ServerTLSConfig{ReloadIntervalInSec: 5}))
+}
@@ -38,6 +38,7 @@ type webServer struct {
 	groups       map[string]shared.GroupHandler
 	wasTriggered bool
 	cancelFunc   func()
+	certReloader *certificateReloader
 }
 
 // NewWebServerHandler creates and configures an instance of webServer
@@ -115,7 +116,7 @@ func (w *webServer) Run() error {
 		Handler: engine,
 	}
 
-	w.httpServer, err = NewHTTPServerWrapper(server)
+	w.httpServer, err = w.createHTTPServer(server)
 	if err != nil {
 		return err
 	}
@@ -127,6 +128,29 @@ func (w *webServer) Run() error {
 	return nil
 }
 
+func (w *webServer) createHTTPServer(server *http.Server) (shared.HTTPServerCloser, error) {
+	tlsCfg := w.configs.GeneralConfig.ConnectorApi.TLS
+	if !tlsCfg.Enabled {
+		return NewHTTPServerWrapper(server)
+	}
+
+	certReloader, err := newCertificateReloader(tlsCfg.CertFile, tlsCfg.KeyFile, getCertificateReloadInterval(tlsCfg))
+	if err != nil {
+		return nil, err
+	}
+
+	server.TLSConfig, err = createServerTLSConfig(tlsCfg, certReloader)
+	if err != nil {
+		certReloader.Close()
+		return nil, err
+	}
+	w.certReloader = certReloader
+
+	log.Info("web server will serve over TLS", "require observer certificate", tlsCfg.RequireObserverCertificate)
+
+	return NewHTTPSServerWrapper(server)
+}
+
 func (w *webServer) createGroups() error {
 	groupsMap := make(map[string]shared.GroupHandler)
 
@@ -148,6 +172,15 @@ func (w *webServer) createGroups() error {
 			return err
 		}
 		groupsMap["hub"] = hubHandler
+
+		adminCfg := w.configs.GeneralConfig.ConnectorApi.Admin
+		if adminCfg.Username != "" && adminCfg.Password != "" {
+			adminGroup, err := groups.NewAdminGroup(w.facade)
+			if err != nil {
+				return err
+			}
+			groupsMap["admin"] = adminGroup
+		}
 	}
 
 	w.groups = groupsMap
@@ -173,6 +206,9 @@ func (w *webServer) Close() error {
 
 	w.Lock()
 	err := w.httpServer.Close()
+	if w.certReloader != nil {
+		w.certReloader.Close()
+	}
 	w.Unlock()
 
 	if err != nil {
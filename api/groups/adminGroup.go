@@ -0,0 +1,133 @@
+package groups
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/api/errors"
+	"github.com/multiversx/mx-chain-notifier-go/api/shared"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+)
+
+const (
+	dispatchersPath        = "/dispatchers"
+	dispatcherPath         = "/dispatchers/:id"
+	subscriptionsStatsPath = "/subscriptions/stats"
+
+	dispatcherIDParam = "id"
+)
+
+type adminGroup struct {
+	*baseGroup
+	facade AdminFacadeHandler
+}
+
+// NewAdminGroup registers handlers for the /admin group
+// All its endpoints require basic authentication, regardless of the api.toml "Auth" flag
+func NewAdminGroup(facade AdminFacadeHandler) (*adminGroup, error) {
+	if check.IfNil(facade) {
+		return nil, fmt.Errorf("%w for admin group", errors.ErrNilFacadeHandler)
+	}
+
+	user, pass := facade.GetAdminUserAndPass()
+	if user == "" || pass == "" {
+		return nil, errors.ErrEmptyAdminCredentials
+	}
+
+	ag := &adminGroup{
+		facade:    facade,
+		baseGroup: newBaseGroup(),
+	}
+	ag.additionalMiddlewares = append(ag.additionalMiddlewares, gin.BasicAuth(gin.Accounts{
+		user: pass,
+	}))
+
+	endpoints := []*shared.EndpointHandlerData{
+		{
+			Path:    dispatchersPath,
+			Handler: ag.getDispatchers,
+			Method:  http.MethodGet,
+		},
+		{
+			Path:    dispatcherPath,
+			Handler: ag.getDispatcher,
+			Method:  http.MethodGet,
+		},
+		{
+			Path:    dispatcherPath,
+			Handler: ag.disconnectDispatcher,
+			Method:  http.MethodDelete,
+		},
+		{
+			Path:    subscriptionsStatsPath,
+			Handler: ag.getSubscriptionsStats,
+			Method:  http.MethodGet,
+		},
+	}
+	ag.endpoints = endpoints
+
+	return ag, nil
+}
+
+// getDispatchers will expose the connected websocket dispatchers, together with their subscriptions
+func (ag *adminGroup) getDispatchers(c *gin.Context) {
+	dispatchers := ag.facade.GetDispatchers()
+
+	shared.JSONResponse(c, http.StatusOK, gin.H{"dispatchers": dispatchers}, "")
+}
+
+// getDispatcher will expose a single websocket dispatcher, together with its subscriptions
+func (ag *adminGroup) getDispatcher(c *gin.Context) {
+	dispatcherID, err := uuid.Parse(c.Param(dispatcherIDParam))
+	if err != nil {
+		shared.JSONResponse(c, http.StatusBadRequest, nil, fmt.Sprintf("%s: %s", errors.ErrInvalidDispatcherID.Error(), err.Error()))
+		return
+	}
+
+	for _, dispatcherInfo := range ag.facade.GetDispatchers() {
+		if dispatcherInfo.ID == dispatcherID {
+			shared.JSONResponse(c, http.StatusOK, gin.H{"dispatcher": dispatcherInfo}, "")
+			return
+		}
+	}
+
+	shared.JSONResponse(c, http.StatusNotFound, nil, common.ErrDispatcherNotFound.Error())
+}
+
+// disconnectDispatcher will force the disconnection of a websocket dispatcher
+func (ag *adminGroup) disconnectDispatcher(c *gin.Context) {
+	dispatcherID, err := uuid.Parse(c.Param(dispatcherIDParam))
+	if err != nil {
+		shared.JSONResponse(c, http.StatusBadRequest, nil, fmt.Sprintf("%s: %s", errors.ErrInvalidDispatcherID.Error(), err.Error()))
+		return
+	}
+
+	err = ag.facade.DisconnectDispatcher(dispatcherID)
+	if err == common.ErrDispatcherNotFound {
+		shared.JSONResponse(c, http.StatusNotFound, nil, err.Error())
+		return
+	}
+	if err != nil {
+		shared.JSONResponse(c, http.StatusInternalServerError, nil, err.Error())
+		return
+	}
+
+	log.Info("dispatcher disconnected by admin", "dispatcherID", dispatcherID, "remote address", c.ClientIP())
+
+	shared.JSONResponse(c, http.StatusOK, nil, "")
+}
+
+// getSubscriptionsStats will expose the subscriptions aggregated by event type and by match level
+func (ag *adminGroup) getSubscriptionsStats(c *gin.Context) {
+	stats := ag.facade.GetSubscriptionsStats()
+
+	shared.JSONResponse(c, http.StatusOK, gin.H{"stats": stats}, "")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ag *adminGroup) IsInterfaceNil() bool {
+	return ag == nil
+}
@@ -0,0 +1,266 @@
+package groups_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	apiErrors "github.com/multiversx/mx-chain-notifier-go/api/errors"
+	"github.com/multiversx/mx-chain-notifier-go/api/groups"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	adminPath = "/admin"
+	adminUser = "admin"
+	adminPass = "pass"
+)
+
+type dispatchersResponse struct {
+	Data struct {
+		Dispatchers []data.DispatcherInfo `json:"dispatchers"`
+		Dispatcher  data.DispatcherInfo   `json:"dispatcher"`
+	}
+	Error string `json:"error"`
+}
+
+type subscriptionsStatsResponse struct {
+	Data struct {
+		Stats data.SubscriptionsStats `json:"stats"`
+	}
+	Error string `json:"error"`
+}
+
+func createAdminFacadeStub() *mocks.FacadeStub {
+	return &mocks.FacadeStub{
+		GetAdminUserAndPassCalled: func() (string, string) {
+			return adminUser, adminPass
+		},
+	}
+}
+
+func getAdminRoutesConfig() config.APIRoutesConfig {
+	return config.APIRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"admin": {
+				Routes: []config.RouteConfig{
+					{Name: "/dispatchers", Open: true},
+					{Name: "/dispatchers/:id", Open: true},
+					{Name: "/subscriptions/stats", Open: true},
+				},
+			},
+		},
+	}
+}
+
+func newAdminRequest(method string, path string) *http.Request {
+	req, _ := http.NewRequest(method, path, nil)
+	req.SetBasicAuth(adminUser, adminPass)
+
+	return req
+}
+
+func TestNewAdminGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		ag, err := groups.NewAdminGroup(nil)
+
+		require.True(t, errors.Is(err, apiErrors.ErrNilFacadeHandler))
+		require.True(t, check.IfNil(ag))
+	})
+
+	t.Run("empty credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		ag, err := groups.NewAdminGroup(&mocks.FacadeStub{})
+
+		require.Equal(t, apiErrors.ErrEmptyAdminCredentials, err)
+		require.True(t, check.IfNil(ag))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ag, err := groups.NewAdminGroup(createAdminFacadeStub())
+
+		require.Nil(t, err)
+		require.False(t, check.IfNil(ag))
+	})
+}
+
+func TestAdminGroup_Authentication(t *testing.T) {
+	t.Parallel()
+
+	ag, err := groups.NewAdminGroup(createAdminFacadeStub())
+	require.Nil(t, err)
+
+	ws := startWebServer(ag, adminPath, getAdminRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/admin/dispatchers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	req, _ = http.NewRequest("GET", "/admin/dispatchers", nil)
+	req.SetBasicAuth(adminUser, "wrong")
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+	assert.Equal(t, http.StatusUnauthorized, resp.Code)
+
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("GET", "/admin/dispatchers"))
+	assert.Equal(t, http.StatusOK, resp.Code)
+}
+
+func TestAdminGroup_GetDispatchers(t *testing.T) {
+	t.Parallel()
+
+	dispatcherID := uuid.New()
+	expectedDispatchers := []data.DispatcherInfo{
+		{
+			ID:            dispatcherID,
+			RemoteAddress: "127.0.0.1:1234",
+			ConnectedAt:   1700000000,
+			QueueDepth:    2,
+			MessagesSent:  10,
+			Subscriptions: []data.Subscription{
+				{
+					Identifier:   "swap",
+					MatchLevel:   "match:identifier",
+					EventType:    common.PushLogsAndEvents,
+					DispatcherID: dispatcherID,
+				},
+			},
+		},
+	}
+	facade := createAdminFacadeStub()
+	facade.GetDispatchersCalled = func() []data.DispatcherInfo {
+		return expectedDispatchers
+	}
+
+	ag, err := groups.NewAdminGroup(facade)
+	require.Nil(t, err)
+
+	ws := startWebServer(ag, adminPath, getAdminRoutesConfig())
+
+	t.Run("all dispatchers", func(t *testing.T) {
+		t.Parallel()
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, newAdminRequest("GET", "/admin/dispatchers"))
+
+		var apiResp dispatchersResponse
+		loadResponse(resp.Body, &apiResp)
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedDispatchers, apiResp.Data.Dispatchers)
+	})
+
+	t.Run("single dispatcher", func(t *testing.T) {
+		t.Parallel()
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, newAdminRequest("GET", "/admin/dispatchers/"+dispatcherID.String()))
+
+		var apiResp dispatchersResponse
+		loadResponse(resp.Body, &apiResp)
+		require.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedDispatchers[0], apiResp.Data.Dispatcher)
+	})
+
+	t.Run("unknown dispatcher", func(t *testing.T) {
+		t.Parallel()
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, newAdminRequest("GET", "/admin/dispatchers/"+uuid.New().String()))
+
+		var apiResp dispatchersResponse
+		loadResponse(resp.Body, &apiResp)
+		require.Equal(t, http.StatusNotFound, resp.Code)
+		assert.Equal(t, common.ErrDispatcherNotFound.Error(), apiResp.Error)
+	})
+
+	t.Run("invalid dispatcher ID", func(t *testing.T) {
+		t.Parallel()
+
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, newAdminRequest("GET", "/admin/dispatchers/invalid"))
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+}
+
+func TestAdminGroup_DisconnectDispatcher(t *testing.T) {
+	t.Parallel()
+
+	dispatcherID := uuid.New()
+	facade := createAdminFacadeStub()
+	facade.DisconnectDispatcherCalled = func(id uuid.UUID) error {
+		if id == dispatcherID {
+			return nil
+		}
+
+		return common.ErrDispatcherNotFound
+	}
+
+	ag, err := groups.NewAdminGroup(facade)
+	require.Nil(t, err)
+
+	ws := startWebServer(ag, adminPath, getAdminRoutesConfig())
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("DELETE", "/admin/dispatchers/"+dispatcherID.String()))
+	assert.Equal(t, http.StatusOK, resp.Code)
+
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("DELETE", "/admin/dispatchers/"+uuid.New().String()))
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+
+	resp = httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("DELETE", "/admin/dispatchers/invalid"))
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
+
+func TestAdminGroup_GetSubscriptionsStats(t *testing.T) {
+	t.Parallel()
+
+	expectedStats := data.SubscriptionsStats{
+		NumDispatchers:   2,
+		NumSubscriptions: 3,
+		ByEventType: map[string]int{
+			common.PushLogsAndEvents: 2,
+			common.BlockTxs:          1,
+		},
+		ByMatchLevel: map[string]int{
+			"*":                1,
+			"match:identifier": 2,
+		},
+	}
+	facade := createAdminFacadeStub()
+	facade.GetSubscriptionsStatsCalled = func() data.SubscriptionsStats {
+		return expectedStats
+	}
+
+	ag, err := groups.NewAdminGroup(facade)
+	require.Nil(t, err)
+
+	ws := startWebServer(ag, adminPath, getAdminRoutesConfig())
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, newAdminRequest("GET", "/admin/subscriptions/stats"))
+
+	var apiResp subscriptionsStatsResponse
+	loadResponse(resp.Body, &apiResp)
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, expectedStats, apiResp.Data.Stats)
+}
@@ -13,12 +13,14 @@ var log = logger.GetOrCreate("api/groups")
 
 type baseGroup struct {
 	endpoints             []*shared.EndpointHandlerData
+	preAuthMiddlewares    []gin.HandlerFunc
 	additionalMiddlewares []gin.HandlerFunc
 	authMiddleware        gin.HandlerFunc
 }
 
 func newBaseGroup() *baseGroup {
 	return &baseGroup{
+		preAuthMiddlewares:    make([]gin.HandlerFunc, 0),
 		additionalMiddlewares: make([]gin.HandlerFunc, 0),
 		authMiddleware:        func(ctx *gin.Context) {},
 	}
@@ -37,6 +39,7 @@ func (bg *baseGroup) RegisterRoutes(
 		}
 
 		handlers := make([]gin.HandlerFunc, 0)
+		handlers = append(handlers, bg.GetPreAuthMiddlewares()...)
 
 		if isAuthEnabled {
 			handlers = append(handlers, bg.GetAuthMiddleware())
@@ -49,6 +52,11 @@ func (bg *baseGroup) RegisterRoutes(
 	}
 }
 
+// GetPreAuthMiddlewares returns the middlewares which run before the auth middleware
+func (bg *baseGroup) GetPreAuthMiddlewares() []gin.HandlerFunc {
+	return bg.preAuthMiddlewares
+}
+
 // GetAdditionalMiddlewares returns additional middlewares
 func (bg *baseGroup) GetAdditionalMiddlewares() []gin.HandlerFunc {
 	return bg.additionalMiddlewares
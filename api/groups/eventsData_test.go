@@ -5,10 +5,8 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/block"
-	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
-	coreData "github.com/multiversx/mx-chain-core-go/websocketOutportDriver/data"
 	"github.com/multiversx/mx-chain-notifier-go/api/groups"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/stretchr/testify/require"
@@ -101,9 +99,9 @@ func TestGetHeader(t *testing.T) {
 			ScheduledGasPenalized: 1,
 			ScheduledGasRefunded:  1,
 		}
-		saveBlockData := &coreData.ArgsSaveBlock{
+		saveBlockData := &data.ArgsSaveBlock{
 			HeaderType: "HeaderV2",
-			ArgsSaveBlockData: outport.ArgsSaveBlockData{
+			ArgsSaveBlockData: data.ArgsSaveBlockData{
 				Header: header,
 			},
 		}
@@ -122,9 +120,9 @@ func TestGetHeader(t *testing.T) {
 			ShardID:   1,
 			TimeStamp: 1,
 		}
-		saveBlockData := &coreData.ArgsSaveBlock{
+		saveBlockData := &data.ArgsSaveBlock{
 			HeaderType: "Header",
-			ArgsSaveBlockData: outport.ArgsSaveBlockData{
+			ArgsSaveBlockData: data.ArgsSaveBlockData{
 				Header: header,
 			},
 		}
@@ -142,9 +140,9 @@ func TestGetHeader(t *testing.T) {
 			Nonce:     2,
 			TimeStamp: 2,
 		}
-		saveBlockData := &coreData.ArgsSaveBlock{
+		saveBlockData := &data.ArgsSaveBlock{
 			HeaderType: "MetaBlock",
-			ArgsSaveBlockData: outport.ArgsSaveBlockData{
+			ArgsSaveBlockData: data.ArgsSaveBlockData{
 				Header: header,
 			},
 		}
@@ -1,20 +1,31 @@
 package groups
 
 import (
+	"context"
+	"encoding/hex"
+	goErrors "errors"
 	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-notifier-go/api/errors"
 	"github.com/multiversx/mx-chain-notifier-go/api/shared"
+	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/decoders"
+	"github.com/multiversx/mx-chain-notifier-go/tracing"
 )
 
 const (
 	pushEventsEndpoint      = "/push"
 	revertEventsEndpoint    = "/revert"
 	finalizedEventsEndpoint = "/finalized"
+
+	contentEncodingHeader = "Content-Encoding"
 )
 
 type eventsGroup struct {
@@ -33,7 +44,10 @@ func NewEventsGroup(facade EventsFacadeHandler) (*eventsGroup, error) {
 		baseGroup: newBaseGroup(),
 	}
 
-	h.createMiddlewares()
+	err := h.createMiddlewares()
+	if err != nil {
+		return nil, err
+	}
 
 	endpoints := []*shared.EndpointHandlerData{
 		{
@@ -59,11 +73,9 @@ func NewEventsGroup(facade EventsFacadeHandler) (*eventsGroup, error) {
 }
 
 func (h *eventsGroup) pushEvents(c *gin.Context) {
-	pushEventsRawData, err := c.GetRawData()
-	if err != nil {
-		shared.JSONResponse(c, http.StatusBadRequest, nil, err.Error())
-		return
-	}
+	ctx := tracing.ExtractContextFromHeaders(c.Request.Context(), c.Request.Header)
+	ctx, span := tracing.StartSpan(ctx, "eventsGroup.pushEvents", "")
+	defer span.End()
 
 	// blockEvents, err := UnmarshallBlockDataV1(pushEventsRawData)
 	// if err == nil {
@@ -74,22 +86,36 @@ func (h *eventsGroup) pushEvents(c *gin.Context) {
 	// 	}
 	// }
 
-	err = h.pushEventsV2(pushEventsRawData)
+	observerID := c.GetString(ObserverIDContextKey)
+	if h.facade.IsIngestionQueueEnabled() {
+		err := h.enqueuePushEventsV2(ctx, c, observerID)
+		if err != nil {
+			tracing.RecordError(span, err)
+			h.respondPushError(c, err, observerID)
+			return
+		}
+
+		shared.JSONResponse(c, http.StatusOK, nil, "")
+		return
+	}
+
+	err := h.pushEventsV2(ctx, c, observerID)
 	if err != nil {
-		shared.JSONResponse(c, http.StatusBadRequest, nil, err.Error())
+		tracing.RecordError(span, err)
+		h.respondPushError(c, err, observerID)
 		return
 	}
 
 	shared.JSONResponse(c, http.StatusOK, nil, "")
 }
 
-func (h *eventsGroup) pushEventsV2(pushEventsRawData []byte) error {
-	saveBlockData, err := UnmarshallBlockDataV2(pushEventsRawData)
+func (h *eventsGroup) pushEventsV2(ctx context.Context, c *gin.Context, observerID string) error {
+	saveBlockData, err := h.decodePushedBlock(ctx, c)
 	if err != nil {
 		return err
 	}
 
-	err = h.facade.HandlePushEventsV2(*saveBlockData)
+	err = h.facade.HandlePushEventsV2(ctx, *saveBlockData, observerID)
 	if err != nil {
 		return err
 	}
@@ -97,12 +123,93 @@ func (h *eventsGroup) pushEventsV2(pushEventsRawData []byte) error {
 	return nil
 }
 
+func (h *eventsGroup) enqueuePushEventsV2(ctx context.Context, c *gin.Context, observerID string) error {
+	saveBlockData, err := h.decodePushedBlock(ctx, c)
+	if err != nil {
+		return err
+	}
+
+	return h.facade.EnqueuePushEventsV2(ctx, *saveBlockData, observerID)
+}
+
+// decodePushedBlock decodes the pushed block, then checks the signature of the body, if signed. The signature
+// is checked even if the block could not be decoded, so that a forged push is not counted as an observer error
+func (h *eventsGroup) decodePushedBlock(ctx context.Context, c *gin.Context) (*data.ArgsSaveBlockData, error) {
+	saveBlockData, decodeErr := h.unmarshallBlockData(ctx, c.Request.Body, c.ContentType())
+	err := verifySignedBody(c)
+	if err != nil {
+		return nil, err
+	}
+	if decodeErr != nil {
+		return nil, decodeErr
+	}
+
+	return saveBlockData, nil
+}
+
+// respondPushError asks the observer to retry later if the block could not be handled because of
+// backpressure, otherwise the push is either unauthorized or its data is invalid
+func (h *eventsGroup) respondPushError(c *gin.Context, err error, observerID string) {
+	log.Debug("failed to push events", "observer", observerID, "err", err.Error())
+
+	switch {
+	case goErrors.Is(err, common.ErrIngestionQueueFull):
+		c.Header("Retry-After", strconv.Itoa(common.IngestionQueueFullRetryAfterInSec))
+		shared.JSONResponse(c, http.StatusServiceUnavailable, nil, err.Error())
+	case goErrors.Is(err, common.ErrNotifierDraining):
+		c.Header("Retry-After", strconv.Itoa(common.DrainingRetryAfterInSec))
+		shared.JSONResponse(c, http.StatusServiceUnavailable, nil, err.Error())
+	case goErrors.Is(err, context.Canceled), goErrors.Is(err, context.DeadlineExceeded):
+		// the request was canceled while its block was waiting in the queue, the block is still processed
+		shared.JSONResponse(c, http.StatusServiceUnavailable, nil, err.Error())
+	case goErrors.Is(err, errors.ErrObserverUnauthorized):
+		shared.JSONResponse(c, http.StatusUnauthorized, nil, errors.ErrObserverUnauthorized.Error())
+	default:
+		h.facade.RecordObserverError(observerID)
+		shared.JSONResponse(c, getRequestErrorStatus(err), nil, err.Error())
+	}
+}
+
+// getRequestErrorStatus returns the status code for a request body which could not be read, verified or decoded
+func getRequestErrorStatus(err error) int {
+	if goErrors.Is(err, decoders.ErrBodyTooLarge) {
+		return http.StatusRequestEntityTooLarge
+	}
+	if goErrors.Is(err, errors.ErrObserverUnauthorized) {
+		return http.StatusUnauthorized
+	}
+
+	return http.StatusBadRequest
+}
+
+// unmarshallBlockData decodes the pushed block while reading it, in any of the formats supported by the decoders
+func (h *eventsGroup) unmarshallBlockData(ctx context.Context, body io.Reader, contentType string) (*data.ArgsSaveBlockData, error) {
+	_, span := tracing.StartSpan(ctx, "DecodeBlockData", "")
+	defer span.End()
+
+	startTime := time.Now()
+	saveBlockData, err := decoders.DecodeBlockDataFromReader(body, contentType)
+	h.facade.ObserveStage(common.UnmarshalStage, time.Since(startTime))
+	if err != nil {
+		tracing.RecordError(span, err)
+		h.facade.AddStageError(common.UnmarshalStage)
+		return nil, err
+	}
+	tracing.SetBlockHash(span, hex.EncodeToString(saveBlockData.HeaderHash))
+
+	return saveBlockData, nil
+}
+
 func (h *eventsGroup) revertEvents(c *gin.Context) {
 	var revertBlock data.RevertBlock
 
-	err := c.Bind(&revertBlock)
+	bindErr := c.ShouldBind(&revertBlock)
+	err := verifySignedBody(c)
+	if err == nil {
+		err = bindErr
+	}
 	if err != nil {
-		shared.JSONResponse(c, http.StatusBadRequest, nil, err.Error())
+		shared.JSONResponse(c, getRequestErrorStatus(err), nil, err.Error())
 		return
 	}
 
@@ -114,9 +221,13 @@ func (h *eventsGroup) revertEvents(c *gin.Context) {
 func (h *eventsGroup) finalizedEvents(c *gin.Context) {
 	var finalizedBlock data.FinalizedBlock
 
-	err := c.Bind(&finalizedBlock)
+	bindErr := c.ShouldBind(&finalizedBlock)
+	err := verifySignedBody(c)
+	if err == nil {
+		err = bindErr
+	}
 	if err != nil {
-		shared.JSONResponse(c, http.StatusBadRequest, nil, err.Error())
+		shared.JSONResponse(c, getRequestErrorStatus(err), nil, err.Error())
 		return
 	}
 
@@ -125,15 +236,96 @@ func (h *eventsGroup) finalizedEvents(c *gin.Context) {
 	shared.JSONResponse(c, http.StatusOK, nil, "")
 }
 
-func (h *eventsGroup) createMiddlewares() {
+func (h *eventsGroup) createMiddlewares() error {
 	user, pass := h.facade.GetConnectorUserAndPass()
+	observersAuthConfig := h.facade.GetObserversAuthConfig()
+
+	if len(observersAuthConfig.Observers) > 0 {
+		if user != "" || pass != "" {
+			log.Warn("per-observer credentials are configured, the shared Username and Password are ignored")
+		}
 
-	if user != "" && pass != "" {
+		authenticator, err := newObserverAuthenticator(h.facade, observersAuthConfig)
+		if err != nil {
+			return err
+		}
+		h.authMiddleware = authenticator.middleware
+	} else if user != "" && pass != "" {
 		basicAuth := gin.BasicAuth(gin.Accounts{
 			user: pass,
 		})
 		h.authMiddleware = basicAuth
 	}
+
+	// the checks which only need the headers run before the auth, which reads the signed body
+	if h.facade.IsObserverCertificateRequired() {
+		h.preAuthMiddlewares = append(h.preAuthMiddlewares, observerCertificateMiddleware)
+	}
+	h.preAuthMiddlewares = append(h.preAuthMiddlewares, h.drainingMiddleware, h.contentLengthMiddleware)
+	h.additionalMiddlewares = append(h.additionalMiddlewares, h.bodyReaderMiddleware)
+
+	return nil
+}
+
+// drainingMiddleware keeps track of the in-flight pushes and rejects the new ones while the notifier is
+// draining, so that the observers retry them on another instance
+func (h *eventsGroup) drainingMiddleware(c *gin.Context) {
+	if !h.facade.BeginPush() {
+		c.Header("Retry-After", strconv.Itoa(common.DrainingRetryAfterInSec))
+		shared.JSONResponse(c, http.StatusServiceUnavailable, nil, common.ErrNotifierDraining.Error())
+		c.Abort()
+		return
+	}
+	defer h.facade.EndPush()
+
+	c.Next()
+}
+
+// contentLengthMiddleware rejects the pushes which declare a body above the maximum size before reading it
+func (h *eventsGroup) contentLengthMiddleware(c *gin.Context) {
+	maxBodySize := h.facade.GetMaxBodySize()
+	if maxBodySize > 0 && c.Request.ContentLength > maxBodySize {
+		err := fmt.Errorf("%w: %d bytes, maximum allowed is %d bytes", decoders.ErrBodyTooLarge, c.Request.ContentLength, maxBodySize)
+		shared.JSONResponse(c, http.StatusRequestEntityTooLarge, nil, err.Error())
+		c.Abort()
+		return
+	}
+
+	c.Next()
+}
+
+// bodyReaderMiddleware decompresses the pushed data and bounds its size, so that the handlers decode the
+// body while reading it, without buffering the raw payload
+func (h *eventsGroup) bodyReaderMiddleware(c *gin.Context) {
+	maxBodySize := h.facade.GetMaxBodySize()
+	bodyReader, err := decoders.NewBodyReader(c.Request.Body, c.GetHeader(contentEncodingHeader), maxBodySize)
+	if err != nil {
+		status := http.StatusBadRequest
+		if goErrors.Is(err, decoders.ErrUnsupportedContentEncoding) {
+			status = http.StatusUnsupportedMediaType
+		}
+		shared.JSONResponse(c, status, nil, err.Error())
+		c.Abort()
+		return
+	}
+	defer func() {
+		_ = bodyReader.Close()
+	}()
+
+	c.Request.Body = bodyReader
+	c.Request.Header.Del(contentEncodingHeader)
+
+	c.Next()
+}
+
+func observerCertificateMiddleware(c *gin.Context) {
+	if c.Request.TLS != nil && len(c.Request.TLS.VerifiedChains) > 0 {
+		c.Next()
+		return
+	}
+
+	shared.JSONResponse(c, http.StatusUnauthorized, nil, errors.ErrObserverCertificateRequired.Error())
+	c.Abort()
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -2,11 +2,16 @@ package groups_test
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/block"
@@ -42,7 +47,8 @@ func TestNewEventsGroup(t *testing.T) {
 		require.NoError(t, err)
 		require.NotNil(t, eg)
 
-		require.Equal(t, 0, len(eg.GetAdditionalMiddlewares()))
+		require.Equal(t, 2, len(eg.GetPreAuthMiddlewares()))
+		require.Equal(t, 1, len(eg.GetAdditionalMiddlewares()))
 	})
 
 	t.Run("with basic auth middleware, should work", func(t *testing.T) {
@@ -60,13 +66,161 @@ func TestNewEventsGroup(t *testing.T) {
 	})
 }
 
+func TestEventsGroup_ObserverCertificateMiddleware(t *testing.T) {
+	t.Parallel()
+
+	finalizedBlockEvents := data.FinalizedBlock{
+		Hash: "hash1",
+	}
+	jsonBytes, _ := json.Marshal(finalizedBlockEvents)
+
+	t.Run("without verified certificate, should be unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mocks.FacadeStub{
+			IsObserverCertificateRequiredCalled: func() bool {
+				return true
+			},
+			HandleFinalizedEventsCalled: func(events data.FinalizedBlock) {
+				assert.Fail(t, "should not have been called")
+			},
+		}
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+		require.Equal(t, 3, len(eg.GetPreAuthMiddlewares()))
+
+		ws := startWebServer(eg, eventsPath, getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/events/finalized", bytes.NewBuffer(jsonBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.TLS = &tls.ConnectionState{}
+		resp := httptest.NewRecorder()
+
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+
+	t.Run("with verified certificate, should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mocks.FacadeStub{
+			IsObserverCertificateRequiredCalled: func() bool {
+				return true
+			},
+			HandleFinalizedEventsCalled: func(events data.FinalizedBlock) {
+				wasCalled = true
+			},
+		}
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+
+		ws := startWebServer(eg, eventsPath, getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/events/finalized", bytes.NewBuffer(jsonBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.TLS = &tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{&x509.Certificate{}}},
+		}
+		resp := httptest.NewRecorder()
+
+		ws.ServeHTTP(resp, req)
+
+		assert.True(t, wasCalled)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+}
+
+func TestEventsGroup_DrainingMiddleware(t *testing.T) {
+	t.Parallel()
+
+	finalizedBlockEvents := data.FinalizedBlock{
+		Hash: "hash1",
+	}
+	jsonBytes, _ := json.Marshal(finalizedBlockEvents)
+
+	t.Run("while draining, should be unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mocks.FacadeStub{
+			BeginPushCalled: func() bool {
+				return false
+			},
+			EndPushCalled: func() {
+				assert.Fail(t, "should not have been called")
+			},
+			HandleFinalizedEventsCalled: func(events data.FinalizedBlock) {
+				assert.Fail(t, "should not have been called")
+			},
+		}
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+
+		ws := startWebServer(eg, eventsPath, getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/events/finalized", bytes.NewBuffer(jsonBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp := httptest.NewRecorder()
+
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+		assert.Equal(t, "30", resp.Header().Get("Retry-After"))
+	})
+
+	t.Run("not draining, should track the push", func(t *testing.T) {
+		t.Parallel()
+
+		numBeginPushCalls, numEndPushCalls := 0, 0
+		wasCalled := false
+		facade := &mocks.FacadeStub{
+			BeginPushCalled: func() bool {
+				numBeginPushCalls++
+				return true
+			},
+			EndPushCalled: func() {
+				numEndPushCalls++
+			},
+			HandleFinalizedEventsCalled: func(events data.FinalizedBlock) {
+				wasCalled = true
+			},
+		}
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+
+		ws := startWebServer(eg, eventsPath, getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/events/finalized", bytes.NewBuffer(jsonBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp := httptest.NewRecorder()
+
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.True(t, wasCalled)
+		assert.Equal(t, 1, numBeginPushCalls)
+		assert.Equal(t, 1, numEndPushCalls)
+	})
+}
+
 func TestEventsGroup_PushEvents(t *testing.T) {
 	t.Parallel()
 
 	t.Run("invalid data, bad request", func(t *testing.T) {
 		t.Parallel()
 
-		eg, err := groups.NewEventsGroup(&mocks.FacadeStub{})
+		observedStage := ""
+		failedStage := ""
+		facade := &mocks.FacadeStub{
+			ObserveStageCalled: func(stage string, duration time.Duration) {
+				observedStage = stage
+			},
+			AddStageErrorCalled: func(stage string) {
+				failedStage = stage
+			},
+		}
+		eg, err := groups.NewEventsGroup(facade)
 		require.Nil(t, err)
 
 		ws := startWebServer(eg, eventsPath, getEventsRoutesConfig())
@@ -78,6 +232,8 @@ func TestEventsGroup_PushEvents(t *testing.T) {
 		ws.ServeHTTP(resp, req)
 
 		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Equal(t, common.UnmarshalStage, observedStage)
+		assert.Equal(t, common.UnmarshalStage, failedStage)
 	})
 
 	t.Run("facade error, will try push events v2, should fail", func(t *testing.T) {
@@ -170,9 +326,11 @@ func TestEventsGroup_PushEvents(t *testing.T) {
 			HandlePushEventsV1Called: func(eventsData data.SaveBlockData) error {
 				return common.ErrReceivedEmptyEvents
 			},
-			HandlePushEventsV2Called: func(events data.ArgsSaveBlockData) error {
+			HandlePushEventsV2Called: func(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error {
 				wasCalled = true
 				assert.Equal(t, argsSaveBlockData, events)
+				// without per-observer authentication, no observer ID is passed, not even the client address
+				assert.Empty(t, observerID)
 				return nil
 			},
 		}
@@ -191,6 +349,285 @@ func TestEventsGroup_PushEvents(t *testing.T) {
 		assert.True(t, wasCalled)
 		assert.Equal(t, http.StatusOK, resp.Code)
 	})
+
+	t.Run("protobuf outport block should work", func(t *testing.T) {
+		t.Parallel()
+
+		header := &block.Header{Nonce: 1}
+		headerBytes, _ := header.Marshal()
+
+		// blockData: headerBytes (field 2), headerType (field 3) and headerHash (field 4)
+		blockData := append([]byte{0x12, byte(len(headerBytes))}, headerBytes...)
+		blockData = append(blockData, 0x1a, 6)
+		blockData = append(blockData, "Header"...)
+		blockData = append(blockData, 0x22, 4)
+		blockData = append(blockData, "hash"...)
+		outportBlock := append([]byte{0x12, byte(len(blockData))}, blockData...)
+
+		wasCalled := false
+		facade := &mocks.FacadeStub{
+			HandlePushEventsV2Called: func(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error {
+				wasCalled = true
+				assert.Equal(t, []byte("hash"), events.HeaderHash)
+				assert.Equal(t, header, events.Header)
+				return nil
+			},
+		}
+
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+
+		ws := startWebServer(eg, eventsPath, getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/events/push", bytes.NewBuffer(outportBlock))
+		req.Header.Set("Content-Type", "application/x-protobuf")
+		resp := httptest.NewRecorder()
+
+		ws.ServeHTTP(resp, req)
+
+		assert.True(t, wasCalled)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+}
+
+func TestEventsGroup_EnqueuePushEvents(t *testing.T) {
+	t.Parallel()
+
+	blockEvents := data.ArgsSaveBlock{
+		HeaderType: "HeaderV2",
+		ArgsSaveBlockData: data.ArgsSaveBlockData{
+			HeaderHash:       []byte{},
+			Body:             &block.Body{},
+			Header:           &block.HeaderV2{},
+			TransactionsPool: &data.TransactionsPool{},
+		},
+	}
+	jsonBytes, _ := json.Marshal(blockEvents)
+
+	pushEvents := func(facade *mocks.FacadeStub, body []byte) *httptest.ResponseRecorder {
+		facade.IsIngestionQueueEnabledCalled = func() bool {
+			return true
+		}
+		facade.HandlePushEventsV2Called = func(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error {
+			assert.Fail(t, "should not have been called")
+			return nil
+		}
+
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+
+		ws := startWebServer(eg, eventsPath, getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/events/push", bytes.NewBuffer(body))
+		req.Header.Set("Content-Type", "application/json")
+		resp := httptest.NewRecorder()
+
+		ws.ServeHTTP(resp, req)
+
+		return resp
+	}
+
+	t.Run("invalid data, bad request", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mocks.FacadeStub{
+			EnqueuePushEventsV2Called: func(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error {
+				assert.Fail(t, "should not have been called")
+				return nil
+			},
+		}
+
+		resp := pushEvents(facade, []byte("invalid data"))
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+
+	t.Run("full queue, should be unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mocks.FacadeStub{
+			EnqueuePushEventsV2Called: func(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error {
+				return common.ErrIngestionQueueFull
+			},
+			RecordObserverErrorCalled: func(observerID string) {
+				assert.Fail(t, "should not have been called")
+			},
+		}
+
+		resp := pushEvents(facade, jsonBytes)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+		assert.Equal(t, "1", resp.Header().Get("Retry-After"))
+	})
+
+	t.Run("processing error, bad request", func(t *testing.T) {
+		t.Parallel()
+
+		observerErrorWasRecorded := false
+		facade := &mocks.FacadeStub{
+			EnqueuePushEventsV2Called: func(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error {
+				return common.ErrReceivedEmptyEvents
+			},
+			RecordObserverErrorCalled: func(observerID string) {
+				observerErrorWasRecorded = true
+			},
+		}
+
+		resp := pushEvents(facade, jsonBytes)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, observerErrorWasRecorded)
+	})
+
+	t.Run("canceled request, should be unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mocks.FacadeStub{
+			EnqueuePushEventsV2Called: func(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error {
+				return context.Canceled
+			},
+			RecordObserverErrorCalled: func(observerID string) {
+				assert.Fail(t, "should not have been called")
+			},
+		}
+
+		resp := pushEvents(facade, jsonBytes)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+	})
+
+	t.Run("should answer once the block was processed", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mocks.FacadeStub{
+			EnqueuePushEventsV2Called: func(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error {
+				wasCalled = true
+				return nil
+			},
+		}
+
+		resp := pushEvents(facade, jsonBytes)
+		assert.True(t, wasCalled)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+}
+
+func TestEventsGroup_BodyReaderMiddleware(t *testing.T) {
+	t.Parallel()
+
+	revertBlockEvents := data.RevertBlock{
+		Hash:  "hash1",
+		Nonce: 1,
+	}
+	jsonBytes, _ := json.Marshal(revertBlockEvents)
+
+	gzipBody := func(payload []byte) *bytes.Buffer {
+		buff := &bytes.Buffer{}
+		gzipWriter := gzip.NewWriter(buff)
+		_, _ = gzipWriter.Write(payload)
+		_ = gzipWriter.Close()
+
+		return buff
+	}
+
+	t.Run("gzip compressed body, should work", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		facade := &mocks.FacadeStub{
+			HandleRevertEventsCalled: func(events data.RevertBlock) {
+				wasCalled = true
+				assert.Equal(t, revertBlockEvents, events)
+			},
+		}
+
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+
+		ws := startWebServer(eg, eventsPath, getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/events/revert", gzipBody(jsonBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-Encoding", "gzip")
+		resp := httptest.NewRecorder()
+
+		ws.ServeHTTP(resp, req)
+
+		assert.True(t, wasCalled)
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+
+	t.Run("unsupported content encoding, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		eg, err := groups.NewEventsGroup(&mocks.FacadeStub{})
+		require.Nil(t, err)
+
+		ws := startWebServer(eg, eventsPath, getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/events/revert", bytes.NewBuffer(jsonBytes))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-Encoding", "br")
+		resp := httptest.NewRecorder()
+
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusUnsupportedMediaType, resp.Code)
+	})
+
+	t.Run("content length above the limit, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mocks.FacadeStub{
+			GetMaxBodySizeCalled: func() int64 {
+				return int64(len(jsonBytes) - 1)
+			},
+			HandleRevertEventsCalled: func(events data.RevertBlock) {
+				assert.Fail(t, "should have not been called")
+			},
+		}
+
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+
+		ws := startWebServer(eg, eventsPath, getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/events/revert", bytes.NewBuffer(jsonBytes))
+		req.Header.Set("Content-Type", "application/json")
+		resp := httptest.NewRecorder()
+
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, resp.Code)
+	})
+
+	t.Run("decompressed push above the limit, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		payload := append([]byte(`{"HeaderType":"HeaderV2","NotarizedHeadersHashes":["`), bytes.Repeat([]byte("a"), 10000)...)
+		payload = append(payload, []byte(`"]}`)...)
+
+		facade := &mocks.FacadeStub{
+			GetMaxBodySizeCalled: func() int64 {
+				return 1000
+			},
+			HandlePushEventsV2Called: func(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error {
+				assert.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+
+		ws := startWebServer(eg, eventsPath, getEventsRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/events/push", gzipBody(payload))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-Encoding", "gzip")
+		resp := httptest.NewRecorder()
+
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, resp.Code)
+	})
 }
 
 func TestEventsGroup_RevertEvents(t *testing.T) {
@@ -1,8 +1,31 @@
 package groups
 
-import nodeData "github.com/multiversx/mx-chain-core-go/data"
+import (
+	"time"
+
+	nodeData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+)
 
 // GetHeader -
 func GetHeader(marshaledData []byte) (nodeData.HeaderHandler, error) {
 	return getHeader(marshaledData)
 }
+
+// NewObserverAuthenticator -
+func NewObserverAuthenticator(facade EventsFacadeHandler, cfg config.ObserversAuthConfig) (*observerAuthenticator, error) {
+	return newObserverAuthenticator(facade, cfg)
+}
+
+// MarkSignatureAsSeen -
+func (oa *observerAuthenticator) MarkSignatureAsSeen(key string, now time.Time) bool {
+	return oa.markSignatureAsSeen(key, now)
+}
+
+// NumSeenSignatures -
+func (oa *observerAuthenticator) NumSeenSignatures() int {
+	oa.mutSignatures.Lock()
+	defer oa.mutSignatures.Unlock()
+
+	return len(oa.seenSignatures)
+}
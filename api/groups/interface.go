@@ -1,18 +1,33 @@
 package groups
 
 import (
+	"context"
 	"net/http"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 )
 
 // EventsFacadeHandler defines the behavior of a facade handler needed for events group
 type EventsFacadeHandler interface {
-	HandlePushEventsV2(events data.ArgsSaveBlockData) error
+	HandlePushEventsV2(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error
+	EnqueuePushEventsV2(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error
+	IsIngestionQueueEnabled() bool
 	HandlePushEventsV1(events data.SaveBlockData) error
 	HandleRevertEvents(revertBlock data.RevertBlock)
 	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock)
 	GetConnectorUserAndPass() (string, string)
+	IsObserverCertificateRequired() bool
+	GetObserversAuthConfig() config.ObserversAuthConfig
+	GetMaxBodySize() int64
+	AddObserverRequest(observerID string)
+	ObserveStage(stage string, duration time.Duration)
+	AddStageError(stage string)
+	RecordObserverError(observerID string)
+	BeginPush() bool
+	EndPush()
 	IsInterfaceNil() bool
 }
 
@@ -21,3 +36,12 @@ type HubFacadeHandler interface {
 	ServeHTTP(w http.ResponseWriter, r *http.Request)
 	IsInterfaceNil() bool
 }
+
+// AdminFacadeHandler defines the behavior of a facade handler needed for admin group
+type AdminFacadeHandler interface {
+	GetAdminUserAndPass() (string, string)
+	GetDispatchers() []data.DispatcherInfo
+	DisconnectDispatcher(dispatcherID uuid.UUID) error
+	GetSubscriptionsStats() data.SubscriptionsStats
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,312 @@
+package groups
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-notifier-go/api/errors"
+	"github.com/multiversx/mx-chain-notifier-go/api/shared"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/decoders"
+)
+
+const (
+	// ObserverIDContextKey is the gin context key holding the ID of the authenticated observer
+	ObserverIDContextKey = "observerID"
+
+	observerIDHeader        = "X-Observer-Id"
+	observerTimestampHeader = "X-Observer-Timestamp"
+	observerSignatureHeader = "X-Observer-Signature"
+
+	signedBodyContextKey = "signedBody"
+
+	defaultSignatureReplayWindow = 60 * time.Second
+)
+
+type observerAuthenticator struct {
+	facade       EventsFacadeHandler
+	replayWindow time.Duration
+	byID         map[string]config.ObserverCredentialsConfig
+	byUsername   map[string]config.ObserverCredentialsConfig
+
+	mutSignatures       sync.Mutex
+	seenSignatures      map[string]time.Time
+	seenSignaturesQueue []seenSignature
+}
+
+type seenSignature struct {
+	key    string
+	expiry time.Time
+}
+
+func newObserverAuthenticator(facade EventsFacadeHandler, cfg config.ObserversAuthConfig) (*observerAuthenticator, error) {
+	oa := &observerAuthenticator{
+		facade:              facade,
+		replayWindow:        defaultSignatureReplayWindow,
+		byID:                make(map[string]config.ObserverCredentialsConfig),
+		byUsername:          make(map[string]config.ObserverCredentialsConfig),
+		seenSignatures:      make(map[string]time.Time),
+		seenSignaturesQueue: make([]seenSignature, 0),
+	}
+	if cfg.SignatureReplayWindowInSec > 0 {
+		oa.replayWindow = time.Second * time.Duration(cfg.SignatureReplayWindowInSec)
+	}
+
+	for _, observer := range cfg.Observers {
+		err := oa.addObserver(observer)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return oa, nil
+}
+
+func (oa *observerAuthenticator) addObserver(observer config.ObserverCredentialsConfig) error {
+	if observer.ID == "" {
+		return fmt.Errorf("%w: empty observer ID", errors.ErrInvalidObserversAuthConfig)
+	}
+	_, exists := oa.byID[observer.ID]
+	if exists {
+		return fmt.Errorf("%w: duplicated observer ID %s", errors.ErrInvalidObserversAuthConfig, observer.ID)
+	}
+
+	hasBasicAuth := observer.Username != "" && observer.Password != ""
+	if !hasBasicAuth && observer.HMACKey == "" {
+		return fmt.Errorf("%w: no credentials for observer %s", errors.ErrInvalidObserversAuthConfig, observer.ID)
+	}
+
+	if hasBasicAuth {
+		_, exists = oa.byUsername[observer.Username]
+		if exists {
+			return fmt.Errorf("%w: duplicated username for observer %s", errors.ErrInvalidObserversAuthConfig, observer.ID)
+		}
+		oa.byUsername[observer.Username] = observer
+	}
+	oa.byID[observer.ID] = observer
+
+	return nil
+}
+
+// middleware authenticates the request either by its signature or by basic auth
+// and attaches the observer ID to the request context. A signed request is checked here only
+// by its headers, the signature of its body being verified while the handler reads the body
+func (oa *observerAuthenticator) middleware(c *gin.Context) {
+	var observerID string
+	var err error
+	if c.GetHeader(observerSignatureHeader) != "" {
+		observerID, err = oa.startSignatureVerification(c)
+	} else {
+		observerID, err = oa.verifyBasicAuth(c)
+		if err == nil {
+			oa.facade.AddObserverRequest(observerID)
+		}
+	}
+	if err != nil {
+		log.Debug("observer authentication failed", "path", c.FullPath(), "remote address", c.ClientIP(), "err", err.Error())
+		shared.JSONResponse(c, http.StatusUnauthorized, nil, errors.ErrObserverUnauthorized.Error())
+		c.Abort()
+		return
+	}
+
+	c.Set(ObserverIDContextKey, observerID)
+	log.Trace("observer request authenticated", "observer", observerID, "path", c.FullPath())
+
+	c.Next()
+}
+
+func (oa *observerAuthenticator) verifyBasicAuth(c *gin.Context) (string, error) {
+	username, password, ok := c.Request.BasicAuth()
+	if !ok {
+		return "", fmt.Errorf("missing credentials")
+	}
+
+	observer, exists := oa.byUsername[username]
+	if !exists || subtle.ConstantTimeCompare([]byte(password), []byte(observer.Password)) != 1 {
+		return "", fmt.Errorf("invalid credentials for user %s", username)
+	}
+
+	return observer.ID, nil
+}
+
+func (oa *observerAuthenticator) startSignatureVerification(c *gin.Context) (string, error) {
+	observerID := c.GetHeader(observerIDHeader)
+	observer, exists := oa.byID[observerID]
+	if !exists || observer.HMACKey == "" {
+		return "", fmt.Errorf("unknown observer %s", observerID)
+	}
+
+	timestampValue := c.GetHeader(observerTimestampHeader)
+	timestamp, err := strconv.ParseInt(timestampValue, 10, 64)
+	if err != nil {
+		return "", fmt.Errorf("invalid timestamp for observer %s", observerID)
+	}
+
+	now := time.Now()
+	signedAt := time.Unix(timestamp, 0)
+	if signedAt.Before(now.Add(-oa.replayWindow)) || signedAt.After(now.Add(oa.replayWindow)) {
+		return "", fmt.Errorf("timestamp outside the replay window for observer %s", observerID)
+	}
+
+	signature, err := hex.DecodeString(c.GetHeader(observerSignatureHeader))
+	if err != nil {
+		return "", fmt.Errorf("invalid signature encoding for observer %s", observerID)
+	}
+
+	// the signature covers the body as sent on the wire, so it is computed before the body is decompressed
+	mac := newObserverSignatureHash([]byte(observer.HMACKey), c.Request.Method, c.Request.URL.Path, timestampValue)
+	onSignatureVerified := func() error {
+		if !oa.markSignatureAsSeen(observerID+string(signature), now) {
+			return fmt.Errorf("%w: replayed signature for observer %s", errors.ErrObserverUnauthorized, observerID)
+		}
+
+		oa.facade.AddObserverRequest(observerID)
+		return nil
+	}
+
+	bodyReader, err := decoders.NewBodyReader(c.Request.Body, "", oa.facade.GetMaxBodySize())
+	if err != nil {
+		return "", err
+	}
+
+	sbr := &signedBodyReader{
+		reader:              io.TeeReader(bodyReader, mac),
+		mac:                 mac,
+		signature:           signature,
+		observerID:          observerID,
+		onSignatureVerified: onSignatureVerified,
+	}
+	c.Set(signedBodyContextKey, sbr)
+	c.Request.Body = ioutil.NopCloser(sbr)
+
+	return observerID, nil
+}
+
+// signedBodyReader computes the signature of the body while it is read and verifies it once the end of the body is
+// reached, so that the body is read in a single pass. Reading fails at the end of the body if the signature is invalid
+type signedBodyReader struct {
+	reader              io.Reader
+	mac                 hash.Hash
+	signature           []byte
+	observerID          string
+	onSignatureVerified func() error
+
+	isVerified bool
+	err        error
+}
+
+// Read reads the next bytes of the body, returning the verification error instead of EOF if the signature is invalid
+func (sbr *signedBodyReader) Read(p []byte) (int, error) {
+	if sbr.isVerified {
+		if sbr.err != nil {
+			return 0, sbr.err
+		}
+		return 0, io.EOF
+	}
+
+	n, err := sbr.reader.Read(p)
+	if err != io.EOF {
+		return n, err
+	}
+
+	sbr.isVerified = true
+	sbr.err = sbr.verifySignature()
+	if sbr.err != nil {
+		return n, sbr.err
+	}
+
+	return n, io.EOF
+}
+
+func (sbr *signedBodyReader) verifySignature() error {
+	if !hmac.Equal(sbr.signature, sbr.mac.Sum(nil)) {
+		return fmt.Errorf("%w: invalid signature for observer %s", errors.ErrObserverUnauthorized, sbr.observerID)
+	}
+
+	return sbr.onSignatureVerified()
+}
+
+// verify reads the rest of the body, which is left unread if the handler has already decoded its data, and
+// returns an error if the signature is invalid
+func (sbr *signedBodyReader) verify() error {
+	_, err := io.Copy(ioutil.Discard, sbr)
+	if err != nil {
+		return err
+	}
+
+	return sbr.err
+}
+
+// verifySignedBody returns an error if the request is signed and its body does not match the signature. The
+// handlers of the signed routes have to call it after decoding the body and before using the decoded data
+func verifySignedBody(c *gin.Context) error {
+	value, exists := c.Get(signedBodyContextKey)
+	if !exists {
+		return nil
+	}
+
+	sbr, ok := value.(*signedBodyReader)
+	if !ok {
+		return fmt.Errorf("%w: invalid signed body", errors.ErrObserverUnauthorized)
+	}
+
+	return sbr.verify()
+}
+
+// markSignatureAsSeen returns false if the signature has already been used within the replay window.
+// The signatures are kept in the order they expire, so only the expired ones are visited
+func (oa *observerAuthenticator) markSignatureAsSeen(key string, now time.Time) bool {
+	oa.mutSignatures.Lock()
+	defer oa.mutSignatures.Unlock()
+
+	for len(oa.seenSignaturesQueue) > 0 && now.After(oa.seenSignaturesQueue[0].expiry) {
+		delete(oa.seenSignatures, oa.seenSignaturesQueue[0].key)
+		oa.seenSignaturesQueue = oa.seenSignaturesQueue[1:]
+	}
+
+	_, seen := oa.seenSignatures[key]
+	if seen {
+		return false
+	}
+
+	// a signature can not be replayed once its timestamp leaves the window, which happens at most
+	// 2 windows later (for timestamps in the future)
+	expiry := now.Add(2 * oa.replayWindow)
+	oa.seenSignatures[key] = expiry
+	oa.seenSignaturesQueue = append(oa.seenSignaturesQueue, seenSignature{key: key, expiry: expiry})
+
+	return true
+}
+
+// ComputeObserverSignature returns the HMAC-SHA256 signature an observer has to provide for a request,
+// computed over "<timestamp>.<method>.<path>.<body>", so that a signed body can not be sent to another route
+func ComputeObserverSignature(key []byte, method string, path string, timestamp string, body []byte) []byte {
+	mac := newObserverSignatureHash(key, method, path, timestamp)
+	_, _ = mac.Write(body)
+
+	return mac.Sum(nil)
+}
+
+// newObserverSignatureHash returns the HMAC-SHA256 of the request, left to be written with the body
+func newObserverSignatureHash(key []byte, method string, path string, timestamp string) hash.Hash {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(timestamp))
+	_, _ = mac.Write([]byte("."))
+	_, _ = mac.Write([]byte(method))
+	_, _ = mac.Write([]byte("."))
+	_, _ = mac.Write([]byte(path))
+	_, _ = mac.Write([]byte("."))
+
+	return mac
+}
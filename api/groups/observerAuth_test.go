@@ -0,0 +1,536 @@
+package groups_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	apiErrors "github.com/multiversx/mx-chain-notifier-go/api/errors"
+	"github.com/multiversx/mx-chain-notifier-go/api/groups"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createObserversAuthConfig() config.ObserversAuthConfig {
+	return config.ObserversAuthConfig{
+		SignatureReplayWindowInSec: 60,
+		Observers: []config.ObserverCredentialsConfig{
+			{
+				ID:       "observer-0",
+				Username: "user0",
+				Password: "pass0",
+			},
+			{
+				ID:      "observer-1",
+				HMACKey: "key1",
+			},
+		},
+	}
+}
+
+func createFinalizedRequest(t *testing.T) (*http.Request, []byte) {
+	jsonBytes, err := json.Marshal(data.FinalizedBlock{Hash: "hash1"})
+	require.Nil(t, err)
+
+	req, _ := http.NewRequest("POST", "/events/finalized", bytes.NewBuffer(jsonBytes))
+	req.Header.Set("Content-Type", "application/json")
+
+	return req, jsonBytes
+}
+
+func signRequest(req *http.Request, observerID string, key string, timestamp time.Time, body []byte) {
+	timestampValue := strconv.FormatInt(timestamp.Unix(), 10)
+	signature := groups.ComputeObserverSignature([]byte(key), req.Method, req.URL.Path, timestampValue, body)
+
+	req.Header.Set("X-Observer-Id", observerID)
+	req.Header.Set("X-Observer-Timestamp", timestampValue)
+	req.Header.Set("X-Observer-Signature", hex.EncodeToString(signature))
+}
+
+func getAuthEventsRoutesConfig() config.APIRoutesConfig {
+	return config.APIRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"events": {
+				Routes: []config.RouteConfig{
+					{Name: "/finalized", Open: true, Auth: true},
+				},
+			},
+		},
+	}
+}
+
+func TestNewEventsGroup_ObserversAuthConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty observer ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createObserversAuthConfig()
+		cfg.Observers[0].ID = ""
+
+		eg, err := groups.NewEventsGroup(&mocks.FacadeStub{
+			GetObserversAuthConfigCalled: func() config.ObserversAuthConfig {
+				return cfg
+			},
+		})
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidObserversAuthConfig))
+		require.True(t, check.IfNil(eg))
+	})
+
+	t.Run("duplicated observer ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createObserversAuthConfig()
+		cfg.Observers[1].ID = cfg.Observers[0].ID
+
+		eg, err := groups.NewEventsGroup(&mocks.FacadeStub{
+			GetObserversAuthConfigCalled: func() config.ObserversAuthConfig {
+				return cfg
+			},
+		})
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidObserversAuthConfig))
+		require.True(t, check.IfNil(eg))
+	})
+
+	t.Run("observer without credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := createObserversAuthConfig()
+		cfg.Observers[1].HMACKey = ""
+
+		eg, err := groups.NewEventsGroup(&mocks.FacadeStub{
+			GetObserversAuthConfigCalled: func() config.ObserversAuthConfig {
+				return cfg
+			},
+		})
+		require.True(t, errors.Is(err, apiErrors.ErrInvalidObserversAuthConfig))
+		require.True(t, check.IfNil(eg))
+	})
+}
+
+func TestEventsGroup_ObserversAuth(t *testing.T) {
+	t.Parallel()
+
+	createEventsServer := func(t *testing.T, handledObserver *string) http.Handler {
+		facade := &mocks.FacadeStub{
+			GetConnectorUserAndPassCalled: func() (string, string) {
+				return "shared", "shared"
+			},
+			GetObserversAuthConfigCalled: func() config.ObserversAuthConfig {
+				return createObserversAuthConfig()
+			},
+			AddObserverRequestCalled: func(observerID string) {
+				*handledObserver = observerID
+			},
+		}
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+
+		return startWebServer(eg, eventsPath, getAuthEventsRoutesConfig())
+	}
+
+	t.Run("valid basic auth should work", func(t *testing.T) {
+		t.Parallel()
+
+		handledObserver := ""
+		ws := createEventsServer(t, &handledObserver)
+
+		req, _ := createFinalizedRequest(t)
+		req.SetBasicAuth("user0", "pass0")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "observer-0", handledObserver)
+	})
+
+	t.Run("shared credentials should be unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		handledObserver := ""
+		ws := createEventsServer(t, &handledObserver)
+
+		req, _ := createFinalizedRequest(t)
+		req.SetBasicAuth("shared", "shared")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Empty(t, handledObserver)
+	})
+
+	t.Run("invalid password should be unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		handledObserver := ""
+		ws := createEventsServer(t, &handledObserver)
+
+		req, _ := createFinalizedRequest(t)
+		req.SetBasicAuth("user0", "pass1")
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+
+	t.Run("valid signature should work", func(t *testing.T) {
+		t.Parallel()
+
+		handledObserver := ""
+		wasCalled := false
+		facade := &mocks.FacadeStub{
+			GetObserversAuthConfigCalled: func() config.ObserversAuthConfig {
+				return createObserversAuthConfig()
+			},
+			AddObserverRequestCalled: func(observerID string) {
+				handledObserver = observerID
+			},
+			HandleFinalizedEventsCalled: func(events data.FinalizedBlock) {
+				wasCalled = true
+				assert.Equal(t, "hash1", events.Hash)
+			},
+		}
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+		ws := startWebServer(eg, eventsPath, getAuthEventsRoutesConfig())
+
+		req, body := createFinalizedRequest(t)
+		signRequest(req, "observer-1", "key1", time.Now(), body)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "observer-1", handledObserver)
+		assert.True(t, wasCalled)
+	})
+
+	t.Run("invalid signature should be unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		handledObserver := ""
+		ws := createEventsServer(t, &handledObserver)
+
+		req, body := createFinalizedRequest(t)
+		signRequest(req, "observer-1", "another key", time.Now(), body)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+
+	t.Run("signature for another route should be unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		handledObserver := ""
+		ws := createEventsServer(t, &handledObserver)
+
+		req, body := createFinalizedRequest(t)
+		timestampValue := strconv.FormatInt(time.Now().Unix(), 10)
+		signature := groups.ComputeObserverSignature([]byte("key1"), http.MethodPost, "/events/revert", timestampValue, body)
+		req.Header.Set("X-Observer-Id", "observer-1")
+		req.Header.Set("X-Observer-Timestamp", timestampValue)
+		req.Header.Set("X-Observer-Signature", hex.EncodeToString(signature))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Empty(t, handledObserver)
+	})
+
+	t.Run("observer without HMAC key should be unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		handledObserver := ""
+		ws := createEventsServer(t, &handledObserver)
+
+		req, body := createFinalizedRequest(t)
+		signRequest(req, "observer-0", "", time.Now(), body)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+
+	t.Run("timestamp outside replay window should be unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		handledObserver := ""
+		ws := createEventsServer(t, &handledObserver)
+
+		req, body := createFinalizedRequest(t)
+		signRequest(req, "observer-1", "key1", time.Now().Add(-2*time.Minute), body)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+
+	t.Run("replayed signature should be unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		handledObserver := ""
+		ws := createEventsServer(t, &handledObserver)
+
+		timestamp := time.Now()
+		req, body := createFinalizedRequest(t)
+		signRequest(req, "observer-1", "key1", timestamp, body)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		require.Equal(t, http.StatusOK, resp.Code)
+
+		req, body = createFinalizedRequest(t)
+		signRequest(req, "observer-1", "key1", timestamp, body)
+		resp = httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+}
+
+func TestObserverAuthenticator_MarkSignatureAsSeen(t *testing.T) {
+	t.Parallel()
+
+	cfg := createObserversAuthConfig()
+	cfg.SignatureReplayWindowInSec = 1
+	oa, err := groups.NewObserverAuthenticator(&mocks.FacadeStub{}, cfg)
+	require.Nil(t, err)
+
+	now := time.Now()
+	require.True(t, oa.MarkSignatureAsSeen("signature0", now))
+	require.False(t, oa.MarkSignatureAsSeen("signature0", now))
+	require.True(t, oa.MarkSignatureAsSeen("signature1", now.Add(time.Second)))
+	require.Equal(t, 2, oa.NumSeenSignatures())
+
+	// the first signature expires after 2 replay windows and is pruned on the next call
+	later := now.Add(2*time.Second + time.Millisecond)
+	require.True(t, oa.MarkSignatureAsSeen("signature2", later))
+	require.Equal(t, 2, oa.NumSeenSignatures())
+	require.False(t, oa.MarkSignatureAsSeen("signature1", later))
+	require.True(t, oa.MarkSignatureAsSeen("signature0", later))
+	require.Equal(t, 3, oa.NumSeenSignatures())
+}
+
+type readTrackingBody struct {
+	*bytes.Reader
+	wasRead bool
+}
+
+func (rtb *readTrackingBody) Read(p []byte) (int, error) {
+	rtb.wasRead = true
+	return rtb.Reader.Read(p)
+}
+
+func (rtb *readTrackingBody) Close() error {
+	return nil
+}
+
+func TestEventsGroup_ObserversAuthShouldRunAfterHeaderChecks(t *testing.T) {
+	t.Parallel()
+
+	createSignedRequest := func(t *testing.T) (*http.Request, *readTrackingBody) {
+		req, body := createFinalizedRequest(t)
+		signRequest(req, "observer-1", "key1", time.Now(), body)
+		trackingBody := &readTrackingBody{Reader: bytes.NewReader(body)}
+		req.Body = trackingBody
+
+		return req, trackingBody
+	}
+
+	t.Run("without verified certificate, body should not be read", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mocks.FacadeStub{
+			GetObserversAuthConfigCalled: func() config.ObserversAuthConfig {
+				return createObserversAuthConfig()
+			},
+			IsObserverCertificateRequiredCalled: func() bool {
+				return true
+			},
+		}
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+		ws := startWebServer(eg, eventsPath, getAuthEventsRoutesConfig())
+
+		req, trackingBody := createSignedRequest(t)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Contains(t, resp.Body.String(), apiErrors.ErrObserverCertificateRequired.Error())
+		assert.False(t, trackingBody.wasRead)
+	})
+
+	t.Run("while draining, body should not be read", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mocks.FacadeStub{
+			GetObserversAuthConfigCalled: func() config.ObserversAuthConfig {
+				return createObserversAuthConfig()
+			},
+			BeginPushCalled: func() bool {
+				return false
+			},
+		}
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+		ws := startWebServer(eg, eventsPath, getAuthEventsRoutesConfig())
+
+		req, trackingBody := createSignedRequest(t)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+		assert.False(t, trackingBody.wasRead)
+	})
+
+	t.Run("content length above the limit, body should not be read", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mocks.FacadeStub{
+			GetObserversAuthConfigCalled: func() config.ObserversAuthConfig {
+				return createObserversAuthConfig()
+			},
+			GetMaxBodySizeCalled: func() int64 {
+				return 4
+			},
+		}
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+		ws := startWebServer(eg, eventsPath, getAuthEventsRoutesConfig())
+
+		req, trackingBody := createSignedRequest(t)
+		req.ContentLength = int64(trackingBody.Len())
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, resp.Code)
+		assert.False(t, trackingBody.wasRead)
+	})
+}
+
+func TestEventsGroup_ObserversAuthSignedPush(t *testing.T) {
+	t.Parallel()
+
+	routesConfig := config.APIRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"events": {
+				Routes: []config.RouteConfig{
+					{Name: "/push", Open: true, Auth: true},
+				},
+			},
+		},
+	}
+
+	gzipBody := func(payload []byte) []byte {
+		buff := &bytes.Buffer{}
+		gzipWriter := gzip.NewWriter(buff)
+		_, _ = gzipWriter.Write(payload)
+		_ = gzipWriter.Close()
+
+		return buff.Bytes()
+	}
+
+	blockEvents := data.ArgsSaveBlock{
+		HeaderType: "HeaderV2",
+		ArgsSaveBlockData: data.ArgsSaveBlockData{
+			HeaderHash:       []byte("headerHash"),
+			Body:             &block.Body{},
+			Header:           &block.HeaderV2{},
+			TransactionsPool: &data.TransactionsPool{},
+		},
+	}
+	jsonBytes, err := json.Marshal(blockEvents)
+	require.Nil(t, err)
+	pushedBody := gzipBody(jsonBytes)
+
+	type pushResult struct {
+		pushedObserver    string
+		requestObserver   string
+		observerWithError string
+	}
+
+	push := func(t *testing.T, body []byte, signedBody []byte) (*httptest.ResponseRecorder, *pushResult) {
+		result := &pushResult{}
+		facade := &mocks.FacadeStub{
+			GetObserversAuthConfigCalled: func() config.ObserversAuthConfig {
+				return createObserversAuthConfig()
+			},
+			AddObserverRequestCalled: func(observerID string) {
+				result.requestObserver = observerID
+			},
+			RecordObserverErrorCalled: func(observerID string) {
+				result.observerWithError = observerID
+			},
+			HandlePushEventsV2Called: func(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error {
+				result.pushedObserver = observerID
+				return nil
+			},
+		}
+		eg, err := groups.NewEventsGroup(facade)
+		require.Nil(t, err)
+		ws := startWebServer(eg, eventsPath, routesConfig)
+
+		req, _ := http.NewRequest("POST", "/events/push", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-Encoding", "gzip")
+		signRequest(req, "observer-1", "key1", time.Now(), signedBody)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		return resp, result
+	}
+
+	t.Run("valid signature of the compressed body should work", func(t *testing.T) {
+		t.Parallel()
+
+		resp, result := push(t, pushedBody, pushedBody)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "observer-1", result.pushedObserver)
+		assert.Equal(t, "observer-1", result.requestObserver)
+	})
+
+	t.Run("valid block with invalid signature should be unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		resp, result := push(t, pushedBody, gzipBody([]byte("another body")))
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Empty(t, result.pushedObserver)
+		assert.Empty(t, result.requestObserver)
+		assert.Empty(t, result.observerWithError)
+	})
+
+	t.Run("invalid block with invalid signature should be unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		invalidBody := gzipBody([]byte("invalid data"))
+		resp, result := push(t, invalidBody, pushedBody)
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Empty(t, result.observerWithError)
+	})
+
+	t.Run("invalid block with valid signature should be a bad request", func(t *testing.T) {
+		t.Parallel()
+
+		invalidBody := gzipBody([]byte("invalid data"))
+		resp, result := push(t, invalidBody, invalidBody)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Empty(t, result.pushedObserver)
+		assert.Equal(t, "observer-1", result.observerWithError)
+	})
+}
@@ -8,11 +8,15 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-notifier-go/api/errors"
 	"github.com/multiversx/mx-chain-notifier-go/api/shared"
+	"github.com/multiversx/mx-chain-notifier-go/common"
 )
 
 const (
 	metricsPath           = "/metrics"
 	prometheusMetricsPath = "/prometheus-metrics"
+	observersPath         = "/observers"
+	healthPath            = "/health"
+	readyPath             = "/ready"
 )
 
 type statusGroup struct {
@@ -42,6 +46,21 @@ func NewStatusGroup(facade shared.FacadeHandler) (*statusGroup, error) {
 			Handler: sg.getPrometheusMetrics,
 			Method:  http.MethodGet,
 		},
+		{
+			Path:    observersPath,
+			Handler: sg.getObserversHealth,
+			Method:  http.MethodGet,
+		},
+		{
+			Path:    healthPath,
+			Handler: sg.getHealth,
+			Method:  http.MethodGet,
+		},
+		{
+			Path:    readyPath,
+			Handler: sg.getReadiness,
+			Method:  http.MethodGet,
+		},
 	}
 	sg.endpoints = endpoints
 
@@ -62,6 +81,35 @@ func (sg *statusGroup) getPrometheusMetrics(c *gin.Context) {
 	c.String(http.StatusOK, metricsResults)
 }
 
+// getObserversHealth will expose the push statistics of each observer and shard
+func (sg *statusGroup) getObserversHealth(c *gin.Context) {
+	health := sg.facade.GetObserversHealth()
+
+	shared.JSONResponse(c, http.StatusOK, gin.H{"observers": health.Observers, "shards": health.Shards}, "")
+}
+
+// getHealth will expose the liveness status, which is down only if a component required for liveness is unhealthy
+func (sg *statusGroup) getHealth(c *gin.Context) {
+	health := sg.facade.GetHealth()
+	if health.Status == common.HealthStatusDown {
+		shared.JSONResponse(c, http.StatusServiceUnavailable, health, errors.ErrServiceUnhealthy.Error())
+		return
+	}
+
+	shared.JSONResponse(c, http.StatusOK, health, "")
+}
+
+// getReadiness will expose the readiness status, which is down if any component is unhealthy
+func (sg *statusGroup) getReadiness(c *gin.Context) {
+	readiness := sg.facade.GetReadiness()
+	if readiness.Status != common.HealthStatusUp {
+		shared.JSONResponse(c, http.StatusServiceUnavailable, readiness, errors.ErrServiceNotReady.Error())
+		return
+	}
+
+	shared.JSONResponse(c, http.StatusOK, readiness, "")
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sg *statusGroup) IsInterfaceNil() bool {
 	return sg == nil
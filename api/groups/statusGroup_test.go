@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	apiErrors "github.com/multiversx/mx-chain-notifier-go/api/errors"
 	"github.com/multiversx/mx-chain-notifier-go/api/groups"
+	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/mocks"
@@ -26,6 +27,19 @@ type statusMetricsResponse struct {
 	Error string `json:"error"`
 }
 
+type healthStatusResponse struct {
+	Data  data.HealthStatus `json:"data"`
+	Error string            `json:"error"`
+}
+
+type observersHealthResponse struct {
+	Data struct {
+		Observers []data.ObserverHealth `json:"observers"`
+		Shards    []data.ShardHealth    `json:"shards"`
+	}
+	Error string `json:"error"`
+}
+
 func TestNewStatusGroup(t *testing.T) {
 	t.Parallel()
 
@@ -105,6 +119,139 @@ func TestGetPrometheusMetrics_ShouldWork(t *testing.T) {
 	require.Equal(t, expectedMetrics, string(bodyBytes))
 }
 
+func TestGetObserversHealth_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedHealth := data.ObserversHealth{
+		Observers: []data.ObserverHealth{
+			{
+				ObserverID: "observer-0",
+				LastNonce:  10,
+				NumPushes:  2,
+				PushRate:   2,
+			},
+		},
+		Shards: []data.ShardHealth{
+			{
+				ShardID:   1,
+				LastNonce: 10,
+				IsStale:   true,
+			},
+		},
+	}
+	facade := &mocks.FacadeStub{
+		GetObserversHealthCalled: func() data.ObserversHealth {
+			return expectedHealth
+		},
+	}
+
+	statusGroup, err := groups.NewStatusGroup(facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(statusGroup, statusPath, getStatusRoutesConfig())
+
+	req, _ := http.NewRequest("GET", "/status/observers", nil)
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	var apiResp observersHealthResponse
+	loadResponse(resp.Body, &apiResp)
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	require.Equal(t, expectedHealth.Observers, apiResp.Data.Observers)
+	require.Equal(t, expectedHealth.Shards, apiResp.Data.Shards)
+}
+
+func TestGetHealth(t *testing.T) {
+	t.Parallel()
+
+	testHealth := func(status string, expectedCode int) {
+		health := data.HealthStatus{
+			Status: status,
+			Components: map[string]data.ComponentHealth{
+				"redis": {Status: common.HealthStatusDown, Error: "no connection"},
+				"hub":   {Status: common.HealthStatusUp},
+			},
+		}
+		facade := &mocks.FacadeStub{
+			GetHealthCalled: func() data.HealthStatus {
+				return health
+			},
+		}
+
+		statusGroup, err := groups.NewStatusGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(statusGroup, statusPath, getStatusRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/status/health", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		var apiResp healthStatusResponse
+		loadResponse(resp.Body, &apiResp)
+		require.Equal(t, expectedCode, resp.Code)
+		require.Equal(t, health, apiResp.Data)
+	}
+
+	t.Run("degraded should be alive", func(t *testing.T) {
+		t.Parallel()
+
+		testHealth(common.HealthStatusDegraded, http.StatusOK)
+	})
+
+	t.Run("down should be unavailable", func(t *testing.T) {
+		t.Parallel()
+
+		testHealth(common.HealthStatusDown, http.StatusServiceUnavailable)
+	})
+}
+
+func TestGetReadiness(t *testing.T) {
+	t.Parallel()
+
+	testReadiness := func(status string, expectedCode int, expectedErr string) {
+		facade := &mocks.FacadeStub{
+			GetReadinessCalled: func() data.HealthStatus {
+				return data.HealthStatus{
+					Status: status,
+					Components: map[string]data.ComponentHealth{
+						"rabbitmq": {Status: status},
+					},
+				}
+			},
+		}
+
+		statusGroup, err := groups.NewStatusGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(statusGroup, statusPath, getStatusRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/status/ready", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		var apiResp healthStatusResponse
+		loadResponse(resp.Body, &apiResp)
+		require.Equal(t, expectedCode, resp.Code)
+		require.Equal(t, status, apiResp.Data.Status)
+		require.Equal(t, status, apiResp.Data.Components["rabbitmq"].Status)
+		require.Equal(t, expectedErr, apiResp.Error)
+	}
+
+	t.Run("up should be ready", func(t *testing.T) {
+		t.Parallel()
+
+		testReadiness(common.HealthStatusUp, http.StatusOK, "")
+	})
+
+	t.Run("down should not be ready", func(t *testing.T) {
+		t.Parallel()
+
+		testReadiness(common.HealthStatusDown, http.StatusServiceUnavailable, apiErrors.ErrServiceNotReady.Error())
+	})
+}
+
 func TestStatusGroup_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -122,6 +269,9 @@ func getStatusRoutesConfig() config.APIRoutesConfig {
 				Routes: []config.RouteConfig{
 					{Name: "/metrics", Open: true},
 					{Name: "/prometheus-metrics", Open: true},
+					{Name: "/observers", Open: true},
+					{Name: "/health", Open: true},
+					{Name: "/ready", Open: true},
 				},
 			},
 		},
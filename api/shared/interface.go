@@ -1,9 +1,12 @@
 package shared
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 )
@@ -24,14 +27,32 @@ type GroupHandler interface {
 
 // FacadeHandler defines the behavior of a notifier base facade handler
 type FacadeHandler interface {
-	HandlePushEventsV2(events data.ArgsSaveBlockData) error
+	HandlePushEventsV2(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error
+	EnqueuePushEventsV2(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error
+	IsIngestionQueueEnabled() bool
 	HandlePushEventsV1(events data.SaveBlockData) error
 	HandleRevertEvents(revertBlock data.RevertBlock)
 	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock)
 	GetConnectorUserAndPass() (string, string)
+	IsObserverCertificateRequired() bool
+	GetObserversAuthConfig() config.ObserversAuthConfig
+	GetMaxBodySize() int64
+	AddObserverRequest(observerID string)
+	ObserveStage(stage string, duration time.Duration)
+	AddStageError(stage string)
+	RecordObserverError(observerID string)
+	BeginPush() bool
+	EndPush()
+	GetObserversHealth() data.ObserversHealth
+	GetHealth() data.HealthStatus
+	GetReadiness() data.HealthStatus
 	ServeHTTP(w http.ResponseWriter, r *http.Request)
 	GetMetrics() map[string]*data.EndpointMetricsResponse
 	GetMetricsForPrometheus() string
+	GetAdminUserAndPass() (string, string)
+	GetDispatchers() []data.DispatcherInfo
+	DisconnectDispatcher(dispatcherID uuid.UUID) error
+	GetSubscriptionsStats() data.SubscriptionsStats
 	IsInterfaceNil() bool
 }
 
@@ -37,4 +37,105 @@ const (
 
 	// BlockScrs defines the subscription event type for block scrs
 	BlockScrs string = "block_scrs"
+
+	// BlockRewards defines the subscription event type for block reward transactions
+	BlockRewards string = "block_rewards"
+
+	// BlockReceipts defines the subscription event type for block receipts
+	BlockReceipts string = "block_receipts"
+
+	// BlockInvalidTxs defines the subscription event type for block invalid transactions
+	BlockInvalidTxs string = "block_invalid_txs"
+
+	// AlteredAccounts defines the subscription event type for the accounts altered in a block
+	AlteredAccounts string = "altered_accounts"
+
+	// BlockHeader defines the subscription event type for the block header summary
+	BlockHeader string = "block_header"
+
+	// EpochStart defines the subscription event type for the metachain blocks which start an epoch
+	EpochStart string = "epoch_start"
+
+	// TxBundles defines the subscription event type for the transactions grouped with their smart contract results and events
+	TxBundles string = "tx_bundles"
+
+	// TxStatus defines the subscription event type for the final status of the tracked transactions
+	TxStatus string = "tx_status"
+
+	// SourceStaleEvents defines the subscription event type for shards from which no block arrived in time
+	SourceStaleEvents string = "source_stale"
+
+	// SubscriptionErrorEvent defines the websocket event type sent to a client whose subscription was rejected
+	SubscriptionErrorEvent string = "subscription_error"
+)
+
+const (
+	// TxStatusSuccess signals that a transaction and its smart contract results were executed successfully
+	TxStatusSuccess string = "success"
+
+	// TxStatusFailed signals that the execution of a transaction or of one of its smart contract results failed
+	TxStatusFailed string = "failed"
+
+	// TxStatusPending signals that the execution of a transaction continues in another shard
+	TxStatusPending string = "pending"
+
+	// TxStatusTimeout signals that a transaction was not completed in the configured time
+	TxStatusTimeout string = "timeout"
+)
+
+const (
+	// AnonymousSubscriber defines the subscriber name used when the websocket subscribers authentication is disabled
+	AnonymousSubscriber string = "anonymous"
+
+	// NativeAuthSubscriber defines the subscriber name used for the subscribers authenticated with native-auth
+	NativeAuthSubscriber string = "native-auth"
+)
+
+const (
+	// HealthStatusUp signals that all the checked components are healthy
+	HealthStatusUp string = "up"
+
+	// HealthStatusDegraded signals that only components which are not required for liveness are unhealthy
+	HealthStatusDegraded string = "degraded"
+
+	// HealthStatusDown signals that at least one required component is unhealthy
+	HealthStatusDown string = "down"
+
+	// HealthStatusDraining signals that the notifier is shutting down and no longer accepts data
+	HealthStatusDraining string = "draining"
+)
+
+// DrainingRetryAfterInSec defines the Retry-After value sent to the clients rejected while the notifier is draining
+const DrainingRetryAfterInSec = 30
+
+// IngestionQueueFullRetryAfterInSec defines the Retry-After value sent to the observers rejected because the
+// ingestion queue of the shard is full
+const IngestionQueueFullRetryAfterInSec = 1
+
+const (
+	// UnmarshalStage defines the pipeline stage which unmarshals the data pushed by observers
+	UnmarshalStage string = "unmarshal"
+
+	// InterceptorStage defines the pipeline stage which extracts the events from the pushed block
+	InterceptorStage string = "interceptor"
+
+	// DedupStage defines the pipeline stage which checks if a block was already processed
+	DedupStage string = "dedup"
+
+	// HubDispatchStage defines the pipeline stage which dispatches the events to websocket subscribers
+	HubDispatchStage string = "hub_dispatch"
+
+	// IngestionQueueStage defines the pipeline stage in which a pushed block waits to be processed
+	IngestionQueueStage string = "ingestion_queue"
+)
+
+const (
+	// RabbitMQSink defines the rabbitMQ publisher sink
+	RabbitMQSink string = "rabbitmq"
+
+	// ServiceBusSink defines the azure service bus publisher sink
+	ServiceBusSink string = "service_bus"
+
+	// WebSocketSink defines the websocket clients sink
+	WebSocketSink string = "websocket"
 )
@@ -16,3 +16,27 @@ var ErrReceivedEmptyEvents = errors.New("received empty events")
 
 // ErrNilStatusMetricsHandler signals that a nil status metrics handler has been provided
 var ErrNilStatusMetricsHandler = errors.New("nil status metrics handler")
+
+// ErrDispatcherNotFound signals that no dispatcher with the provided ID is connected
+var ErrDispatcherNotFound = errors.New("dispatcher not found")
+
+// ErrNativeAuthNotEnabled signals that the native-auth authentication is not enabled
+var ErrNativeAuthNotEnabled = errors.New("native-auth is not enabled")
+
+// ErrNotifierDraining signals that the notifier is shutting down and no longer accepts data
+var ErrNotifierDraining = errors.New("notifier is shutting down")
+
+// ErrUndeliveredData signals that some data was not delivered before the shutdown deadline
+var ErrUndeliveredData = errors.New("undelivered data")
+
+// ErrIngestionQueueFull signals that the ingestion queue of the shard is full
+var ErrIngestionQueueFull = errors.New("ingestion queue is full")
+
+// ErrIngestionQueueNotEnabled signals that the ingestion queue is not enabled
+var ErrIngestionQueueNotEnabled = errors.New("ingestion queue is not enabled")
+
+// ErrQuorumWithoutObserversAuth signals that the observers quorum is enabled without per-observer authentication
+var ErrQuorumWithoutObserversAuth = errors.New("observers quorum requires per-observer authentication")
+
+// ErrInvalidTLSConfig signals that an invalid TLS configuration has been provided
+var ErrInvalidTLSConfig = errors.New("invalid TLS config")
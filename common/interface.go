@@ -9,7 +9,35 @@ import (
 // StatusMetricsHandler defines the behavior of a component that handles status metrics
 type StatusMetricsHandler interface {
 	AddRequest(path string, duration time.Duration)
+	AddCounter(operation string, value uint64)
+	ObserveStage(stage string, duration time.Duration)
+	AddStageError(stage string)
+	ObservePublish(sink string, duration time.Duration)
+	AddEvents(identifier string, shardID uint32, count uint64)
+	SetWSConnections(count int)
+	SetWSSubscriptions(count int)
+	SetSubscriberConnections(subscriber string, count int)
+	AddSubscriberMessage(subscriber string, status string)
+	AddSubscriberRejection(subscriber string, reason string)
+	SetIngestionQueueDepth(shardID uint32, depth int)
 	GetAll() map[string]*data.EndpointMetricsResponse
 	GetMetricsForPrometheus() string
 	IsInterfaceNil() bool
 }
+
+// ObserversTracker defines the behaviour of a component which tracks the pushes of each observer and shard
+type ObserversTracker interface {
+	RecordPush(observerID string, shardID uint32, nonce uint64)
+	RecordError(observerID string)
+	GetObserversHealth() data.ObserversHealth
+	GetMetricsForPrometheus() string
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// HealthService defines the behaviour of a component which aggregates the health of the notifier dependencies
+type HealthService interface {
+	GetHealth() data.HealthStatus
+	GetReadiness() data.HealthStatus
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,49 @@
+package tlsclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+)
+
+// CreateTLSClientConfig builds the TLS config used when connecting, as a client, to rabbitMQ or to the observers.
+// The CA bundle verifies the server certificate, while the client certificate is presented for mutual TLS
+func CreateTLSClientConfig(cfg config.ClientTLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         cfg.ServerName,
+		InsecureSkipVerify: cfg.InsecureSkipVerify,
+	}
+
+	if cfg.CACertFile != "" {
+		caCert, err := ioutil.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("%w: no certificate found in %s", common.ErrInvalidTLSConfig, cfg.CACertFile)
+		}
+		tlsConfig.RootCAs = certPool
+	}
+
+	hasCertFile := cfg.CertFile != ""
+	hasKeyFile := cfg.KeyFile != ""
+	if hasCertFile != hasKeyFile {
+		return nil, fmt.Errorf("%w: both client certificate and key files should be provided", common.ErrInvalidTLSConfig)
+	}
+	if hasCertFile {
+		clientCert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return tlsConfig, nil
+}
@@ -22,14 +22,150 @@ type GeneralConfig struct {
 	Redis        RedisConfig
 	Azure        AzureConfig
 	RabbitMQ     RabbitMQConfig
+	HealthCheck  HealthCheckConfig
+	Tracing      TracingConfig
+	Shutdown     ShutdownConfig
+}
+
+// ShutdownConfig holds the configuration of the graceful shutdown
+type ShutdownConfig struct {
+	DrainTimeoutInSec uint32
+}
+
+// HealthCheckConfig holds the configuration for the health and readiness probes
+type HealthCheckConfig struct {
+	ProbeTimeoutInMs  uint32
+	CacheDurationInMs uint32
+}
+
+// TracingConfig holds the configuration for the OpenTelemetry tracing
+type TracingConfig struct {
+	Enabled       bool
+	Endpoint      string
+	Insecure      bool
+	SamplingRatio float64
+	ServiceName   string
 }
 
 // ConnectorApiConfig maps the connector configuration
 type ConnectorApiConfig struct {
-	Host            string
-	Username        string
-	Password        string
-	CheckDuplicates bool
+	Host             string
+	Username         string
+	Password         string
+	CheckDuplicates  bool
+	MaxBodySizeInMB  uint32
+	TLS              ServerTLSConfig
+	ObserversAuth    ObserversAuthConfig
+	Quorum           QuorumConfig
+	IngestionQueue   IngestionQueueConfig
+	OutportWebSocket OutportWebSocketConfig
+	ObserversHealth  ObserversHealthConfig
+	TxStatusTracker  TxStatusTrackerConfig
+	Admin            AdminApiConfig
+	SubscribersAuth  SubscribersAuthConfig
+}
+
+// SubscribersAuthConfig holds the authentication and quotas configuration of the websocket subscribers
+type SubscribersAuthConfig struct {
+	Enabled    bool
+	JWTSecret  string
+	APIKeys    []SubscriberKeyConfig
+	NativeAuth NativeAuthConfig
+}
+
+// NativeAuthConfig holds the configuration of the MultiversX native-auth tokens accepted from the
+// websocket subscribers, together with the limits applied to each authenticated address
+type NativeAuthConfig struct {
+	Enabled                       bool
+	AcceptedOrigins               []string
+	MaxExpiryInSec                uint64
+	BlockHashesCacheSize          int
+	OwnAddressOnly                bool
+	MaxConnections                uint32
+	MaxSubscriptionsPerConnection uint32
+	AllowedEventTypes             []string
+	MaxMessagesPerSecond          uint32
+}
+
+// SubscriberKeyConfig holds the API key of a websocket subscriber together with its limits
+type SubscriberKeyConfig struct {
+	Name                          string
+	Key                           string
+	MaxConnections                uint32
+	MaxSubscriptionsPerConnection uint32
+	AllowedEventTypes             []string
+	MaxMessagesPerSecond          uint32
+}
+
+// AdminApiConfig holds the credentials needed to access the admin endpoints
+type AdminApiConfig struct {
+	Username string
+	Password string
+}
+
+// ObserversHealthConfig holds the configuration for tracking the observers and shards activity
+type ObserversHealthConfig struct {
+	StaleSourceWindowInSec uint32
+}
+
+// TxStatusTrackerConfig holds the configuration for following the transactions across blocks and shards
+// until they are completed
+type TxStatusTrackerConfig struct {
+	Enabled      bool
+	TimeoutInSec uint32
+	UseRedis     bool
+}
+
+// QuorumConfig holds the configuration for publishing a block only after multiple observers agree on it
+type QuorumConfig struct {
+	Enabled      bool
+	MinObservers uint32
+	TimeoutInMs  uint32
+}
+
+// IngestionQueueConfig holds the configuration of the per shard queues which bound and order the observers
+// pushes before their processing
+type IngestionQueueConfig struct {
+	Enabled                 bool
+	MaxQueuedBlocksPerShard uint32
+}
+
+// OutportWebSocketConfig holds the configuration of the websocket connections to the outport driver of the observers
+type OutportWebSocketConfig struct {
+	Enabled           bool
+	RetryDurationInMs uint32
+	TLS               ClientTLSConfig
+	Observers         []OutportObserverConfig
+}
+
+// OutportObserverConfig holds the outport websocket address of a single observer
+type OutportObserverConfig struct {
+	ID  string
+	URL string
+}
+
+// ObserversAuthConfig holds the per-observer authentication configuration
+type ObserversAuthConfig struct {
+	SignatureReplayWindowInSec uint32
+	Observers                  []ObserverCredentialsConfig
+}
+
+// ObserverCredentialsConfig holds the credentials of a single observer
+type ObserverCredentialsConfig struct {
+	ID       string
+	Username string
+	Password string
+	HMACKey  string
+}
+
+// ServerTLSConfig holds the TLS configuration for the notifier web server
+type ServerTLSConfig struct {
+	Enabled                    bool
+	CertFile                   string
+	KeyFile                    string
+	ReloadIntervalInSec        uint32
+	ObserversCACertFile        string
+	RequireObserverCertificate bool
 }
 
 type AzureConfig struct {
@@ -68,12 +204,38 @@ type RabbitMQConfig struct {
 	Url                     string
 	AzureCredentials        string
 	Topic                   string
+	ServiceBusSendRetries   uint32
+	ServiceBusRetryDelayMs  uint32
+	ServiceBusQueueSize     uint32
+	ConnectionName          string
+	HeartbeatInSec          uint32
+	ChannelMax              uint32
+	TLS                     ClientTLSConfig
 	EventsExchange          RabbitMQExchangeConfig
 	RevertEventsExchange    RabbitMQExchangeConfig
 	FinalizedEventsExchange RabbitMQExchangeConfig
 	BlockTxsExchange        RabbitMQExchangeConfig
 	BlockScrsExchange       RabbitMQExchangeConfig
+	BlockRewardsExchange    RabbitMQExchangeConfig
+	BlockReceiptsExchange   RabbitMQExchangeConfig
+	BlockInvalidTxsExchange RabbitMQExchangeConfig
+	AlteredAccountsExchange RabbitMQExchangeConfig
+	BlockHeaderExchange     RabbitMQExchangeConfig
+	EpochStartExchange      RabbitMQExchangeConfig
+	TxBundlesExchange       RabbitMQExchangeConfig
+	TxStatusExchange        RabbitMQExchangeConfig
 	BlockEventsExchange     RabbitMQExchangeConfig
+	SourceStaleExchange     RabbitMQExchangeConfig
+}
+
+// ClientTLSConfig holds the TLS configuration used when connecting to rabbitMQ or to the observers
+type ClientTLSConfig struct {
+	Enabled            bool
+	CACertFile         string
+	CertFile           string
+	KeyFile            string
+	ServerName         string
+	InsecureSkipVerify bool
 }
 
 // RabbitMQExchangeConfig holds the configuration for a rabbitMQ exchange
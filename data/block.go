@@ -3,6 +3,7 @@ package data
 import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	nodeData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/data/receipt"
 	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
@@ -20,14 +21,28 @@ type SaveBlockData struct {
 
 // InterceptorBlockData holds the block data needed for processing
 type InterceptorBlockData struct {
-	Hash          string
-	Body          nodeData.BodyHandler
-	Header        nodeData.HeaderHandler
-	Txs           map[string]*transaction.Transaction
-	TxsWithOrder  map[string]*NotifierTransaction
-	Scrs          map[string]*smartContractResult.SmartContractResult
-	ScrsWithOrder map[string]*NotifierSmartContractResult
-	LogEvents     []Event
+	Hash                   string
+	Body                   nodeData.BodyHandler
+	Header                 nodeData.HeaderHandler
+	Txs                    []*NotifierTransaction
+	Scrs                   []*NotifierSmartContractResult
+	Rewards                []*NotifierRewardTx
+	Receipts               []*NotifierReceipt
+	InvalidTxs             []*NotifierTransaction
+	AlteredAccounts        map[string]*alteredAccount.AlteredAccount
+	NotarizedHeadersHashes []string
+	HeaderGasConsumption   outport.HeaderGasConsumption
+	LogEvents              []Event
+	TxBundles              []*TxBundle
+}
+
+// ObserverBlockVote holds the details of a block pushed by an observer, used for quorum decisions
+type ObserverBlockVote struct {
+	ObserverID string
+	ShardID    uint32
+	Nonce      uint64
+	Hash       string
+	Digest     string
 }
 
 // ArgsSaveBlockData holds the block data that will be received on push events
@@ -39,7 +54,7 @@ type ArgsSaveBlockData struct {
 	NotarizedHeadersHashes []string
 	HeaderGasConsumption   outport.HeaderGasConsumption
 	TransactionsPool       *TransactionsPool
-	AlteredAccounts        map[string]*outport.AlteredAccount
+	AlteredAccounts        map[string]*alteredAccount.AlteredAccount
 	NumberOfShards         uint32
 	IsImportDB             bool
 }
@@ -0,0 +1,15 @@
+package data
+
+// ComponentHealth holds the result of the last health probe of a component
+type ComponentHealth struct {
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	CheckedAt  int64  `json:"checkedAt"`
+	DurationMs int64  `json:"durationMs"`
+}
+
+// HealthStatus holds the aggregated status together with the per-component breakdown
+type HealthStatus struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentHealth `json:"components"`
+}
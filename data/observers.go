@@ -0,0 +1,37 @@
+package data
+
+// ObserverHealth holds the push statistics of an observer
+type ObserverHealth struct {
+	ObserverID        string  `json:"observerId"`
+	LastShardID       uint32  `json:"lastShardId"`
+	LastPushTimestamp int64   `json:"lastPushTimestamp"`
+	LastNonce         uint64  `json:"lastNonce"`
+	NumPushes         uint64  `json:"numPushes"`
+	NumErrors         uint64  `json:"numErrors"`
+	PushRate          float64 `json:"pushRate"`
+	ErrorRate         float64 `json:"errorRate"`
+}
+
+// ShardHealth holds the push statistics of a shard, aggregated over all its observers
+type ShardHealth struct {
+	ShardID           uint32  `json:"shardId"`
+	LastPushTimestamp int64   `json:"lastPushTimestamp"`
+	LastNonce         uint64  `json:"lastNonce"`
+	NumPushes         uint64  `json:"numPushes"`
+	PushRate          float64 `json:"pushRate"`
+	IsStale           bool    `json:"isStale"`
+}
+
+// ObserversHealth holds the push statistics of all the observers and shards
+type ObserversHealth struct {
+	Observers []ObserverHealth `json:"observers"`
+	Shards    []ShardHealth    `json:"shards"`
+}
+
+// SourceStaleEvent holds the details of a shard from which no block arrived in the configured window
+type SourceStaleEvent struct {
+	ShardID           uint32 `json:"shardId"`
+	LastPushTimestamp int64  `json:"lastPushTimestamp"`
+	LastNonce         uint64 `json:"lastNonce"`
+	StaleForSeconds   uint64 `json:"staleForSeconds"`
+}
@@ -3,6 +3,7 @@ package data
 import (
 	"encoding/json"
 
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/data/receipt"
 	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
@@ -26,53 +27,217 @@ type Event struct {
 	Topics          [][]byte `json:"topics"`
 	Data            []byte   `json:"data"`
 	TxHash          string   `json:"txHash"`
+
+	// block context of the event. The event index is the position of the event in the block,
+	// which together with the block hash gives a unique event ID
+	BlockHash      string `json:"blockHash"`
+	ShardID        uint32 `json:"shardId"`
+	BlockNonce     uint64 `json:"blockNonce"`
+	BlockRound     uint64 `json:"blockRound"`
+	BlockTimeStamp uint64 `json:"blockTimestamp"`
+	ExecutionOrder int    `json:"executionOrder"`
+	EventIndex     int    `json:"eventIndex"`
 }
 
 // BlockEvents holds events data for a block
 type BlockEvents struct {
-	Hash      string  `json:"hash"`
-	ShardID   uint32  `json:"shardId"`
-	TimeStamp uint64  `json:"timestamp"`
-	Events    []Event `json:"events"`
+	Hash         string            `json:"hash"`
+	ShardID      uint32            `json:"shardId"`
+	Nonce        uint64            `json:"nonce"`
+	Round        uint64            `json:"round"`
+	Epoch        uint32            `json:"epoch"`
+	TimeStamp    uint64            `json:"timestamp"`
+	Events       []Event           `json:"events"`
+	TraceContext map[string]string `json:"-"`
 }
 
 // RevertBlock holds revert event data
 type RevertBlock struct {
-	Hash  string `json:"hash"`
-	Nonce uint64 `json:"nonce"`
-	Round uint64 `json:"round"`
-	Epoch uint32 `json:"epoch"`
+	Hash         string            `json:"hash"`
+	Nonce        uint64            `json:"nonce"`
+	Round        uint64            `json:"round"`
+	Epoch        uint32            `json:"epoch"`
+	TraceContext map[string]string `json:"-"`
 }
 
 // FinalizedBlock holds finalized block data
 type FinalizedBlock struct {
-	Hash string `json:"hash"`
+	Hash         string            `json:"hash"`
+	TraceContext map[string]string `json:"-"`
 }
 
-// BlockTxs holds the block transactions
+// BlockTxs holds the block transactions, ordered by their execution order
 type BlockTxs struct {
-	Hash string                              `json:"hash"`
-	Txs  map[string]*transaction.Transaction `json:"txs"`
+	Hash         string                 `json:"hash"`
+	Txs          []*NotifierTransaction `json:"txs"`
+	TraceContext map[string]string      `json:"-"`
 }
 
-// BlockScrs holds the block smart contract results
+// BlockScrs holds the block smart contract results, ordered by their execution order
 type BlockScrs struct {
-	Hash string                                              `json:"hash"`
-	Scrs map[string]*smartContractResult.SmartContractResult `json:"scrs"`
+	Hash         string                         `json:"hash"`
+	Scrs         []*NotifierSmartContractResult `json:"scrs"`
+	TraceContext map[string]string              `json:"-"`
+}
+
+// BlockRewards holds the block reward transactions, ordered by their execution order
+type BlockRewards struct {
+	Hash         string              `json:"hash"`
+	Rewards      []*NotifierRewardTx `json:"rewards"`
+	TraceContext map[string]string   `json:"-"`
+}
+
+// BlockReceipts holds the block receipts, ordered by their execution order
+type BlockReceipts struct {
+	Hash         string             `json:"hash"`
+	Receipts     []*NotifierReceipt `json:"receipts"`
+	TraceContext map[string]string  `json:"-"`
+}
+
+// BlockInvalidTxs holds the block invalid transactions, ordered by their execution order
+type BlockInvalidTxs struct {
+	Hash         string                 `json:"hash"`
+	InvalidTxs   []*NotifierTransaction `json:"invalidTxs"`
+	TraceContext map[string]string      `json:"-"`
+}
+
+// BlockAlteredAccounts holds the accounts altered in a block, mapped by address
+type BlockAlteredAccounts struct {
+	Hash            string                                    `json:"hash"`
+	AlteredAccounts map[string]*alteredAccount.AlteredAccount `json:"alteredAccounts"`
+	TraceContext    map[string]string                         `json:"-"`
+}
+
+// BlockHeader holds a compact summary of a block header. The notarized headers hashes are
+// set only for the metachain blocks
+type BlockHeader struct {
+	Hash                   string            `json:"hash"`
+	ShardID                uint32            `json:"shardId"`
+	Nonce                  uint64            `json:"nonce"`
+	Round                  uint64            `json:"round"`
+	Epoch                  uint32            `json:"epoch"`
+	TimeStamp              uint64            `json:"timestamp"`
+	PrevHash               string            `json:"prevHash"`
+	TxCount                uint32            `json:"txCount"`
+	AccumulatedFees        string            `json:"accumulatedFees"`
+	DeveloperFees          string            `json:"developerFees"`
+	GasProvided            uint64            `json:"gasProvided"`
+	GasRefunded            uint64            `json:"gasRefunded"`
+	GasPenalized           uint64            `json:"gasPenalized"`
+	MaxGasPerBlock         uint64            `json:"maxGasPerBlock"`
+	NotarizedHeadersHashes []string          `json:"notarizedHeadersHashes,omitempty"`
+	TraceContext           map[string]string `json:"-"`
+}
+
+// EpochStart holds the epoch start data of a metachain block
+type EpochStart struct {
+	Hash                 string                  `json:"hash"`
+	Epoch                uint32                  `json:"epoch"`
+	Round                uint64                  `json:"round"`
+	Nonce                uint64                  `json:"nonce"`
+	TimeStamp            uint64                  `json:"timestamp"`
+	Economics            EpochStartEconomics     `json:"economics"`
+	LastFinalizedHeaders []EpochStartShardHeader `json:"lastFinalizedHeaders"`
+	TraceContext         map[string]string       `json:"-"`
+}
+
+// EpochStartEconomics holds the economics data of an epoch start metachain block
+type EpochStartEconomics struct {
+	TotalSupply                      string `json:"totalSupply"`
+	TotalToDistribute                string `json:"totalToDistribute"`
+	TotalNewlyMinted                 string `json:"totalNewlyMinted"`
+	RewardsPerBlock                  string `json:"rewardsPerBlock"`
+	RewardsForProtocolSustainability string `json:"rewardsForProtocolSustainability"`
+	NodePrice                        string `json:"nodePrice"`
+	PrevEpochStartRound              uint64 `json:"prevEpochStartRound"`
+	PrevEpochStartHash               string `json:"prevEpochStartHash"`
+}
+
+// EpochStartShardHeader holds the last finalized header of a shard, as recorded in an epoch start metachain block
+type EpochStartShardHeader struct {
+	ShardID               uint32 `json:"shardId"`
+	Epoch                 uint32 `json:"epoch"`
+	Round                 uint64 `json:"round"`
+	Nonce                 uint64 `json:"nonce"`
+	HeaderHash            string `json:"headerHash"`
+	RootHash              string `json:"rootHash"`
+	FirstPendingMetaBlock string `json:"firstPendingMetaBlock"`
+	LastFinishedMetaBlock string `json:"lastFinishedMetaBlock"`
+}
+
+// BlockTxBundles holds the block transactions, each one grouped with its smart contract results and events
+type BlockTxBundles struct {
+	Hash         string            `json:"hash"`
+	ShardID      uint32            `json:"shardId"`
+	Nonce        uint64            `json:"nonce"`
+	Round        uint64            `json:"round"`
+	Epoch        uint32            `json:"epoch"`
+	TimeStamp    uint64            `json:"timestamp"`
+	Bundles      []*TxBundle       `json:"bundles"`
+	TraceContext map[string]string `json:"-"`
+}
+
+// TxBundle holds an originating transaction together with its smart contract results, events,
+// fee info and derived status. The transaction is not set if it was executed in another shard
+type TxBundle struct {
+	TxHash         string                   `json:"txHash"`
+	Sender         string                   `json:"sender"`
+	Receiver       string                   `json:"receiver"`
+	Tx             *transaction.Transaction `json:"tx,omitempty"`
+	FeeInfo        outport.FeeInfo          `json:"feeInfo"`
+	ExecutionOrder int                      `json:"executionOrder"`
+	Status         string                   `json:"status"`
+	Scrs           []*ScrNode               `json:"scrs"`
+	Events         []Event                  `json:"events"`
+}
+
+// ScrNode holds a smart contract result of a bundle, together with the smart contract results generated by it
+type ScrNode struct {
+	Hash           string                                   `json:"hash"`
+	Scr            *smartContractResult.SmartContractResult `json:"scr"`
+	ExecutionOrder int                                      `json:"executionOrder"`
+	Children       []*ScrNode                               `json:"children,omitempty"`
+}
+
+// TxStatus holds the final status of a transaction. The block fields refer to the block in which
+// the transaction was completed, and are not set on timeout
+type TxStatus struct {
+	TxHash       string            `json:"txHash"`
+	Sender       string            `json:"sender"`
+	Receiver     string            `json:"receiver"`
+	Status       string            `json:"status"`
+	BlockHash    string            `json:"blockHash,omitempty"`
+	ShardID      uint32            `json:"shardId"`
+	BlockNonce   uint64            `json:"blockNonce,omitempty"`
+	TimeStamp    uint64            `json:"timestamp,omitempty"`
+	TraceContext map[string]string `json:"-"`
+}
+
+// TrackedTx holds a pending transaction followed by the tx status tracker
+type TrackedTx struct {
+	TxHash      string `json:"txHash"`
+	Sender      string `json:"sender"`
+	Receiver    string `json:"receiver"`
+	FirstSeenMs int64  `json:"firstSeenMs"`
 }
 
-// BlockEventsWithOrder holds the block transactions with order
+// BlockEventsWithOrder holds the block transactions and smart contract results, ordered by their execution order
 type BlockEventsWithOrder struct {
-	Hash      string                                  `json:"hash"`
-	ShardID   uint32                                  `json:"shardID"`
-	TimeStamp uint64                                  `json:"timestamp"`
-	Txs       map[string]*NotifierTransaction         `json:"txs"`
-	Scrs      map[string]*NotifierSmartContractResult `json:"scrs"`
-	Events    []Event                                 `json:"events"`
+	Hash         string                         `json:"hash"`
+	ShardID      uint32                         `json:"shardID"`
+	Nonce        uint64                         `json:"nonce"`
+	Round        uint64                         `json:"round"`
+	Epoch        uint32                         `json:"epoch"`
+	TimeStamp    uint64                         `json:"timestamp"`
+	Txs          []*NotifierTransaction         `json:"txs"`
+	Scrs         []*NotifierSmartContractResult `json:"scrs"`
+	Events       []Event                        `json:"events"`
+	TraceContext map[string]string              `json:"-"`
 }
 
 // NotifierTransaction defines a wrapper over transaction
 type NotifierTransaction struct {
+	Hash string
 	*transaction.Transaction
 	outport.FeeInfo
 	ExecutionOrder int
@@ -80,6 +245,7 @@ type NotifierTransaction struct {
 
 // NotifierSmartContractResult defines a wrapper over scr
 type NotifierSmartContractResult struct {
+	Hash string
 	*smartContractResult.SmartContractResult
 	outport.FeeInfo
 	ExecutionOrder int
@@ -87,6 +253,7 @@ type NotifierSmartContractResult struct {
 
 // NotifierRewardTx defines a wrapper over rewardTx
 type NotifierRewardTx struct {
+	Hash string
 	*rewardTx.RewardTx
 	outport.FeeInfo
 	ExecutionOrder int
@@ -94,6 +261,7 @@ type NotifierRewardTx struct {
 
 // NotifierReceipt defines a wrapper over receipt
 type NotifierReceipt struct {
+	Hash string
 	*receipt.Receipt
 	outport.FeeInfo
 	ExecutionOrder int
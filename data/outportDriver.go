@@ -0,0 +1,46 @@
+package data
+
+// OutportDriverWSRoute is the route on which the websocket outport driver of the observers sends its data
+const OutportDriverWSRoute = "/save"
+
+// OperationType defines the type of an operation sent by the websocket outport driver of the observers
+type OperationType uint8
+
+const (
+	// OperationSaveBlock is the operation that triggers a block saving
+	OperationSaveBlock OperationType = 0
+	// OperationRevertIndexedBlock is the operation that triggers a reverting of an indexed block
+	OperationRevertIndexedBlock OperationType = 1
+	// OperationSaveRoundsInfo is the operation that triggers the saving of rounds info
+	OperationSaveRoundsInfo OperationType = 2
+	// OperationSaveValidatorsPubKeys is the operation that triggers the saving of validators' public keys
+	OperationSaveValidatorsPubKeys OperationType = 3
+	// OperationSaveValidatorsRating is the operation that triggers the saving of the validators' rating
+	OperationSaveValidatorsRating OperationType = 4
+	// OperationSaveAccounts is the operation that triggers the saving of accounts
+	OperationSaveAccounts OperationType = 5
+	// OperationFinalizedBlock is the operation that triggers the handling of a finalized block
+	OperationFinalizedBlock OperationType = 6
+)
+
+// String returns the string representation of the operation
+func (ot OperationType) String() string {
+	switch ot {
+	case OperationSaveBlock:
+		return "SaveBlock"
+	case OperationRevertIndexedBlock:
+		return "RevertIndexedBlock"
+	case OperationSaveRoundsInfo:
+		return "SaveRoundsInfo"
+	case OperationSaveValidatorsPubKeys:
+		return "SaveValidatorsPubKeys"
+	case OperationSaveValidatorsRating:
+		return "SaveValidatorsRating"
+	case OperationSaveAccounts:
+		return "SaveAccounts"
+	case OperationFinalizedBlock:
+		return "FinalizedBlock"
+	default:
+		return "Unknown"
+	}
+}
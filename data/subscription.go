@@ -6,6 +6,11 @@ import "github.com/google/uuid"
 type SubscribeEvent struct {
 	DispatcherID        uuid.UUID
 	SubscriptionEntries []SubscriptionEntry `json:"subscriptionEntries"`
+
+	// AuthenticatedAddress is the address proven by the subscriber, if any. When OwnAddressOnly
+	// is set, the subscriptions are restricted to the events of this address
+	AuthenticatedAddress string `json:"-"`
+	OwnAddressOnly       bool   `json:"-"`
 }
 
 // SubscriptionEntry holds the subscription entry data
@@ -14,14 +19,54 @@ type SubscriptionEntry struct {
 	Address    string   `json:"address"`
 	Identifier string   `json:"identifier"`
 	Topics     []string `json:"topics"`
+	TxHash     string   `json:"txHash"`
 }
 
 // Subscription holds subscription data
 type Subscription struct {
-	Address      string
-	Identifier   string
-	Topics       []string
-	MatchLevel   string
-	EventType    string
-	DispatcherID uuid.UUID
+	Address      string    `json:"address"`
+	Identifier   string    `json:"identifier"`
+	Topics       []string  `json:"topics"`
+	TxHash       string    `json:"txHash"`
+	MatchLevel   string    `json:"matchLevel"`
+	EventType    string    `json:"eventType"`
+	DispatcherID uuid.UUID `json:"dispatcherID"`
+}
+
+// DispatcherInfo holds the details of a connected dispatcher
+type DispatcherInfo struct {
+	ID            uuid.UUID      `json:"id"`
+	Subscriber    string         `json:"subscriber"`
+	Address       string         `json:"address,omitempty"`
+	RemoteAddress string         `json:"remoteAddress"`
+	ConnectedAt   int64          `json:"connectedAt"`
+	QueueDepth    int            `json:"queueDepth"`
+	MessagesSent  uint64         `json:"messagesSent"`
+	Subscriptions []Subscription `json:"subscriptions"`
+}
+
+// SubscriptionsStats holds the subscriptions aggregated by event type and by match level
+type SubscriptionsStats struct {
+	NumDispatchers   int            `json:"numDispatchers"`
+	NumSubscriptions int            `json:"numSubscriptions"`
+	ByEventType      map[string]int `json:"byEventType"`
+	ByMatchLevel     map[string]int `json:"byMatchLevel"`
+}
+
+// SubscriberQuota holds the name of an authenticated websocket subscriber together with its limits
+// A zero limit or an empty list of allowed event types means no restriction. The address is set for
+// the subscribers authenticated with native-auth, in which case the connections are counted per address
+type SubscriberQuota struct {
+	Name                          string   `json:"name"`
+	Address                       string   `json:"address,omitempty"`
+	OwnAddressOnly                bool     `json:"ownAddressOnly"`
+	MaxConnections                uint32   `json:"maxConnections"`
+	MaxSubscriptionsPerConnection uint32   `json:"maxSubscriptionsPerConnection"`
+	AllowedEventTypes             []string `json:"allowedEventTypes"`
+	MaxMessagesPerSecond          uint32   `json:"maxMessagesPerSecond"`
+}
+
+// SubscriptionError holds the reason for which a websocket subscription was rejected
+type SubscriptionError struct {
+	Error string `json:"error"`
 }
@@ -0,0 +1,186 @@
+package decoders
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	nodeData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+// PayloadFormat defines the format of the block data pushed by observers
+type PayloadFormat string
+
+const (
+	// LegacyJSONFormat defines the JSON ArgsSaveBlockData format, with a HeaderType field
+	LegacyJSONFormat PayloadFormat = "legacy-json"
+	// OutportBlockJSONFormat defines the JSON encoded outport.OutportBlock format
+	OutportBlockJSONFormat PayloadFormat = "outport-block-json"
+	// OutportBlockProtoFormat defines the gogo protobuf encoded outport.OutportBlock format
+	OutportBlockProtoFormat PayloadFormat = "outport-block-proto"
+)
+
+const (
+	jsonContentType        = "application/json"
+	protobufContentType    = "application/x-protobuf"
+	altProtobufContentType = "application/protobuf"
+	octetStreamContentType = "application/octet-stream"
+)
+
+// DecodeBlockData decodes the block data pushed by an observer, in any of the supported formats. The
+// format is taken from the content type, if provided, otherwise it is detected from the payload
+func DecodeBlockData(payload []byte, contentType string) (*data.ArgsSaveBlockData, error) {
+	return DecodeBlockDataFromReader(bytes.NewReader(payload), contentType)
+}
+
+// DecodeBlockDataFromReader decodes the block data pushed by an observer while reading it. JSON payloads
+// are decoded in a single pass, without keeping the raw payload in memory
+func DecodeBlockDataFromReader(reader io.Reader, contentType string) (*data.ArgsSaveBlockData, error) {
+	bufferedReader := bufio.NewReader(reader)
+	if !isJSONPayload(bufferedReader, contentType) {
+		payload, err := ioutil.ReadAll(bufferedReader)
+		if err != nil {
+			return nil, err
+		}
+
+		return unmarshallOutportBlockProto(payload)
+	}
+
+	var blockData jsonBlockData
+	decoder := json.NewDecoder(bufferedReader)
+	err := decoder.Decode(&blockData)
+	if err != nil {
+		return nil, err
+	}
+	_, err = decoder.Token()
+	if err != io.EOF {
+		return nil, fmt.Errorf("%w after the block data", ErrInvalidJSONPayload)
+	}
+
+	return blockData.toArgsSaveBlockData()
+}
+
+// isJSONPayload checks the content type, if provided, otherwise the first non space character of the payload
+func isJSONPayload(reader *bufio.Reader, contentType string) bool {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch strings.ToLower(mediaType) {
+	case protobufContentType, altProtobufContentType, octetStreamContentType:
+		return false
+	case jsonContentType:
+		return true
+	}
+
+	for i := 1; i <= reader.Size(); i++ {
+		peeked, err := reader.Peek(i)
+		if err != nil {
+			return false
+		}
+
+		character := peeked[i-1]
+		if !isSpace(character) {
+			return character == '{'
+		}
+	}
+
+	return false
+}
+
+func isSpace(character byte) bool {
+	return character == ' ' || character == '\t' || character == '\n' || character == '\r'
+}
+
+// GetPayloadFormat returns the format of the provided block data payload
+func GetPayloadFormat(payload []byte, contentType string) PayloadFormat {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch strings.ToLower(mediaType) {
+	case protobufContentType, altProtobufContentType, octetStreamContentType:
+		return OutportBlockProtoFormat
+	case jsonContentType:
+		return getJSONPayloadFormat(payload)
+	}
+
+	trimmedPayload := bytes.TrimSpace(payload)
+	if len(trimmedPayload) > 0 && trimmedPayload[0] == '{' {
+		return getJSONPayloadFormat(payload)
+	}
+
+	return OutportBlockProtoFormat
+}
+
+// getJSONPayloadFormat distinguishes between the two JSON formats: only the outport block has a blockData field
+func getJSONPayloadFormat(payload []byte) PayloadFormat {
+	formatStruct := struct {
+		BlockData json.RawMessage `json:"blockData"`
+	}{}
+
+	err := json.Unmarshal(payload, &formatStruct)
+	if err == nil && len(formatStruct.BlockData) > 0 && string(formatStruct.BlockData) != "null" {
+		return OutportBlockJSONFormat
+	}
+
+	return LegacyJSONFormat
+}
+
+// UnmarshallBlockDataV2 will try to unmarshal block data v2
+func UnmarshallBlockDataV2(marshalledData []byte) (*data.ArgsSaveBlockData, error) {
+	var blockData jsonBlockData
+	err := json.Unmarshal(marshalledData, &blockData)
+	if err != nil {
+		return nil, err
+	}
+
+	return blockData.toLegacyArgsSaveBlockData()
+}
+
+// UnmarshallHeader will try to unmarshal a JSON block header based on its HeaderType field
+func UnmarshallHeader(marshalledData []byte) (nodeData.HeaderHandler, error) {
+	headerStruct := struct {
+		HeaderType core.HeaderType
+		Header     json.RawMessage
+	}{}
+
+	err := json.Unmarshal(marshalledData, &headerStruct)
+	if err != nil {
+		return nil, err
+	}
+
+	return unmarshallJSONHeader(headerStruct.HeaderType, headerStruct.Header)
+}
+
+func unmarshallJSONHeader(headerType core.HeaderType, marshalledHeader json.RawMessage) (nodeData.HeaderHandler, error) {
+	header, err := createHeader(headerType)
+	if err != nil {
+		return nil, err
+	}
+	if len(marshalledHeader) == 0 || string(marshalledHeader) == "null" {
+		return nil, nil
+	}
+
+	err = json.Unmarshal(marshalledHeader, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+func createHeader(headerType core.HeaderType) (nodeData.HeaderHandler, error) {
+	switch headerType {
+	case core.MetaHeader:
+		return &block.MetaBlock{}, nil
+	case core.ShardHeaderV1:
+		return &block.Header{}, nil
+	case core.ShardHeaderV2:
+		return &block.HeaderV2{}, nil
+	default:
+		return nil, ErrInvalidHeaderType
+	}
+}
@@ -0,0 +1,331 @@
+package decoders_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/decoders"
+	"github.com/stretchr/testify/require"
+)
+
+func createLegacyPayload(t *testing.T) []byte {
+	saveBlock := data.ArgsSaveBlock{
+		HeaderType: core.ShardHeaderV2,
+		ArgsSaveBlockData: data.ArgsSaveBlockData{
+			HeaderHash: []byte("headerHash"),
+			Body:       &block.Body{},
+			Header: &block.HeaderV2{
+				Header: &block.Header{Nonce: 1},
+			},
+			TransactionsPool: &data.TransactionsPool{},
+		},
+	}
+
+	payload, err := json.Marshal(saveBlock)
+	require.Nil(t, err)
+
+	return payload
+}
+
+func createOutportBlockJSONPayload(t *testing.T) []byte {
+	headerBytes, err := json.Marshal(&block.Header{Nonce: 5, ShardID: 1})
+	require.Nil(t, err)
+	txBytes, err := json.Marshal(&transaction.Transaction{Nonce: 2, Value: big.NewInt(10)})
+	require.Nil(t, err)
+	headerBytesJSON, err := json.Marshal(headerBytes)
+	require.Nil(t, err)
+
+	return []byte(fmt.Sprintf(`{
+		"shardID": 1,
+		"blockData": {
+			"shardID": 1,
+			"headerBytes": %s,
+			"headerType": "Header",
+			"headerHash": "aGVhZGVySGFzaA=="
+		},
+		"transactionPool": {
+			"transactions": {
+				"7478": {
+					"transaction": %s,
+					"feeInfo": {"gasUsed": 50000, "fee": 1000, "initialPaidFee": 2000},
+					"executionOrder": 3
+				}
+			},
+			"logs": [{"txHash": "7478", "log": {"address": "YWRkcg==", "events": []}}]
+		},
+		"alteredAccounts": {
+			"erd1": {"address": "erd1", "nonce": 7, "additionalAccountData": {"isSender": true}}
+		},
+		"numberOfShards": 3,
+		"signersIndexes": [1, 2]
+	}`, headerBytesJSON, txBytes))
+}
+
+func createOutportBlockProtoPayload(t *testing.T) []byte {
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	headerBytes, err := marshaller.Marshal(&block.HeaderV2{Header: &block.Header{Nonce: 5, ShardID: 1}})
+	require.Nil(t, err)
+
+	outportBlock := &outport.OutportBlock{
+		ShardID: 1,
+		BlockData: &outport.BlockData{
+			ShardID:     1,
+			HeaderBytes: headerBytes,
+			HeaderType:  string(core.ShardHeaderV2),
+			HeaderHash:  []byte("headerHash"),
+			Body:        &block.Body{MiniBlocks: []*block.MiniBlock{{SenderShardID: 1}}},
+		},
+		TransactionPool: &outport.TransactionPool{
+			Transactions: map[string]*outport.TxInfo{
+				"7478": {
+					Transaction:    &transaction.Transaction{Nonce: 2, Value: big.NewInt(10)},
+					FeeInfo:        &outport.FeeInfo{GasUsed: 50000, Fee: big.NewInt(1000), InitialPaidFee: big.NewInt(2000)},
+					ExecutionOrder: 4,
+				},
+			},
+			SmartContractResults: map[string]*outport.SCRInfo{
+				"736372": {
+					SmartContractResult: &smartContractResult.SmartContractResult{Nonce: 3, Value: big.NewInt(0)},
+					FeeInfo:             &outport.FeeInfo{Fee: big.NewInt(0), InitialPaidFee: big.NewInt(0)},
+					ExecutionOrder:      5,
+				},
+			},
+			Rewards: map[string]*outport.RewardInfo{
+				"726577617264": {
+					Reward:         &rewardTx.RewardTx{Value: big.NewInt(300)},
+					ExecutionOrder: 1,
+				},
+			},
+			Logs: []*outport.LogData{
+				{TxHash: "7478", Log: &transaction.Log{Address: []byte("addr")}},
+			},
+		},
+		HeaderGasConsumption: &outport.HeaderGasConsumption{GasProvided: 100, MaxGasPerBlock: 1000},
+		AlteredAccounts: map[string]*alteredAccount.AlteredAccount{
+			"erd1": {
+				Address: "erd1",
+				Nonce:   7,
+				Balance: "100",
+				Tokens: []*alteredAccount.AccountTokenData{
+					{Nonce: 1, Identifier: "NFT-abcdef", Balance: "1"},
+				},
+				AdditionalData: &alteredAccount.AdditionalAccountData{IsSender: true},
+			},
+		},
+		NotarizedHeadersHashes: []string{"notarized"},
+		NumberOfShards:         3,
+		SignersIndexes:         []uint64{1, 300},
+		HighestFinalBlockNonce: 4,
+		HighestFinalBlockHash:  []byte("finalHash"),
+	}
+
+	payload, err := marshaller.Marshal(outportBlock)
+	require.Nil(t, err)
+
+	return payload
+}
+
+func TestGetPayloadFormat(t *testing.T) {
+	t.Parallel()
+
+	legacyPayload := createLegacyPayload(t)
+	outportBlockPayload := createOutportBlockJSONPayload(t)
+
+	require.Equal(t, decoders.LegacyJSONFormat, decoders.GetPayloadFormat(legacyPayload, ""))
+	require.Equal(t, decoders.LegacyJSONFormat, decoders.GetPayloadFormat(legacyPayload, "application/json; charset=utf-8"))
+	require.Equal(t, decoders.OutportBlockJSONFormat, decoders.GetPayloadFormat(outportBlockPayload, ""))
+	require.Equal(t, decoders.OutportBlockJSONFormat, decoders.GetPayloadFormat(outportBlockPayload, "application/json"))
+	require.Equal(t, decoders.OutportBlockProtoFormat, decoders.GetPayloadFormat([]byte{0x08, 0x01}, ""))
+	require.Equal(t, decoders.OutportBlockProtoFormat, decoders.GetPayloadFormat(legacyPayload, "application/x-protobuf"))
+}
+
+func TestDecodeBlockData(t *testing.T) {
+	t.Parallel()
+
+	t.Run("legacy json should work", func(t *testing.T) {
+		t.Parallel()
+
+		argsSaveBlockData, err := decoders.DecodeBlockData(createLegacyPayload(t), "application/json")
+		require.Nil(t, err)
+		require.Equal(t, []byte("headerHash"), argsSaveBlockData.HeaderHash)
+		require.Equal(t, uint64(1), argsSaveBlockData.Header.GetNonce())
+	})
+
+	t.Run("outport block json should keep fee info and execution order", func(t *testing.T) {
+		t.Parallel()
+
+		argsSaveBlockData, err := decoders.DecodeBlockData(createOutportBlockJSONPayload(t), "")
+		require.Nil(t, err)
+
+		require.Equal(t, []byte("headerHash"), argsSaveBlockData.HeaderHash)
+		require.Equal(t, &block.Header{Nonce: 5, ShardID: 1}, argsSaveBlockData.Header)
+		require.Equal(t, uint32(3), argsSaveBlockData.NumberOfShards)
+		require.Equal(t, []uint64{1, 2}, argsSaveBlockData.SignersIndexes)
+
+		tx := argsSaveBlockData.TransactionsPool.Txs["7478"]
+		require.NotNil(t, tx)
+		require.Equal(t, uint64(2), tx.TransactionHandler.Nonce)
+		require.Equal(t, 3, tx.ExecutionOrder)
+		require.Equal(t, uint64(50000), tx.GasUsed)
+		require.Equal(t, big.NewInt(1000), tx.Fee)
+		require.Equal(t, big.NewInt(2000), tx.InitialPaidFee)
+
+		require.Len(t, argsSaveBlockData.TransactionsPool.Logs, 1)
+		require.Equal(t, "7478", argsSaveBlockData.TransactionsPool.Logs[0].TxHash)
+		require.Equal(t, []byte("addr"), argsSaveBlockData.TransactionsPool.Logs[0].LogHandler.Address)
+
+		require.Equal(t, &alteredAccount.AlteredAccount{
+			Address:        "erd1",
+			Nonce:          7,
+			AdditionalData: &alteredAccount.AdditionalAccountData{IsSender: true},
+		}, argsSaveBlockData.AlteredAccounts["erd1"])
+	})
+
+	t.Run("legacy json should keep the altered accounts additional data", func(t *testing.T) {
+		t.Parallel()
+
+		payload := []byte(`{
+			"headerType": "Header",
+			"header": {"nonce": 1},
+			"alteredAccounts": {"erd1": {"address": "erd1", "additionalData": {"isSender": true}}}
+		}`)
+		argsSaveBlockData, err := decoders.DecodeBlockData(payload, "")
+		require.Nil(t, err)
+		require.Equal(t, &alteredAccount.AlteredAccount{
+			Address:        "erd1",
+			AdditionalData: &alteredAccount.AdditionalAccountData{IsSender: true},
+		}, argsSaveBlockData.AlteredAccounts["erd1"])
+	})
+
+	t.Run("outport block proto should decode all the fields", func(t *testing.T) {
+		t.Parallel()
+
+		payload := createOutportBlockProtoPayload(t)
+		require.Equal(t, decoders.OutportBlockProtoFormat, decoders.GetPayloadFormat(payload, ""))
+
+		argsSaveBlockData, err := decoders.DecodeBlockData(payload, "application/x-protobuf")
+		require.Nil(t, err)
+
+		require.Equal(t, []byte("headerHash"), argsSaveBlockData.HeaderHash)
+		require.Equal(t, &block.HeaderV2{Header: &block.Header{Nonce: 5, ShardID: 1}}, argsSaveBlockData.Header)
+		require.Equal(t, &block.Body{MiniBlocks: []*block.MiniBlock{{SenderShardID: 1}}}, argsSaveBlockData.Body)
+		require.Equal(t, uint32(3), argsSaveBlockData.NumberOfShards)
+		require.Equal(t, []uint64{1, 300}, argsSaveBlockData.SignersIndexes)
+		require.Equal(t, []string{"notarized"}, argsSaveBlockData.NotarizedHeadersHashes)
+		require.Equal(t, outport.HeaderGasConsumption{GasProvided: 100, MaxGasPerBlock: 1000}, argsSaveBlockData.HeaderGasConsumption)
+
+		tx := argsSaveBlockData.TransactionsPool.Txs["7478"]
+		require.NotNil(t, tx)
+		require.Equal(t, uint64(2), tx.TransactionHandler.Nonce)
+		require.Equal(t, big.NewInt(10), tx.TransactionHandler.Value)
+		require.Equal(t, 4, tx.ExecutionOrder)
+		require.Equal(t, uint64(50000), tx.GasUsed)
+		require.Equal(t, big.NewInt(1000), tx.Fee)
+		require.Equal(t, big.NewInt(2000), tx.InitialPaidFee)
+
+		scr := argsSaveBlockData.TransactionsPool.Scrs["736372"]
+		require.NotNil(t, scr)
+		require.Equal(t, uint64(3), scr.TransactionHandler.Nonce)
+		require.Equal(t, 5, scr.ExecutionOrder)
+
+		reward := argsSaveBlockData.TransactionsPool.Rewards["726577617264"]
+		require.NotNil(t, reward)
+		require.Equal(t, big.NewInt(300), reward.TransactionHandler.Value)
+		require.Equal(t, 1, reward.ExecutionOrder)
+
+		require.Len(t, argsSaveBlockData.TransactionsPool.Logs, 1)
+		require.Equal(t, "7478", argsSaveBlockData.TransactionsPool.Logs[0].TxHash)
+		require.Equal(t, []byte("addr"), argsSaveBlockData.TransactionsPool.Logs[0].LogHandler.Address)
+
+		require.Equal(t, &alteredAccount.AlteredAccount{
+			Address: "erd1",
+			Nonce:   7,
+			Balance: "100",
+			Tokens: []*alteredAccount.AccountTokenData{
+				{Nonce: 1, Identifier: "NFT-abcdef", Balance: "1"},
+			},
+			AdditionalData: &alteredAccount.AdditionalAccountData{IsSender: true},
+		}, argsSaveBlockData.AlteredAccounts["erd1"])
+	})
+
+	t.Run("truncated outport block proto should error", func(t *testing.T) {
+		t.Parallel()
+
+		payload := createOutportBlockProtoPayload(t)
+
+		_, err := decoders.DecodeBlockData(payload[:len(payload)-3], "application/x-protobuf")
+		require.True(t, errors.Is(err, decoders.ErrInvalidProtoPayload))
+	})
+
+	t.Run("outport block without block data should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := decoders.DecodeBlockData([]byte(`{"shardID": 1}`), "application/x-protobuf")
+		require.NotNil(t, err)
+
+		_, err = decoders.DecodeBlockData([]byte{0x08, 0x01}, "")
+		require.Equal(t, decoders.ErrNilBlockData, err)
+	})
+
+	t.Run("invalid header type should error", func(t *testing.T) {
+		t.Parallel()
+
+		payload := []byte(`{"blockData": {"headerType": "unknown", "headerBytes": "e30="}}`)
+		_, err := decoders.DecodeBlockData(payload, "")
+		require.True(t, errors.Is(err, decoders.ErrInvalidHeaderType))
+	})
+}
+
+func TestDecodeBlockDataFromReader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("trailing data, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		payload := append(createLegacyPayload(t), []byte(" {}")...)
+
+		saveBlockData, err := decoders.DecodeBlockDataFromReader(bytes.NewReader(payload), "")
+		require.True(t, errors.Is(err, decoders.ErrInvalidJSONPayload))
+		require.Nil(t, saveBlockData)
+	})
+
+	t.Run("compressed legacy payload, should work", func(t *testing.T) {
+		t.Parallel()
+
+		payload := append([]byte("\n  "), createLegacyPayload(t)...)
+		reader, err := decoders.NewBodyReader(bytes.NewReader(gzipPayload(t, payload)), "gzip", 0)
+		require.Nil(t, err)
+
+		saveBlockData, err := decoders.DecodeBlockDataFromReader(reader, "")
+		require.Nil(t, err)
+		require.Equal(t, []byte("headerHash"), saveBlockData.HeaderHash)
+		require.Equal(t, uint64(1), saveBlockData.Header.GetNonce())
+	})
+
+	t.Run("compressed outport block payload, should work", func(t *testing.T) {
+		t.Parallel()
+
+		reader, err := decoders.NewBodyReader(bytes.NewReader(zstdPayload(t, createOutportBlockJSONPayload(t))), "zstd", 0)
+		require.Nil(t, err)
+
+		saveBlockData, err := decoders.DecodeBlockDataFromReader(reader, "application/json")
+		require.Nil(t, err)
+		require.Equal(t, []byte("headerHash"), saveBlockData.HeaderHash)
+		require.Equal(t, uint64(5), saveBlockData.Header.GetNonce())
+		require.Equal(t, uint32(3), saveBlockData.NumberOfShards)
+		require.True(t, saveBlockData.AlteredAccounts["erd1"].AdditionalData.IsSender)
+	})
+}
@@ -0,0 +1,136 @@
+package decoders
+
+import (
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	identityEncoding = "identity"
+	gzipEncoding     = "gzip"
+	zstdEncoding     = "zstd"
+)
+
+// NewBodyReader returns a reader which decompresses the provided body based on its content encoding. If
+// maxBodySize is not 0, reading fails with ErrBodyTooLarge once more than maxBodySize bytes are read,
+// either before or after decompression. Closing the returned reader does not close the provided body
+func NewBodyReader(body io.Reader, contentEncoding string, maxBodySize int64) (io.ReadCloser, error) {
+	compressedReader := newMaxSizeReader(body, maxBodySize)
+
+	switch strings.ToLower(strings.TrimSpace(contentEncoding)) {
+	case "", identityEncoding:
+		return ioutil.NopCloser(compressedReader), nil
+	case gzipEncoding:
+		gzipReader, err := gzip.NewReader(compressedReader)
+		if err != nil {
+			return nil, err
+		}
+
+		return newDecompressedReader(gzipReader, gzipReader.Close, maxBodySize), nil
+	case zstdEncoding:
+		options := []zstd.DOption{
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderLowmem(true),
+		}
+		if maxBodySize > 0 {
+			options = append(options, zstd.WithDecoderMaxMemory(uint64(maxBodySize)))
+		}
+
+		zstdReader, err := zstd.NewReader(compressedReader, options...)
+		if err != nil {
+			return nil, err
+		}
+
+		closeHandler := func() error {
+			zstdReader.Close()
+			return nil
+		}
+
+		return newDecompressedReader(&zstdBodyReader{decoder: zstdReader}, closeHandler, maxBodySize), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedContentEncoding, contentEncoding)
+	}
+}
+
+type decompressedReader struct {
+	io.Reader
+	closeHandler func() error
+}
+
+func newDecompressedReader(reader io.Reader, closeHandler func() error, maxBodySize int64) *decompressedReader {
+	return &decompressedReader{
+		Reader:       newMaxSizeReader(reader, maxBodySize),
+		closeHandler: closeHandler,
+	}
+}
+
+// Close releases the resources of the decompressor
+func (dr *decompressedReader) Close() error {
+	return dr.closeHandler()
+}
+
+// zstdBodyReader reports the frames exceeding the memory limit of the decoder as ErrBodyTooLarge
+type zstdBodyReader struct {
+	decoder *zstd.Decoder
+}
+
+// Read decompresses the next bytes of the body
+func (zbr *zstdBodyReader) Read(p []byte) (int, error) {
+	n, err := zbr.decoder.Read(p)
+	if errors.Is(err, zstd.ErrWindowSizeExceeded) || errors.Is(err, zstd.ErrDecoderSizeExceeded) {
+		return n, fmt.Errorf("%w: %s", ErrBodyTooLarge, err.Error())
+	}
+
+	return n, err
+}
+
+// maxSizeReader behaves like io.LimitReader, but fails instead of reporting EOF when the limit is exceeded
+type maxSizeReader struct {
+	reader    io.Reader
+	maxSize   int64
+	remaining int64
+	err       error
+}
+
+func newMaxSizeReader(reader io.Reader, maxSize int64) io.Reader {
+	if maxSize <= 0 {
+		return reader
+	}
+
+	return &maxSizeReader{
+		reader:    reader,
+		maxSize:   maxSize,
+		remaining: maxSize,
+	}
+}
+
+// Read reads at most the remaining bytes, and one more byte to find out if the limit has been exceeded
+func (msr *maxSizeReader) Read(p []byte) (int, error) {
+	if msr.err != nil {
+		return 0, msr.err
+	}
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if int64(len(p)) > msr.remaining+1 {
+		p = p[:msr.remaining+1]
+	}
+
+	n, err := msr.reader.Read(p)
+	if int64(n) <= msr.remaining {
+		msr.remaining -= int64(n)
+		return n, err
+	}
+
+	n = int(msr.remaining)
+	msr.remaining = 0
+	msr.err = fmt.Errorf("%w: more than %d bytes", ErrBodyTooLarge, msr.maxSize)
+
+	return n, msr.err
+}
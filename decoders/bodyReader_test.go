@@ -0,0 +1,114 @@
+package decoders_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io/ioutil"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/multiversx/mx-chain-notifier-go/decoders"
+	"github.com/stretchr/testify/require"
+)
+
+func gzipPayload(t *testing.T, payload []byte) []byte {
+	buff := &bytes.Buffer{}
+	writer := gzip.NewWriter(buff)
+	_, err := writer.Write(payload)
+	require.Nil(t, err)
+	require.Nil(t, writer.Close())
+
+	return buff.Bytes()
+}
+
+func zstdPayload(t *testing.T, payload []byte) []byte {
+	buff := &bytes.Buffer{}
+	writer, err := zstd.NewWriter(buff)
+	require.Nil(t, err)
+	_, err = writer.Write(payload)
+	require.Nil(t, err)
+	require.Nil(t, writer.Close())
+
+	return buff.Bytes()
+}
+
+func readBody(t *testing.T, body []byte, contentEncoding string, maxBodySize int64) ([]byte, error) {
+	reader, err := decoders.NewBodyReader(bytes.NewReader(body), contentEncoding, maxBodySize)
+	require.Nil(t, err)
+	defer func() {
+		require.Nil(t, reader.Close())
+	}()
+
+	return ioutil.ReadAll(reader)
+}
+
+func TestNewBodyReader(t *testing.T) {
+	t.Parallel()
+
+	payload := bytes.Repeat([]byte("block data "), 1000)
+
+	t.Run("unsupported encoding, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		reader, err := decoders.NewBodyReader(bytes.NewReader(payload), "br", 0)
+		require.True(t, errors.Is(err, decoders.ErrUnsupportedContentEncoding))
+		require.Nil(t, reader)
+	})
+
+	t.Run("invalid gzip payload, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		reader, err := decoders.NewBodyReader(bytes.NewReader(payload), "gzip", 0)
+		require.NotNil(t, err)
+		require.Nil(t, reader)
+	})
+
+	t.Run("identity encoding, should work", func(t *testing.T) {
+		t.Parallel()
+
+		body, err := readBody(t, payload, "", int64(len(payload)))
+		require.Nil(t, err)
+		require.Equal(t, payload, body)
+
+		body, err = readBody(t, payload, "identity", 0)
+		require.Nil(t, err)
+		require.Equal(t, payload, body)
+	})
+
+	t.Run("gzip encoding, should work", func(t *testing.T) {
+		t.Parallel()
+
+		body, err := readBody(t, gzipPayload(t, payload), " GZIP ", int64(len(payload)))
+		require.Nil(t, err)
+		require.Equal(t, payload, body)
+	})
+
+	t.Run("zstd encoding, should work", func(t *testing.T) {
+		t.Parallel()
+
+		body, err := readBody(t, zstdPayload(t, payload), "zstd", int64(len(payload)))
+		require.Nil(t, err)
+		require.Equal(t, payload, body)
+	})
+
+	t.Run("body above the limit, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := readBody(t, payload, "", int64(len(payload)-1))
+		require.True(t, errors.Is(err, decoders.ErrBodyTooLarge))
+	})
+
+	t.Run("decompressed body above the limit, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		compressedPayload := gzipPayload(t, payload)
+		require.Less(t, len(compressedPayload), len(payload)-1)
+
+		_, err := readBody(t, compressedPayload, "gzip", int64(len(payload)-1))
+		require.True(t, errors.Is(err, decoders.ErrBodyTooLarge))
+
+		_, err = readBody(t, zstdPayload(t, payload), "zstd", int64(len(payload)-1))
+		require.True(t, errors.Is(err, decoders.ErrBodyTooLarge))
+	})
+}
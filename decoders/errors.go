@@ -0,0 +1,21 @@
+package decoders
+
+import "errors"
+
+// ErrInvalidHeaderType signals that an unknown header type has been provided
+var ErrInvalidHeaderType = errors.New("invalid header type")
+
+// ErrNilBlockData signals that an outport block without block data has been provided
+var ErrNilBlockData = errors.New("nil outport block data")
+
+// ErrInvalidProtoPayload signals that a protobuf payload could not be decoded
+var ErrInvalidProtoPayload = errors.New("invalid protobuf payload")
+
+// ErrBodyTooLarge signals that the pushed body exceeds the maximum allowed size
+var ErrBodyTooLarge = errors.New("request body too large")
+
+// ErrUnsupportedContentEncoding signals that the pushed body is compressed with an unsupported algorithm
+var ErrUnsupportedContentEncoding = errors.New("unsupported content encoding")
+
+// ErrInvalidJSONPayload signals that a JSON payload could not be decoded
+var ErrInvalidJSONPayload = errors.New("invalid JSON payload")
@@ -0,0 +1,60 @@
+package decoders
+
+import (
+	"encoding/json"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+// jsonBlockData holds the fields of both JSON formats, so that a payload can be decoded in a single pass
+// without knowing its format in advance. The fields shared by the two formats only differ in case, which
+// is ignored when decoding. The altered accounts are decoded apart, since the legacy format names their
+// additional data differently
+type jsonBlockData struct {
+	outport.OutportBlock
+	AlteredAccounts  map[string]*legacyAlteredAccount `json:"alteredAccounts"`
+	HeaderType       core.HeaderType
+	Header           json.RawMessage
+	HeaderHash       []byte
+	Body             *block.Body
+	TransactionsPool *data.TransactionsPool
+	IsImportDB       bool
+}
+
+// toArgsSaveBlockData converts the decoded fields of the detected format. Only the outport block has block data
+func (jbd *jsonBlockData) toArgsSaveBlockData() (*data.ArgsSaveBlockData, error) {
+	if jbd.BlockData != nil {
+		jbd.OutportBlock.AlteredAccounts = toAlteredAccounts(jbd.AlteredAccounts)
+		return outportBlockToArgsSaveBlockData(&jbd.OutportBlock, &marshal.JsonMarshalizer{})
+	}
+
+	return jbd.toLegacyArgsSaveBlockData()
+}
+
+func (jbd *jsonBlockData) toLegacyArgsSaveBlockData() (*data.ArgsSaveBlockData, error) {
+	header, err := unmarshallJSONHeader(jbd.HeaderType, jbd.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	argsSaveBlockData := &data.ArgsSaveBlockData{
+		HeaderHash:             jbd.HeaderHash,
+		Body:                   jbd.Body,
+		SignersIndexes:         jbd.SignersIndexes,
+		NotarizedHeadersHashes: jbd.NotarizedHeadersHashes,
+		AlteredAccounts:        toAlteredAccounts(jbd.AlteredAccounts),
+		NumberOfShards:         jbd.NumberOfShards,
+		IsImportDB:             jbd.IsImportDB,
+		TransactionsPool:       jbd.TransactionsPool,
+		Header:                 header,
+	}
+	if jbd.HeaderGasConsumption != nil {
+		argsSaveBlockData.HeaderGasConsumption = *jbd.HeaderGasConsumption
+	}
+
+	return argsSaveBlockData, nil
+}
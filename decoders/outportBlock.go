@@ -0,0 +1,176 @@
+package decoders
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	nodeData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+func unmarshallOutportBlockProto(marshalledData []byte) (*data.ArgsSaveBlockData, error) {
+	outportBlock := &outport.OutportBlock{}
+	protoMarshaller := &marshal.GogoProtoMarshalizer{}
+	err := protoMarshaller.Unmarshal(outportBlock, marshalledData)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidProtoPayload, err.Error())
+	}
+
+	return outportBlockToArgsSaveBlockData(outportBlock, protoMarshaller)
+}
+
+// outportBlockToArgsSaveBlockData converts the outport block to the interceptor input. The header bytes
+// are decoded with the marshaller of the payload
+func outportBlockToArgsSaveBlockData(outportBlock *outport.OutportBlock, headerMarshaller marshal.Marshalizer) (*data.ArgsSaveBlockData, error) {
+	if outportBlock.BlockData == nil {
+		return nil, ErrNilBlockData
+	}
+
+	blockData := outportBlock.BlockData
+	header, err := unmarshallHeaderBytes(headerMarshaller, core.HeaderType(blockData.HeaderType), blockData.HeaderBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	argsSaveBlockData := &data.ArgsSaveBlockData{
+		HeaderHash:             blockData.HeaderHash,
+		Header:                 header,
+		SignersIndexes:         outportBlock.SignersIndexes,
+		NotarizedHeadersHashes: outportBlock.NotarizedHeadersHashes,
+		TransactionsPool:       toTransactionsPool(outportBlock.TransactionPool),
+		AlteredAccounts:        outportBlock.AlteredAccounts,
+		NumberOfShards:         outportBlock.NumberOfShards,
+	}
+	if outportBlock.HeaderGasConsumption != nil {
+		argsSaveBlockData.HeaderGasConsumption = *outportBlock.HeaderGasConsumption
+	}
+	if blockData.Body != nil {
+		argsSaveBlockData.Body = blockData.Body
+	}
+
+	return argsSaveBlockData, nil
+}
+
+func unmarshallHeaderBytes(marshaller marshal.Marshalizer, headerType core.HeaderType, headerBytes []byte) (nodeData.HeaderHandler, error) {
+	header, err := createHeader(headerType)
+	if err != nil {
+		return nil, err
+	}
+	if len(headerBytes) == 0 {
+		return nil, nil
+	}
+
+	err = marshaller.Unmarshal(header, headerBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+func toTransactionsPool(transactionPool *outport.TransactionPool) *data.TransactionsPool {
+	pool := &data.TransactionsPool{
+		Txs:      make(map[string]*data.NodeTransaction),
+		Scrs:     make(map[string]*data.NodeSmartContractResult),
+		Rewards:  make(map[string]*data.NodeRewardTx),
+		Invalid:  make(map[string]*data.NodeTransaction),
+		Receipts: make(map[string]*data.NodeReceipt),
+		Logs:     make([]*data.LogData, 0),
+	}
+	if transactionPool == nil {
+		return pool
+	}
+
+	for hash, txInfo := range transactionPool.Transactions {
+		pool.Txs[hash] = toNodeTransaction(txInfo)
+	}
+	for hash, txInfo := range transactionPool.InvalidTxs {
+		pool.Invalid[hash] = toNodeTransaction(txInfo)
+	}
+	for hash, scrInfo := range transactionPool.SmartContractResults {
+		if scrInfo == nil {
+			continue
+		}
+		pool.Scrs[hash] = &data.NodeSmartContractResult{
+			TransactionHandler: scrInfo.SmartContractResult,
+			FeeInfo:            toFeeInfo(scrInfo.FeeInfo),
+			ExecutionOrder:     int(scrInfo.ExecutionOrder),
+		}
+	}
+	for hash, rewardInfo := range transactionPool.Rewards {
+		if rewardInfo == nil {
+			continue
+		}
+		pool.Rewards[hash] = &data.NodeRewardTx{
+			TransactionHandler: rewardInfo.Reward,
+			ExecutionOrder:     int(rewardInfo.ExecutionOrder),
+		}
+	}
+	for hash, rec := range transactionPool.Receipts {
+		pool.Receipts[hash] = &data.NodeReceipt{
+			TransactionHandler: rec,
+		}
+	}
+	for _, logData := range transactionPool.Logs {
+		if logData == nil {
+			continue
+		}
+		pool.Logs = append(pool.Logs, &data.LogData{
+			LogHandler: logData.Log,
+			TxHash:     logData.TxHash,
+		})
+	}
+
+	return pool
+}
+
+func toNodeTransaction(txInfo *outport.TxInfo) *data.NodeTransaction {
+	if txInfo == nil {
+		return &data.NodeTransaction{}
+	}
+
+	return &data.NodeTransaction{
+		TransactionHandler: txInfo.Transaction,
+		FeeInfo:            toFeeInfo(txInfo.FeeInfo),
+		ExecutionOrder:     int(txInfo.ExecutionOrder),
+	}
+}
+
+func toFeeInfo(feeInfo *outport.FeeInfo) outport.FeeInfo {
+	if feeInfo == nil {
+		return outport.FeeInfo{}
+	}
+
+	return *feeInfo
+}
+
+// legacyAlteredAccount keeps the additional data of an account, which is named differently in the
+// legacy JSON format than in the outport block
+type legacyAlteredAccount struct {
+	alteredAccount.AlteredAccount
+	LegacyAdditionalData *alteredAccount.AdditionalAccountData `json:"additionalData,omitempty"`
+}
+
+func toAlteredAccounts(accounts map[string]*legacyAlteredAccount) map[string]*alteredAccount.AlteredAccount {
+	if accounts == nil {
+		return nil
+	}
+
+	alteredAccounts := make(map[string]*alteredAccount.AlteredAccount, len(accounts))
+	for address, account := range accounts {
+		if account == nil {
+			continue
+		}
+
+		alteredAcc := account.AlteredAccount
+		if account.LegacyAdditionalData != nil {
+			alteredAcc.AdditionalData = account.LegacyAdditionalData
+		}
+		alteredAccounts[address] = &alteredAcc
+	}
+
+	return alteredAccounts
+}
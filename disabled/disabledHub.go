@@ -1,6 +1,10 @@
 package disabled
 
 import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
 )
@@ -33,10 +37,51 @@ func (h *Hub) BroadcastTxs(_ data.BlockTxs) {
 func (h *Hub) BroadcastScrs(_ data.BlockScrs) {
 }
 
+// BroadcastRewards does nothing
+func (h *Hub) BroadcastRewards(_ data.BlockRewards) {
+}
+
+// BroadcastReceipts does nothing
+func (h *Hub) BroadcastReceipts(_ data.BlockReceipts) {
+}
+
+// BroadcastInvalidTxs does nothing
+func (h *Hub) BroadcastInvalidTxs(_ data.BlockInvalidTxs) {
+}
+
+// BroadcastAlteredAccounts does nothing
+func (h *Hub) BroadcastAlteredAccounts(_ data.BlockAlteredAccounts) {
+}
+
+// BroadcastBlockHeader does nothing
+func (h *Hub) BroadcastBlockHeader(_ data.BlockHeader) {
+}
+
+// BroadcastEpochStart does nothing
+func (h *Hub) BroadcastEpochStart(_ data.EpochStart) {
+}
+
+// BroadcastTxBundles does nothing
+func (h *Hub) BroadcastTxBundles(_ data.BlockTxBundles) {
+}
+
+// BroadcastTxStatus does nothing
+func (h *Hub) BroadcastTxStatus(_ data.TxStatus) {
+}
+
 // BroadcastBlockEventsWithOrder does nothing
 func (h *Hub) BroadcastBlockEventsWithOrder(_ data.BlockEventsWithOrder) {
 }
 
+// BroadcastSourceStale does nothing
+func (h *Hub) BroadcastSourceStale(_ data.SourceStaleEvent) {
+}
+
+// CheckHealth returns nil
+func (h *Hub) CheckHealth(_ context.Context) error {
+	return nil
+}
+
 // RegisterEvent does nothing
 func (h *Hub) RegisterEvent(_ dispatcher.EventDispatcher) {
 }
@@ -49,6 +94,29 @@ func (h *Hub) UnregisterEvent(_ dispatcher.EventDispatcher) {
 func (h *Hub) Subscribe(_ data.SubscribeEvent) {
 }
 
+// GetDispatchers returns an empty slice
+func (h *Hub) GetDispatchers() []data.DispatcherInfo {
+	return make([]data.DispatcherInfo, 0)
+}
+
+// DisconnectDispatcher returns ErrDispatcherNotFound
+func (h *Hub) DisconnectDispatcher(_ uuid.UUID) error {
+	return common.ErrDispatcherNotFound
+}
+
+// GetSubscriptionsStats returns empty stats
+func (h *Hub) GetSubscriptionsStats() data.SubscriptionsStats {
+	return data.SubscriptionsStats{
+		ByEventType:  make(map[string]int),
+		ByMatchLevel: make(map[string]int),
+	}
+}
+
+// Drain returns nil
+func (h *Hub) Drain(_ context.Context) error {
+	return nil
+}
+
 // Close returns nil
 func (h *Hub) Close() error {
 	return nil
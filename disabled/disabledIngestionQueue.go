@@ -0,0 +1,28 @@
+package disabled
+
+import "context"
+
+// IngestionQueue defines a disabled ingestion queue component, which processes every block right away
+type IngestionQueue struct{}
+
+// Enqueue calls the process handler
+func (diq *IngestionQueue) Enqueue(_ uint32, processHandler func()) error {
+	processHandler()
+
+	return nil
+}
+
+// Drain returns nil
+func (diq *IngestionQueue) Drain(_ context.Context) error {
+	return nil
+}
+
+// Close returns nil
+func (diq *IngestionQueue) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (diq *IngestionQueue) IsInterfaceNil() bool {
+	return diq == nil
+}
@@ -0,0 +1,17 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-notifier-go/common"
+
+// NativeAuthVerifier defines a disabled native-auth verifier component
+type NativeAuthVerifier struct {
+}
+
+// Verify rejects all tokens
+func (nav *NativeAuthVerifier) Verify(_ string) (string, error) {
+	return "", common.ErrNativeAuthNotEnabled
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nav *NativeAuthVerifier) IsInterfaceNil() bool {
+	return nav == nil
+}
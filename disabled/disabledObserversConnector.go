@@ -0,0 +1,18 @@
+package disabled
+
+// ObserversConnector defines a disabled observers connector component
+type ObserversConnector struct{}
+
+// Start does nothing
+func (doc *ObserversConnector) Start() {
+}
+
+// Close returns nil
+func (doc *ObserversConnector) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (doc *ObserversConnector) IsInterfaceNil() bool {
+	return doc == nil
+}
@@ -0,0 +1,21 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// ObserversQuorum defines a disabled observers quorum component, which publishes every block right away
+type ObserversQuorum struct{}
+
+// ProcessBlock calls the publish handler
+func (doq *ObserversQuorum) ProcessBlock(_ data.ObserverBlockVote, publishHandler func()) {
+	publishHandler()
+}
+
+// Close returns nil
+func (doq *ObserversQuorum) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (doq *ObserversQuorum) IsInterfaceNil() bool {
+	return doq == nil
+}
@@ -1,6 +1,8 @@
 package disabled
 
 import (
+	"context"
+
 	"github.com/multiversx/mx-chain-notifier-go/data"
 )
 
@@ -27,14 +29,60 @@ func (dp *Publisher) BroadcastFinalized(_ data.FinalizedBlock) {
 func (dp *Publisher) BroadcastTxs(_ data.BlockTxs) {
 }
 
+// CheckHealth returns nil
+func (dp *Publisher) CheckHealth(_ context.Context) error {
+	return nil
+}
+
+// BroadcastSourceStale does nothing
+func (dp *Publisher) BroadcastSourceStale(_ data.SourceStaleEvent) {
+}
+
 // BroadcastScrs does nothing
 func (dp *Publisher) BroadcastScrs(_ data.BlockScrs) {
 }
 
+// BroadcastRewards does nothing
+func (dp *Publisher) BroadcastRewards(_ data.BlockRewards) {
+}
+
+// BroadcastReceipts does nothing
+func (dp *Publisher) BroadcastReceipts(_ data.BlockReceipts) {
+}
+
+// BroadcastInvalidTxs does nothing
+func (dp *Publisher) BroadcastInvalidTxs(_ data.BlockInvalidTxs) {
+}
+
+// BroadcastAlteredAccounts does nothing
+func (dp *Publisher) BroadcastAlteredAccounts(_ data.BlockAlteredAccounts) {
+}
+
+// BroadcastBlockHeader does nothing
+func (dp *Publisher) BroadcastBlockHeader(_ data.BlockHeader) {
+}
+
+// BroadcastEpochStart does nothing
+func (dp *Publisher) BroadcastEpochStart(_ data.EpochStart) {
+}
+
+// BroadcastTxBundles does nothing
+func (dp *Publisher) BroadcastTxBundles(_ data.BlockTxBundles) {
+}
+
+// BroadcastTxStatus does nothing
+func (dp *Publisher) BroadcastTxStatus(_ data.TxStatus) {
+}
+
 // BroadcastBlockEventsWithOrder does nothing
 func (dp *Publisher) BroadcastBlockEventsWithOrder(_ data.BlockEventsWithOrder) {
 }
 
+// Drain returns nil
+func (dp *Publisher) Drain(_ context.Context) error {
+	return nil
+}
+
 // Close returns nil
 func (dp *Publisher) Close() error {
 	return nil
@@ -15,6 +15,11 @@ func (drw *disabledRedlockWrapper) IsEventProcessed(_ context.Context, _ string)
 	return true, nil
 }
 
+// AddToSet returns 1 and nil
+func (drw *disabledRedlockWrapper) AddToSet(_ context.Context, _ string, _ string) (int64, error) {
+	return 1, nil
+}
+
 // HasConnection returns true
 func (drw *disabledRedlockWrapper) HasConnection(_ context.Context) bool {
 	return true
@@ -0,0 +1,30 @@
+package disabled
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+)
+
+// ServiceBusClient defines a disabled service bus client component
+type ServiceBusClient struct{}
+
+// SendMessages returns nil
+func (sbc *ServiceBusClient) SendMessages(_ context.Context, _ string, _ []*azservicebus.Message) error {
+	return nil
+}
+
+// CheckHealth returns nil
+func (sbc *ServiceBusClient) CheckHealth(_ context.Context) error {
+	return nil
+}
+
+// Close returns nil
+func (sbc *ServiceBusClient) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sbc *ServiceBusClient) IsInterfaceNil() bool {
+	return sbc == nil
+}
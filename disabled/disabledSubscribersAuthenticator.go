@@ -0,0 +1,28 @@
+package disabled
+
+import (
+	"net/http"
+
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+// SubscribersAuthenticator defines a disabled subscribers authenticator component
+type SubscribersAuthenticator struct {
+}
+
+// Authenticate returns an unlimited quota for the anonymous subscriber
+func (sa *SubscribersAuthenticator) Authenticate(_ *http.Request) (data.SubscriberQuota, error) {
+	return data.SubscriberQuota{
+		Name: common.AnonymousSubscriber,
+	}, nil
+}
+
+// ReleaseConnection does nothing
+func (sa *SubscribersAuthenticator) ReleaseConnection(_ data.SubscriberQuota) {
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sa *SubscribersAuthenticator) IsInterfaceNil() bool {
+	return sa == nil
+}
@@ -0,0 +1,20 @@
+package disabled
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// TxStatusTracker defines a disabled tx status tracker component
+type TxStatusTracker struct{}
+
+// ProcessTxBundles does nothing
+func (dtst *TxStatusTracker) ProcessTxBundles(_ data.BlockTxBundles) {
+}
+
+// Close returns nil
+func (dtst *TxStatusTracker) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dtst *TxStatusTracker) IsInterfaceNil() bool {
+	return dtst == nil
+}
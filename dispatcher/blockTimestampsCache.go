@@ -0,0 +1,65 @@
+package dispatcher
+
+import (
+	"strings"
+	"sync"
+)
+
+// BlockTimestampsCache keeps the timestamps of the most recent block hashes. When the capacity is
+// reached, the oldest block hash is evicted
+type BlockTimestampsCache struct {
+	mut        sync.RWMutex
+	timestamps map[string]uint64
+	hashes     []string
+	next       int
+}
+
+// NewBlockTimestampsCache creates a new block timestamps cache with the provided capacity
+func NewBlockTimestampsCache(capacity int) (*BlockTimestampsCache, error) {
+	if capacity <= 0 {
+		return nil, ErrInvalidBlockTimestampsCacheSize
+	}
+
+	return &BlockTimestampsCache{
+		timestamps: make(map[string]uint64, capacity),
+		hashes:     make([]string, 0, capacity),
+	}, nil
+}
+
+// Add stores the timestamp of the provided block hash
+func (btc *BlockTimestampsCache) Add(hash string, timestamp uint64) {
+	hash = strings.ToLower(hash)
+
+	btc.mut.Lock()
+	defer btc.mut.Unlock()
+
+	_, exists := btc.timestamps[hash]
+	if exists {
+		btc.timestamps[hash] = timestamp
+		return
+	}
+
+	if len(btc.hashes) < cap(btc.hashes) {
+		btc.hashes = append(btc.hashes, hash)
+	} else {
+		delete(btc.timestamps, btc.hashes[btc.next])
+		btc.hashes[btc.next] = hash
+		btc.next = (btc.next + 1) % len(btc.hashes)
+	}
+	btc.timestamps[hash] = timestamp
+}
+
+// Get returns the timestamp of the provided block hash, if known
+func (btc *BlockTimestampsCache) Get(hash string) (uint64, bool) {
+	btc.mut.RLock()
+	defer btc.mut.RUnlock()
+
+	timestamp, exists := btc.timestamps[strings.ToLower(hash)]
+
+	return timestamp, exists
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (btc *BlockTimestampsCache) IsInterfaceNil() bool {
+	return btc == nil
+}
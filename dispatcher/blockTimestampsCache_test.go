@@ -0,0 +1,52 @@
+package dispatcher
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBlockTimestampsCache(t *testing.T) {
+	t.Parallel()
+
+	cache, err := NewBlockTimestampsCache(0)
+	require.Nil(t, cache)
+	require.Equal(t, ErrInvalidBlockTimestampsCacheSize, err)
+
+	cache, err = NewBlockTimestampsCache(10)
+	require.Nil(t, err)
+	require.False(t, cache.IsInterfaceNil())
+}
+
+func TestBlockTimestampsCache_AddGet(t *testing.T) {
+	t.Parallel()
+
+	cache, _ := NewBlockTimestampsCache(2)
+
+	cache.Add("AA", 1)
+	timestamp, ok := cache.Get("aa")
+	require.True(t, ok)
+	require.Equal(t, uint64(1), timestamp)
+
+	cache.Add("aa", 2)
+	timestamp, _ = cache.Get("aa")
+	require.Equal(t, uint64(2), timestamp)
+
+	cache.Add("bb", 3)
+	cache.Add("cc", 4)
+
+	_, ok = cache.Get("aa")
+	require.False(t, ok)
+	timestamp, ok = cache.Get("bb")
+	require.True(t, ok)
+	require.Equal(t, uint64(3), timestamp)
+	timestamp, ok = cache.Get("cc")
+	require.True(t, ok)
+	require.Equal(t, uint64(4), timestamp)
+
+	cache.Add("dd", 5)
+	_, ok = cache.Get("bb")
+	require.False(t, ok)
+	_, ok = cache.Get("cc")
+	require.True(t, ok)
+}
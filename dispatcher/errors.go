@@ -0,0 +1,16 @@
+package dispatcher
+
+import "errors"
+
+// ErrAddressNotOwned signals that a subscription targets an address other than the authenticated one
+var ErrAddressNotOwned = errors.New("subscription address is not the authenticated address")
+
+// ErrEventTypeNotFilteredByAddress signals that a subscription targets an event type which carries
+// the data of any address
+var ErrEventTypeNotFilteredByAddress = errors.New("event type is not filtered by address")
+
+// ErrNilBlockTimestampsHandler signals that a nil block timestamps handler has been provided
+var ErrNilBlockTimestampsHandler = errors.New("nil block timestamps handler")
+
+// ErrInvalidBlockTimestampsCacheSize signals that an invalid block timestamps cache size has been provided
+var ErrInvalidBlockTimestampsCacheSize = errors.New("invalid block timestamps cache size")
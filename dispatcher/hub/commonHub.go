@@ -2,28 +2,38 @@ package hub
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
 	"github.com/multiversx/mx-chain-notifier-go/filters"
+	"github.com/multiversx/mx-chain-notifier-go/tracing"
 )
 
 var log = logger.GetOrCreate("hub")
 
 // ArgsCommonHub defines the arguments needed for common hub creation
 type ArgsCommonHub struct {
-	Filter             filters.EventFilter
-	SubscriptionMapper dispatcher.SubscriptionMapperHandler
+	Filter               filters.EventFilter
+	SubscriptionMapper   dispatcher.SubscriptionMapperHandler
+	StatusMetricsHandler common.StatusMetricsHandler
+	BlockTimestamps      dispatcher.BlockTimestampsHandler
 }
 
 type commonHub struct {
 	filter                        filters.EventFilter
 	subscriptionMapper            dispatcher.SubscriptionMapperHandler
+	metricsHandler                common.StatusMetricsHandler
+	blockTimestamps               dispatcher.BlockTimestampsHandler
 	mutDispatchers                sync.RWMutex
 	dispatchers                   map[uuid.UUID]dispatcher.EventDispatcher
 	register                      chan dispatcher.EventDispatcher
@@ -34,7 +44,18 @@ type commonHub struct {
 	broadcastTxs                  chan data.BlockTxs
 	broadcastBlockEventsWithOrder chan data.BlockEventsWithOrder
 	broadcastScrs                 chan data.BlockScrs
+	broadcastRewards              chan data.BlockRewards
+	broadcastReceipts             chan data.BlockReceipts
+	broadcastInvalidTxs           chan data.BlockInvalidTxs
+	broadcastAlteredAccounts      chan data.BlockAlteredAccounts
+	broadcastBlockHeader          chan data.BlockHeader
+	broadcastEpochStart           chan data.EpochStart
+	broadcastTxBundles            chan data.BlockTxBundles
+	broadcastTxStatus             chan data.TxStatus
+	broadcastSourceStale          chan data.SourceStaleEvent
+	checkHealth                   chan struct{}
 	closeChan                     chan struct{}
+	runDone                       chan struct{}
 	cancelFunc                    func()
 }
 
@@ -49,6 +70,8 @@ func NewCommonHub(args ArgsCommonHub) (*commonHub, error) {
 		mutDispatchers:                sync.RWMutex{},
 		filter:                        args.Filter,
 		subscriptionMapper:            args.SubscriptionMapper,
+		metricsHandler:                args.StatusMetricsHandler,
+		blockTimestamps:               args.BlockTimestamps,
 		dispatchers:                   make(map[uuid.UUID]dispatcher.EventDispatcher),
 		register:                      make(chan dispatcher.EventDispatcher),
 		unregister:                    make(chan dispatcher.EventDispatcher),
@@ -58,7 +81,18 @@ func NewCommonHub(args ArgsCommonHub) (*commonHub, error) {
 		broadcastTxs:                  make(chan data.BlockTxs),
 		broadcastBlockEventsWithOrder: make(chan data.BlockEventsWithOrder),
 		broadcastScrs:                 make(chan data.BlockScrs),
+		broadcastRewards:              make(chan data.BlockRewards),
+		broadcastReceipts:             make(chan data.BlockReceipts),
+		broadcastInvalidTxs:           make(chan data.BlockInvalidTxs),
+		broadcastAlteredAccounts:      make(chan data.BlockAlteredAccounts),
+		broadcastBlockHeader:          make(chan data.BlockHeader),
+		broadcastEpochStart:           make(chan data.EpochStart),
+		broadcastTxBundles:            make(chan data.BlockTxBundles),
+		broadcastTxStatus:             make(chan data.TxStatus),
+		broadcastSourceStale:          make(chan data.SourceStaleEvent),
+		checkHealth:                   make(chan struct{}),
 		closeChan:                     make(chan struct{}),
+		runDone:                       make(chan struct{}),
 	}, nil
 }
 
@@ -69,6 +103,12 @@ func checkArgs(args ArgsCommonHub) error {
 	if check.IfNil(args.SubscriptionMapper) {
 		return ErrNilSubscriptionMapper
 	}
+	if check.IfNil(args.StatusMetricsHandler) {
+		return common.ErrNilStatusMetricsHandler
+	}
+	if check.IfNil(args.BlockTimestamps) {
+		return dispatcher.ErrNilBlockTimestampsHandler
+	}
 
 	return nil
 }
@@ -82,10 +122,13 @@ func (ch *commonHub) Run() {
 }
 
 func (ch *commonHub) run(ctx context.Context) {
+	defer close(ch.runDone)
+
 	for {
 		select {
 		case <-ctx.Done():
 			log.Debug("commonHub is stopping...")
+			ch.handlePendingBroadcasts()
 			return
 
 		case events := <-ch.broadcast:
@@ -106,6 +149,35 @@ func (ch *commonHub) run(ctx context.Context) {
 		case scrsEvent := <-ch.broadcastScrs:
 			ch.handleScrsBroadcast(scrsEvent)
 
+		case rewardsEvent := <-ch.broadcastRewards:
+			ch.handleRewardsBroadcast(rewardsEvent)
+
+		case receiptsEvent := <-ch.broadcastReceipts:
+			ch.handleReceiptsBroadcast(receiptsEvent)
+
+		case invalidTxsEvent := <-ch.broadcastInvalidTxs:
+			ch.handleInvalidTxsBroadcast(invalidTxsEvent)
+
+		case alteredAccountsEvent := <-ch.broadcastAlteredAccounts:
+			ch.handleAlteredAccountsBroadcast(alteredAccountsEvent)
+
+		case blockHeaderEvent := <-ch.broadcastBlockHeader:
+			ch.handleBlockHeaderBroadcast(blockHeaderEvent)
+
+		case epochStartEvent := <-ch.broadcastEpochStart:
+			ch.handleEpochStartBroadcast(epochStartEvent)
+
+		case txBundlesEvent := <-ch.broadcastTxBundles:
+			ch.handleTxBundlesBroadcast(txBundlesEvent)
+
+		case txStatusEvent := <-ch.broadcastTxStatus:
+			ch.handleTxStatusBroadcast(txStatusEvent)
+
+		case sourceStaleEvent := <-ch.broadcastSourceStale:
+			ch.handleSourceStaleBroadcast(sourceStaleEvent)
+
+		case <-ch.checkHealth:
+
 		case dispatcherClient := <-ch.register:
 			ch.registerDispatcher(dispatcherClient)
 
@@ -115,9 +187,51 @@ func (ch *commonHub) run(ctx context.Context) {
 	}
 }
 
+// handlePendingBroadcasts dispatches the events of the producers which are already waiting on the
+// broadcast channels, without waiting for new ones
+func (ch *commonHub) handlePendingBroadcasts() {
+	for {
+		select {
+		case events := <-ch.broadcast:
+			ch.handleBroadcast(events)
+		case revertEvent := <-ch.broadcastRevert:
+			ch.handleRevertBroadcast(revertEvent)
+		case finalizedEvent := <-ch.broadcastFinalized:
+			ch.handleFinalizedBroadcast(finalizedEvent)
+		case txsEvent := <-ch.broadcastTxs:
+			ch.handleTxsBroadcast(txsEvent)
+		case txsEvent := <-ch.broadcastBlockEventsWithOrder:
+			ch.handleBlockEventsWithOrderBroadcast(txsEvent)
+		case scrsEvent := <-ch.broadcastScrs:
+			ch.handleScrsBroadcast(scrsEvent)
+		case rewardsEvent := <-ch.broadcastRewards:
+			ch.handleRewardsBroadcast(rewardsEvent)
+		case receiptsEvent := <-ch.broadcastReceipts:
+			ch.handleReceiptsBroadcast(receiptsEvent)
+		case invalidTxsEvent := <-ch.broadcastInvalidTxs:
+			ch.handleInvalidTxsBroadcast(invalidTxsEvent)
+		case alteredAccountsEvent := <-ch.broadcastAlteredAccounts:
+			ch.handleAlteredAccountsBroadcast(alteredAccountsEvent)
+		case blockHeaderEvent := <-ch.broadcastBlockHeader:
+			ch.handleBlockHeaderBroadcast(blockHeaderEvent)
+		case epochStartEvent := <-ch.broadcastEpochStart:
+			ch.handleEpochStartBroadcast(epochStartEvent)
+		case txBundlesEvent := <-ch.broadcastTxBundles:
+			ch.handleTxBundlesBroadcast(txBundlesEvent)
+		case txStatusEvent := <-ch.broadcastTxStatus:
+			ch.handleTxStatusBroadcast(txStatusEvent)
+		case sourceStaleEvent := <-ch.broadcastSourceStale:
+			ch.handleSourceStaleBroadcast(sourceStaleEvent)
+		default:
+			return
+		}
+	}
+}
+
 // Subscribe is used by a dispatcher to send a dispatcher.SubscribeEvent
 func (ch *commonHub) Subscribe(event data.SubscribeEvent) {
 	ch.subscriptionMapper.MatchSubscribeEvent(event)
+	ch.metricsHandler.SetWSSubscriptions(len(ch.subscriptionMapper.Subscriptions()))
 }
 
 // Broadcast handles block events pushed by producers into the broadcast channel
@@ -165,6 +279,78 @@ func (ch *commonHub) BroadcastScrs(event data.BlockScrs) {
 	}
 }
 
+// BroadcastRewards handles block rewards event pushed by producers into the broadcast channel
+// Upon reading the channel, the hub notifies the registered dispatchers, if any
+func (ch *commonHub) BroadcastRewards(event data.BlockRewards) {
+	select {
+	case ch.broadcastRewards <- event:
+	case <-ch.closeChan:
+	}
+}
+
+// BroadcastReceipts handles block receipts event pushed by producers into the broadcast channel
+// Upon reading the channel, the hub notifies the registered dispatchers, if any
+func (ch *commonHub) BroadcastReceipts(event data.BlockReceipts) {
+	select {
+	case ch.broadcastReceipts <- event:
+	case <-ch.closeChan:
+	}
+}
+
+// BroadcastInvalidTxs handles block invalid txs event pushed by producers into the broadcast channel
+// Upon reading the channel, the hub notifies the registered dispatchers, if any
+func (ch *commonHub) BroadcastInvalidTxs(event data.BlockInvalidTxs) {
+	select {
+	case ch.broadcastInvalidTxs <- event:
+	case <-ch.closeChan:
+	}
+}
+
+// BroadcastAlteredAccounts handles block altered accounts event pushed by producers into the broadcast channel
+// Upon reading the channel, the hub notifies the registered dispatchers, if any
+func (ch *commonHub) BroadcastAlteredAccounts(event data.BlockAlteredAccounts) {
+	select {
+	case ch.broadcastAlteredAccounts <- event:
+	case <-ch.closeChan:
+	}
+}
+
+// BroadcastBlockHeader handles block header event pushed by producers into the broadcast channel
+// Upon reading the channel, the hub notifies the registered dispatchers, if any
+func (ch *commonHub) BroadcastBlockHeader(event data.BlockHeader) {
+	select {
+	case ch.broadcastBlockHeader <- event:
+	case <-ch.closeChan:
+	}
+}
+
+// BroadcastEpochStart handles epoch start event pushed by producers into the broadcast channel
+// Upon reading the channel, the hub notifies the registered dispatchers, if any
+func (ch *commonHub) BroadcastEpochStart(event data.EpochStart) {
+	select {
+	case ch.broadcastEpochStart <- event:
+	case <-ch.closeChan:
+	}
+}
+
+// BroadcastTxBundles handles tx bundles event pushed by producers into the broadcast channel
+// Upon reading the channel, the hub notifies the registered dispatchers, if any
+func (ch *commonHub) BroadcastTxBundles(event data.BlockTxBundles) {
+	select {
+	case ch.broadcastTxBundles <- event:
+	case <-ch.closeChan:
+	}
+}
+
+// BroadcastTxStatus handles tx status event pushed by producers into the broadcast channel
+// Upon reading the channel, the hub notifies the registered dispatchers, if any
+func (ch *commonHub) BroadcastTxStatus(event data.TxStatus) {
+	select {
+	case ch.broadcastTxStatus <- event:
+	case <-ch.closeChan:
+	}
+}
+
 // BroadcastBlockEventsWithOrder handles full block events pushed by producers into the channel
 func (ch *commonHub) BroadcastBlockEventsWithOrder(event data.BlockEventsWithOrder) {
 	select {
@@ -173,6 +359,26 @@ func (ch *commonHub) BroadcastBlockEventsWithOrder(event data.BlockEventsWithOrd
 	}
 }
 
+// BroadcastSourceStale handles source stale events pushed by producers into the channel
+func (ch *commonHub) BroadcastSourceStale(event data.SourceStaleEvent) {
+	select {
+	case ch.broadcastSourceStale <- event:
+	case <-ch.closeChan:
+	}
+}
+
+// CheckHealth returns an error if the hub loop does not respond before the context is done
+func (ch *commonHub) CheckHealth(ctx context.Context) error {
+	select {
+	case ch.checkHealth <- struct{}{}:
+		return nil
+	case <-ch.closeChan:
+		return ErrHubNotResponding
+	case <-ctx.Done():
+		return fmt.Errorf("%w: %s", ErrHubNotResponding, ctx.Err().Error())
+	}
+}
+
 // RegisterEvent will send event to a receive-only channel used to register dispatchers
 func (ch *commonHub) RegisterEvent(event dispatcher.EventDispatcher) {
 	select {
@@ -189,7 +395,76 @@ func (ch *commonHub) UnregisterEvent(event dispatcher.EventDispatcher) {
 	}
 }
 
+// GetDispatchers returns the details of the connected dispatchers, together with their subscriptions
+func (ch *commonHub) GetDispatchers() []data.DispatcherInfo {
+	subscriptionsMap := make(map[uuid.UUID][]data.Subscription)
+	for _, subscription := range ch.subscriptionMapper.Subscriptions() {
+		subscriptionsMap[subscription.DispatcherID] = append(subscriptionsMap[subscription.DispatcherID], subscription)
+	}
+
+	ch.mutDispatchers.RLock()
+	dispatchersInfo := make([]data.DispatcherInfo, 0, len(ch.dispatchers))
+	for id, d := range ch.dispatchers {
+		info := d.GetInfo()
+		info.Subscriptions = subscriptionsMap[id]
+		if info.Subscriptions == nil {
+			info.Subscriptions = make([]data.Subscription, 0)
+		}
+		dispatchersInfo = append(dispatchersInfo, info)
+	}
+	ch.mutDispatchers.RUnlock()
+
+	sort.Slice(dispatchersInfo, func(i, j int) bool {
+		return dispatchersInfo[i].ConnectedAt < dispatchersInfo[j].ConnectedAt
+	})
+
+	return dispatchersInfo
+}
+
+// DisconnectDispatcher closes the connection of the provided dispatcher. The dispatcher and its
+// subscriptions are removed once the dispatcher signals it has disconnected
+func (ch *commonHub) DisconnectDispatcher(dispatcherID uuid.UUID) error {
+	ch.mutDispatchers.RLock()
+	d, ok := ch.dispatchers[dispatcherID]
+	ch.mutDispatchers.RUnlock()
+	if !ok {
+		return common.ErrDispatcherNotFound
+	}
+
+	log.Info("disconnecting dispatcher", "dispatcherID", dispatcherID)
+
+	return d.Disconnect()
+}
+
+// GetSubscriptionsStats returns the subscriptions aggregated by event type and by match level
+func (ch *commonHub) GetSubscriptionsStats() data.SubscriptionsStats {
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	stats := data.SubscriptionsStats{
+		NumSubscriptions: len(subscriptions),
+		ByEventType:      make(map[string]int),
+		ByMatchLevel:     make(map[string]int),
+	}
+	for _, subscription := range subscriptions {
+		stats.ByEventType[subscription.EventType]++
+		stats.ByMatchLevel[subscription.MatchLevel]++
+	}
+
+	ch.mutDispatchers.RLock()
+	stats.NumDispatchers = len(ch.dispatchers)
+	ch.mutDispatchers.RUnlock()
+
+	return stats
+}
+
 func (ch *commonHub) handleBroadcast(blockEvents data.BlockEvents) {
+	defer ch.observeDispatch(time.Now())
+	ctx := tracing.ExtractContext(context.Background(), blockEvents.TraceContext)
+	_, span := tracing.StartSpan(ctx, "hub.Dispatch", blockEvents.Hash)
+	defer span.End()
+
+	ch.addBlockTimestamp(blockEvents.Hash, blockEvents.TimeStamp)
+
 	subscriptions := ch.subscriptionMapper.Subscriptions()
 
 	for _, subscription := range subscriptions {
@@ -201,6 +476,15 @@ func (ch *commonHub) handleBroadcast(blockEvents data.BlockEvents) {
 	}
 }
 
+// addBlockTimestamp keeps the timestamp of the block, used to check the TTL of the native-auth tokens
+func (ch *commonHub) addBlockTimestamp(hash string, timestamp uint64) {
+	if hash == "" || timestamp == 0 {
+		return
+	}
+
+	ch.blockTimestamps.Add(hash, timestamp)
+}
+
 func (ch *commonHub) handlePushBlockEvents(blockEvents data.BlockEvents, subscription data.Subscription) {
 	events := make([]data.Event, 0)
 	for _, event := range blockEvents.Events {
@@ -218,6 +502,11 @@ func (ch *commonHub) handlePushBlockEvents(blockEvents data.BlockEvents, subscri
 }
 
 func (ch *commonHub) handleRevertBroadcast(revertBlock data.RevertBlock) {
+	defer ch.observeDispatch(time.Now())
+	ctx := tracing.ExtractContext(context.Background(), revertBlock.TraceContext)
+	_, span := tracing.StartSpan(ctx, "hub.Dispatch", revertBlock.Hash)
+	defer span.End()
+
 	subscriptions := ch.subscriptionMapper.Subscriptions()
 
 	dispatchersMap := make(map[uuid.UUID]data.RevertBlock)
@@ -240,6 +529,11 @@ func (ch *commonHub) handleRevertBroadcast(revertBlock data.RevertBlock) {
 }
 
 func (ch *commonHub) handleFinalizedBroadcast(finalizedBlock data.FinalizedBlock) {
+	defer ch.observeDispatch(time.Now())
+	ctx := tracing.ExtractContext(context.Background(), finalizedBlock.TraceContext)
+	_, span := tracing.StartSpan(ctx, "hub.Dispatch", finalizedBlock.Hash)
+	defer span.End()
+
 	subscriptions := ch.subscriptionMapper.Subscriptions()
 
 	dispatchersMap := make(map[uuid.UUID]data.FinalizedBlock)
@@ -262,6 +556,11 @@ func (ch *commonHub) handleFinalizedBroadcast(finalizedBlock data.FinalizedBlock
 }
 
 func (ch *commonHub) handleTxsBroadcast(blockTxs data.BlockTxs) {
+	defer ch.observeDispatch(time.Now())
+	ctx := tracing.ExtractContext(context.Background(), blockTxs.TraceContext)
+	_, span := tracing.StartSpan(ctx, "hub.Dispatch", blockTxs.Hash)
+	defer span.End()
+
 	subscriptions := ch.subscriptionMapper.Subscriptions()
 
 	dispatchersMap := make(map[uuid.UUID]data.BlockTxs)
@@ -284,6 +583,13 @@ func (ch *commonHub) handleTxsBroadcast(blockTxs data.BlockTxs) {
 }
 
 func (ch *commonHub) handleBlockEventsWithOrderBroadcast(blockTxs data.BlockEventsWithOrder) {
+	defer ch.observeDispatch(time.Now())
+	ctx := tracing.ExtractContext(context.Background(), blockTxs.TraceContext)
+	_, span := tracing.StartSpan(ctx, "hub.Dispatch", blockTxs.Hash)
+	defer span.End()
+
+	ch.addBlockTimestamp(blockTxs.Hash, blockTxs.TimeStamp)
+
 	subscriptions := ch.subscriptionMapper.Subscriptions()
 
 	dispatchersMap := make(map[uuid.UUID]data.BlockEventsWithOrder)
@@ -306,6 +612,11 @@ func (ch *commonHub) handleBlockEventsWithOrderBroadcast(blockTxs data.BlockEven
 }
 
 func (ch *commonHub) handleScrsBroadcast(blockScrs data.BlockScrs) {
+	defer ch.observeDispatch(time.Now())
+	ctx := tracing.ExtractContext(context.Background(), blockScrs.TraceContext)
+	_, span := tracing.StartSpan(ctx, "hub.Dispatch", blockScrs.Hash)
+	defer span.End()
+
 	subscriptions := ch.subscriptionMapper.Subscriptions()
 
 	dispatchersMap := make(map[uuid.UUID]data.BlockScrs)
@@ -327,6 +638,363 @@ func (ch *commonHub) handleScrsBroadcast(blockScrs data.BlockScrs) {
 	}
 }
 
+func (ch *commonHub) handleRewardsBroadcast(blockRewards data.BlockRewards) {
+	defer ch.observeDispatch(time.Now())
+	ctx := tracing.ExtractContext(context.Background(), blockRewards.TraceContext)
+	_, span := tracing.StartSpan(ctx, "hub.Dispatch", blockRewards.Hash)
+	defer span.End()
+
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.BlockRewards)
+
+	for _, subscription := range subscriptions {
+		if subscription.EventType != common.BlockRewards {
+			continue
+		}
+
+		dispatchersMap[subscription.DispatcherID] = blockRewards
+	}
+
+	ch.mutDispatchers.RLock()
+	defer ch.mutDispatchers.RUnlock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.RewardsEvent(event)
+		}
+	}
+}
+
+func (ch *commonHub) handleReceiptsBroadcast(blockReceipts data.BlockReceipts) {
+	defer ch.observeDispatch(time.Now())
+	ctx := tracing.ExtractContext(context.Background(), blockReceipts.TraceContext)
+	_, span := tracing.StartSpan(ctx, "hub.Dispatch", blockReceipts.Hash)
+	defer span.End()
+
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.BlockReceipts)
+
+	for _, subscription := range subscriptions {
+		if subscription.EventType != common.BlockReceipts {
+			continue
+		}
+
+		dispatchersMap[subscription.DispatcherID] = blockReceipts
+	}
+
+	ch.mutDispatchers.RLock()
+	defer ch.mutDispatchers.RUnlock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.ReceiptsEvent(event)
+		}
+	}
+}
+
+func (ch *commonHub) handleInvalidTxsBroadcast(blockInvalidTxs data.BlockInvalidTxs) {
+	defer ch.observeDispatch(time.Now())
+	ctx := tracing.ExtractContext(context.Background(), blockInvalidTxs.TraceContext)
+	_, span := tracing.StartSpan(ctx, "hub.Dispatch", blockInvalidTxs.Hash)
+	defer span.End()
+
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.BlockInvalidTxs)
+
+	for _, subscription := range subscriptions {
+		if subscription.EventType != common.BlockInvalidTxs {
+			continue
+		}
+
+		dispatchersMap[subscription.DispatcherID] = blockInvalidTxs
+	}
+
+	ch.mutDispatchers.RLock()
+	defer ch.mutDispatchers.RUnlock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.InvalidTxsEvent(event)
+		}
+	}
+}
+
+func (ch *commonHub) handleAlteredAccountsBroadcast(blockAlteredAccounts data.BlockAlteredAccounts) {
+	defer ch.observeDispatch(time.Now())
+	ctx := tracing.ExtractContext(context.Background(), blockAlteredAccounts.TraceContext)
+	_, span := tracing.StartSpan(ctx, "hub.Dispatch", blockAlteredAccounts.Hash)
+	defer span.End()
+
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	subscriptionsMap := make(map[uuid.UUID][]data.Subscription)
+
+	for _, subscription := range subscriptions {
+		if subscription.EventType != common.AlteredAccounts {
+			continue
+		}
+
+		subscriptionsMap[subscription.DispatcherID] = append(subscriptionsMap[subscription.DispatcherID], subscription)
+	}
+
+	ch.mutDispatchers.RLock()
+	defer ch.mutDispatchers.RUnlock()
+	for id, dispatcherSubscriptions := range subscriptionsMap {
+		d, ok := ch.dispatchers[id]
+		if !ok {
+			continue
+		}
+
+		alteredAccounts := filterAlteredAccounts(blockAlteredAccounts.AlteredAccounts, dispatcherSubscriptions)
+		if len(alteredAccounts) == 0 {
+			continue
+		}
+
+		d.AlteredAccountsEvent(data.BlockAlteredAccounts{
+			Hash:            blockAlteredAccounts.Hash,
+			AlteredAccounts: alteredAccounts,
+		})
+	}
+}
+
+// filterAlteredAccounts returns the altered accounts matching at least one of the subscriptions. A subscription
+// matches the accounts of its address, or all the accounts if no address is set. If the subscription has an
+// identifier, it is used as token identifier and only the matching token balances of the account are kept
+func filterAlteredAccounts(alteredAccounts map[string]*alteredAccount.AlteredAccount, subscriptions []data.Subscription) map[string]*alteredAccount.AlteredAccount {
+	filteredAccounts := make(map[string]*alteredAccount.AlteredAccount)
+	for address, account := range alteredAccounts {
+		if account == nil {
+			continue
+		}
+
+		matchesAllTokens := false
+		tokenIdentifiers := make(map[string]struct{})
+		for _, subscription := range subscriptions {
+			if subscription.Address != "" && subscription.Address != account.Address {
+				continue
+			}
+			if subscription.Identifier == "" {
+				matchesAllTokens = true
+				break
+			}
+
+			tokenIdentifiers[subscription.Identifier] = struct{}{}
+		}
+
+		if matchesAllTokens {
+			filteredAccounts[address] = account
+			continue
+		}
+		if len(tokenIdentifiers) == 0 {
+			continue
+		}
+
+		tokens := make([]*alteredAccount.AccountTokenData, 0)
+		for _, token := range account.Tokens {
+			if token == nil {
+				continue
+			}
+			if _, ok := tokenIdentifiers[token.Identifier]; ok {
+				tokens = append(tokens, token)
+			}
+		}
+		if len(tokens) == 0 {
+			continue
+		}
+
+		filteredAccount := *account
+		filteredAccount.Tokens = tokens
+		filteredAccounts[address] = &filteredAccount
+	}
+
+	return filteredAccounts
+}
+
+func (ch *commonHub) handleBlockHeaderBroadcast(blockHeader data.BlockHeader) {
+	defer ch.observeDispatch(time.Now())
+	ctx := tracing.ExtractContext(context.Background(), blockHeader.TraceContext)
+	_, span := tracing.StartSpan(ctx, "hub.Dispatch", blockHeader.Hash)
+	defer span.End()
+
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.BlockHeader)
+
+	for _, subscription := range subscriptions {
+		if subscription.EventType != common.BlockHeader {
+			continue
+		}
+
+		dispatchersMap[subscription.DispatcherID] = blockHeader
+	}
+
+	ch.mutDispatchers.RLock()
+	defer ch.mutDispatchers.RUnlock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.BlockHeaderEvent(event)
+		}
+	}
+}
+
+func (ch *commonHub) handleEpochStartBroadcast(epochStart data.EpochStart) {
+	defer ch.observeDispatch(time.Now())
+	ctx := tracing.ExtractContext(context.Background(), epochStart.TraceContext)
+	_, span := tracing.StartSpan(ctx, "hub.Dispatch", epochStart.Hash)
+	defer span.End()
+
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.EpochStart)
+
+	for _, subscription := range subscriptions {
+		if subscription.EventType != common.EpochStart {
+			continue
+		}
+
+		dispatchersMap[subscription.DispatcherID] = epochStart
+	}
+
+	ch.mutDispatchers.RLock()
+	defer ch.mutDispatchers.RUnlock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.EpochStartEvent(event)
+		}
+	}
+}
+
+func (ch *commonHub) handleTxBundlesBroadcast(blockTxBundles data.BlockTxBundles) {
+	defer ch.observeDispatch(time.Now())
+	ctx := tracing.ExtractContext(context.Background(), blockTxBundles.TraceContext)
+	_, span := tracing.StartSpan(ctx, "hub.Dispatch", blockTxBundles.Hash)
+	defer span.End()
+
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	subscriptionsMap := make(map[uuid.UUID][]data.Subscription)
+
+	for _, subscription := range subscriptions {
+		if subscription.EventType != common.TxBundles {
+			continue
+		}
+
+		subscriptionsMap[subscription.DispatcherID] = append(subscriptionsMap[subscription.DispatcherID], subscription)
+	}
+
+	ch.mutDispatchers.RLock()
+	defer ch.mutDispatchers.RUnlock()
+	for id, dispatcherSubscriptions := range subscriptionsMap {
+		d, ok := ch.dispatchers[id]
+		if !ok {
+			continue
+		}
+
+		bundles := filterTxBundles(blockTxBundles.Bundles, dispatcherSubscriptions)
+		if len(bundles) == 0 {
+			continue
+		}
+
+		d.TxBundlesEvent(data.BlockTxBundles{
+			Hash:      blockTxBundles.Hash,
+			ShardID:   blockTxBundles.ShardID,
+			Nonce:     blockTxBundles.Nonce,
+			Round:     blockTxBundles.Round,
+			Epoch:     blockTxBundles.Epoch,
+			TimeStamp: blockTxBundles.TimeStamp,
+			Bundles:   bundles,
+		})
+	}
+}
+
+// filterTxBundles returns the bundles matching at least one of the subscriptions. A subscription matches
+// the bundles having its address as sender or receiver, or all the bundles if no address is set
+func filterTxBundles(bundles []*data.TxBundle, subscriptions []data.Subscription) []*data.TxBundle {
+	filteredBundles := make([]*data.TxBundle, 0)
+	for _, bundle := range bundles {
+		if bundle == nil {
+			continue
+		}
+
+		for _, subscription := range subscriptions {
+			if subscription.Address == "" || subscription.Address == bundle.Sender || subscription.Address == bundle.Receiver {
+				filteredBundles = append(filteredBundles, bundle)
+				break
+			}
+		}
+	}
+
+	return filteredBundles
+}
+
+func (ch *commonHub) handleTxStatusBroadcast(txStatus data.TxStatus) {
+	defer ch.observeDispatch(time.Now())
+	ctx := tracing.ExtractContext(context.Background(), txStatus.TraceContext)
+	_, span := tracing.StartSpan(ctx, "hub.Dispatch", txStatus.TxHash)
+	defer span.End()
+
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.TxStatus)
+
+	for _, subscription := range subscriptions {
+		if subscription.EventType != common.TxStatus {
+			continue
+		}
+		if !isTxStatusMatchingSubscription(txStatus, subscription) {
+			continue
+		}
+
+		dispatchersMap[subscription.DispatcherID] = txStatus
+	}
+
+	ch.mutDispatchers.RLock()
+	defer ch.mutDispatchers.RUnlock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.TxStatusEvent(event)
+		}
+	}
+}
+
+// isTxStatusMatchingSubscription returns true if the subscription has no tx hash or the same tx hash as
+// the status, and no address or the address of the transaction sender or receiver
+func isTxStatusMatchingSubscription(txStatus data.TxStatus, subscription data.Subscription) bool {
+	if subscription.TxHash != "" && subscription.TxHash != txStatus.TxHash {
+		return false
+	}
+
+	return subscription.Address == "" || subscription.Address == txStatus.Sender || subscription.Address == txStatus.Receiver
+}
+
+func (ch *commonHub) handleSourceStaleBroadcast(event data.SourceStaleEvent) {
+	defer ch.observeDispatch(time.Now())
+
+	subscriptions := ch.subscriptionMapper.Subscriptions()
+
+	dispatchersMap := make(map[uuid.UUID]data.SourceStaleEvent)
+
+	for _, subscription := range subscriptions {
+		if subscription.EventType != common.SourceStaleEvents {
+			continue
+		}
+
+		dispatchersMap[subscription.DispatcherID] = event
+	}
+
+	ch.mutDispatchers.RLock()
+	defer ch.mutDispatchers.RUnlock()
+	for id, event := range dispatchersMap {
+		if d, ok := ch.dispatchers[id]; ok {
+			d.SourceStaleEvent(event)
+		}
+	}
+}
+
+func (ch *commonHub) observeDispatch(startTime time.Time) {
+	ch.metricsHandler.ObserveStage(common.HubDispatchStage, time.Since(startTime))
+}
+
 func (ch *commonHub) registerDispatcher(d dispatcher.EventDispatcher) {
 	ch.mutDispatchers.Lock()
 	defer ch.mutDispatchers.Unlock()
@@ -336,6 +1004,7 @@ func (ch *commonHub) registerDispatcher(d dispatcher.EventDispatcher) {
 	}
 
 	ch.dispatchers[d.GetID()] = d
+	ch.metricsHandler.SetWSConnections(len(ch.dispatchers))
 
 	log.Info("registered new dispatcher", "dispatcherID", d.GetID())
 }
@@ -347,10 +1016,58 @@ func (ch *commonHub) unregisterDispatcher(d dispatcher.EventDispatcher) {
 	if _, ok := ch.dispatchers[d.GetID()]; ok {
 		delete(ch.dispatchers, d.GetID())
 	}
+	ch.metricsHandler.SetWSConnections(len(ch.dispatchers))
 
 	log.Info("unregistered dispatcher", "dispatcherID", d.GetID(), "unsubscribing", true)
 
 	ch.subscriptionMapper.RemoveSubscriptions(d.GetID())
+	ch.metricsHandler.SetWSSubscriptions(len(ch.subscriptionMapper.Subscriptions()))
+}
+
+// Drain stops the hub loop after the pending events are dispatched, then shuts down the dispatchers,
+// which send their queued messages and a close frame to the websocket clients. An error is returned
+// if the context is done before all the data is delivered
+func (ch *commonHub) Drain(ctx context.Context) error {
+	if ch.cancelFunc != nil {
+		ch.cancelFunc()
+
+		select {
+		case <-ch.runDone:
+		case <-ctx.Done():
+			return fmt.Errorf("%w: hub did not finish dispatching: %s", common.ErrUndeliveredData, ctx.Err().Error())
+		}
+	}
+
+	ch.mutDispatchers.RLock()
+	dispatchers := make([]dispatcher.EventDispatcher, 0, len(ch.dispatchers))
+	for _, d := range ch.dispatchers {
+		dispatchers = append(dispatchers, d)
+	}
+	ch.mutDispatchers.RUnlock()
+
+	numFailed := uint32(0)
+	wg := sync.WaitGroup{}
+	wg.Add(len(dispatchers))
+	for _, d := range dispatchers {
+		go func(d dispatcher.EventDispatcher) {
+			defer wg.Done()
+
+			err := d.Shutdown(ctx)
+			if err != nil {
+				log.Warn("dispatcher not drained", "dispatcherID", d.GetID(), "err", err.Error())
+				atomic.AddUint32(&numFailed, 1)
+			}
+		}(d)
+	}
+	wg.Wait()
+
+	if numFailed > 0 {
+		return fmt.Errorf("%w: %d of %d dispatchers not drained", common.ErrUndeliveredData, numFailed, len(dispatchers))
+	}
+
+	log.Info("hub drained", "num dispatchers", len(dispatchers))
+
+	return nil
 }
 
 // Close will close the goroutine and channels
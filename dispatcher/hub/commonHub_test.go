@@ -1,10 +1,14 @@
 package hub
 
 import (
+	"context"
+	"errors"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
@@ -16,8 +20,10 @@ import (
 
 func createMockCommonHubArgs() ArgsCommonHub {
 	return ArgsCommonHub{
-		Filter:             filters.NewDefaultFilter(),
-		SubscriptionMapper: dispatcher.NewSubscriptionMapper(),
+		Filter:               filters.NewDefaultFilter(),
+		SubscriptionMapper:   dispatcher.NewSubscriptionMapper(),
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+		BlockTimestamps:      &mocks.BlockTimestampsHandlerStub{},
 	}
 }
 
@@ -46,6 +52,28 @@ func TestNewCommonHub(t *testing.T) {
 		assert.Equal(t, ErrNilSubscriptionMapper, err)
 	})
 
+	t.Run("nil status metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		args.StatusMetricsHandler = nil
+
+		hub, err := NewCommonHub(args)
+		require.Nil(t, hub)
+		assert.Equal(t, common.ErrNilStatusMetricsHandler, err)
+	})
+
+	t.Run("nil block timestamps handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		args.BlockTimestamps = nil
+
+		hub, err := NewCommonHub(args)
+		require.Nil(t, hub)
+		assert.Equal(t, dispatcher.ErrNilBlockTimestampsHandler, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -131,6 +159,75 @@ func TestCommonHub_HandleBroadcastDispatcherReceivesEvents(t *testing.T) {
 	require.True(t, len(consumer.CollectedEvents()) == len(blockEvents.Events))
 }
 
+func TestCommonHub_Metrics(t *testing.T) {
+	t.Parallel()
+
+	numConnections := int32(-1)
+	numSubscriptions := int32(-1)
+	numDispatchObservations := int32(0)
+	args := createMockCommonHubArgs()
+	args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+		SetWSConnectionsCalled: func(count int) {
+			atomic.StoreInt32(&numConnections, int32(count))
+		},
+		SetWSSubscriptionsCalled: func(count int) {
+			atomic.StoreInt32(&numSubscriptions, int32(count))
+		},
+		ObserveStageCalled: func(stage string, duration time.Duration) {
+			assert.Equal(t, common.HubDispatchStage, stage)
+			atomic.AddInt32(&numDispatchObservations, 1)
+		},
+	}
+	hub, err := NewCommonHub(args)
+	require.Nil(t, err)
+
+	dispatcher1 := mocks.NewDispatcherMock(mocks.NewConsumerMock(), hub)
+	dispatcher2 := mocks.NewDispatcherMock(mocks.NewConsumerMock(), hub)
+
+	hub.registerDispatcher(dispatcher1)
+	hub.registerDispatcher(dispatcher2)
+	require.Equal(t, int32(2), atomic.LoadInt32(&numConnections))
+
+	hub.Subscribe(data.SubscribeEvent{
+		DispatcherID: dispatcher1.GetID(),
+		SubscriptionEntries: []data.SubscriptionEntry{
+			{Identifier: "transfer"},
+			{Identifier: "swap"},
+		},
+	})
+	hub.Subscribe(data.SubscribeEvent{
+		DispatcherID: dispatcher2.GetID(),
+	})
+	require.Equal(t, int32(3), atomic.LoadInt32(&numSubscriptions))
+
+	hub.handleBroadcast(getEvents())
+	require.Equal(t, int32(1), atomic.LoadInt32(&numDispatchObservations))
+
+	hub.unregisterDispatcher(dispatcher1)
+	require.Equal(t, int32(1), atomic.LoadInt32(&numConnections))
+	require.Equal(t, int32(1), atomic.LoadInt32(&numSubscriptions))
+}
+
+func TestCommonHub_HandleBroadcastShouldKeepBlockTimestamps(t *testing.T) {
+	t.Parallel()
+
+	blockTimestamps := make(map[string]uint64)
+	args := createMockCommonHubArgs()
+	args.BlockTimestamps = &mocks.BlockTimestampsHandlerStub{
+		AddCalled: func(hash string, timestamp uint64) {
+			blockTimestamps[hash] = timestamp
+		},
+	}
+	hub, err := NewCommonHub(args)
+	require.Nil(t, err)
+
+	hub.handleBroadcast(data.BlockEvents{Hash: "hash1", TimeStamp: 100})
+	hub.handleBlockEventsWithOrderBroadcast(data.BlockEventsWithOrder{Hash: "hash2", TimeStamp: 106})
+	hub.handleBroadcast(data.BlockEvents{Hash: "hash3"})
+
+	require.Equal(t, map[string]uint64{"hash1": 100, "hash2": 106}, blockTimestamps)
+}
+
 func TestCommonHub_HandleBroadcastMultipleDispatchers(t *testing.T) {
 	t.Parallel()
 
@@ -190,6 +287,26 @@ func TestCommonHubRun(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestCommonHub_CheckHealth(t *testing.T) {
+	t.Parallel()
+
+	args := createMockCommonHubArgs()
+	hub, err := NewCommonHub(args)
+	require.Nil(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = hub.CheckHealth(ctx)
+	require.True(t, errors.Is(err, ErrHubNotResponding))
+
+	hub.Run()
+	err = hub.CheckHealth(context.Background())
+	require.Nil(t, err)
+
+	err = hub.Close()
+	require.Nil(t, err)
+}
+
 func TestCommonHub_HandleRevertBroadcast(t *testing.T) {
 	t.Parallel()
 
@@ -371,6 +488,611 @@ func TestCommonHub_HandleScrsBroadcast(t *testing.T) {
 	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
 }
 
+func TestCommonHub_HandleRewardsBroadcast(t *testing.T) {
+	t.Parallel()
+
+	args := createMockCommonHubArgs()
+	hub, err := NewCommonHub(args)
+	require.Nil(t, err)
+
+	numCalls := uint32(0)
+	hub.registerDispatcher(&mocks.DispatcherStub{
+		RewardsEventCalled: func(event data.BlockRewards) {
+			atomic.AddUint32(&numCalls, 1)
+		},
+	})
+
+	hub.Subscribe(data.SubscribeEvent{
+		SubscriptionEntries: []data.SubscriptionEntry{
+			{
+				EventType: common.BlockRewards,
+			},
+		},
+	})
+
+	hub.Run()
+	defer hub.Close()
+
+	blockEvents := data.BlockRewards{
+		Hash: "hash1",
+	}
+
+	hub.BroadcastRewards(blockEvents)
+
+	time.Sleep(time.Millisecond * 100)
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestCommonHub_HandleReceiptsBroadcast(t *testing.T) {
+	t.Parallel()
+
+	args := createMockCommonHubArgs()
+	hub, err := NewCommonHub(args)
+	require.Nil(t, err)
+
+	numCalls := uint32(0)
+	hub.registerDispatcher(&mocks.DispatcherStub{
+		ReceiptsEventCalled: func(event data.BlockReceipts) {
+			atomic.AddUint32(&numCalls, 1)
+		},
+	})
+
+	hub.Subscribe(data.SubscribeEvent{
+		SubscriptionEntries: []data.SubscriptionEntry{
+			{
+				EventType: common.BlockReceipts,
+			},
+		},
+	})
+
+	hub.Run()
+	defer hub.Close()
+
+	blockEvents := data.BlockReceipts{
+		Hash: "hash1",
+	}
+
+	hub.BroadcastReceipts(blockEvents)
+
+	time.Sleep(time.Millisecond * 100)
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestCommonHub_HandleInvalidTxsBroadcast(t *testing.T) {
+	t.Parallel()
+
+	args := createMockCommonHubArgs()
+	hub, err := NewCommonHub(args)
+	require.Nil(t, err)
+
+	numCalls := uint32(0)
+	hub.registerDispatcher(&mocks.DispatcherStub{
+		InvalidTxsEventCalled: func(event data.BlockInvalidTxs) {
+			atomic.AddUint32(&numCalls, 1)
+		},
+	})
+
+	hub.Subscribe(data.SubscribeEvent{
+		SubscriptionEntries: []data.SubscriptionEntry{
+			{
+				EventType: common.BlockInvalidTxs,
+			},
+		},
+	})
+
+	hub.Run()
+	defer hub.Close()
+
+	blockEvents := data.BlockInvalidTxs{
+		Hash: "hash1",
+	}
+
+	hub.BroadcastInvalidTxs(blockEvents)
+
+	time.Sleep(time.Millisecond * 100)
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestCommonHub_HandleBlockHeaderBroadcast(t *testing.T) {
+	t.Parallel()
+
+	args := createMockCommonHubArgs()
+	hub, err := NewCommonHub(args)
+	require.Nil(t, err)
+
+	numCalls := uint32(0)
+	hub.registerDispatcher(&mocks.DispatcherStub{
+		BlockHeaderEventCalled: func(event data.BlockHeader) {
+			atomic.AddUint32(&numCalls, 1)
+		},
+	})
+
+	hub.Subscribe(data.SubscribeEvent{
+		SubscriptionEntries: []data.SubscriptionEntry{
+			{
+				EventType: common.BlockHeader,
+			},
+		},
+	})
+
+	hub.Run()
+	defer hub.Close()
+
+	blockEvents := data.BlockHeader{
+		Hash: "hash1",
+	}
+
+	hub.BroadcastBlockHeader(blockEvents)
+
+	time.Sleep(time.Millisecond * 100)
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestCommonHub_HandleEpochStartBroadcast(t *testing.T) {
+	t.Parallel()
+
+	args := createMockCommonHubArgs()
+	hub, err := NewCommonHub(args)
+	require.Nil(t, err)
+
+	numCalls := uint32(0)
+	hub.registerDispatcher(&mocks.DispatcherStub{
+		EpochStartEventCalled: func(event data.EpochStart) {
+			atomic.AddUint32(&numCalls, 1)
+		},
+	})
+
+	hub.Subscribe(data.SubscribeEvent{
+		SubscriptionEntries: []data.SubscriptionEntry{
+			{
+				EventType: common.EpochStart,
+			},
+		},
+	})
+
+	hub.Run()
+	defer hub.Close()
+
+	blockEvents := data.EpochStart{
+		Hash: "hash1",
+	}
+
+	hub.BroadcastEpochStart(blockEvents)
+
+	time.Sleep(time.Millisecond * 100)
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestCommonHub_HandleTxBundlesBroadcast(t *testing.T) {
+	t.Parallel()
+
+	blockTxBundles := data.BlockTxBundles{
+		Hash:  "hash1",
+		Nonce: 10,
+		Bundles: []*data.TxBundle{
+			{TxHash: "txHash1", Sender: "erd1addr1", Receiver: "erd1addr2"},
+			{TxHash: "txHash2", Sender: "erd1addr3", Receiver: "erd1addr1"},
+			{TxHash: "txHash3", Sender: "erd1addr2", Receiver: "erd1addr3"},
+		},
+	}
+
+	t.Run("should filter by sender or receiver address", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		hub, err := NewCommonHub(args)
+		require.Nil(t, err)
+
+		var receivedEvents []data.BlockTxBundles
+		hub.registerDispatcher(&mocks.DispatcherStub{
+			TxBundlesEventCalled: func(event data.BlockTxBundles) {
+				receivedEvents = append(receivedEvents, event)
+			},
+		})
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType: common.TxBundles,
+					Address:   "erd1addr1",
+				},
+			},
+		})
+
+		hub.handleTxBundlesBroadcast(blockTxBundles)
+
+		require.Equal(t, 1, len(receivedEvents))
+		assert.Equal(t, "hash1", receivedEvents[0].Hash)
+		assert.Equal(t, uint64(10), receivedEvents[0].Nonce)
+		assert.Equal(t, []*data.TxBundle{
+			blockTxBundles.Bundles[0],
+			blockTxBundles.Bundles[1],
+		}, receivedEvents[0].Bundles)
+	})
+
+	t.Run("no address, should dispatch all bundles", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		hub, err := NewCommonHub(args)
+		require.Nil(t, err)
+
+		var receivedEvents []data.BlockTxBundles
+		hub.registerDispatcher(&mocks.DispatcherStub{
+			TxBundlesEventCalled: func(event data.BlockTxBundles) {
+				receivedEvents = append(receivedEvents, event)
+			},
+		})
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType: common.TxBundles,
+				},
+			},
+		})
+
+		hub.handleTxBundlesBroadcast(blockTxBundles)
+
+		require.Equal(t, 1, len(receivedEvents))
+		assert.Equal(t, blockTxBundles.Bundles, receivedEvents[0].Bundles)
+	})
+
+	t.Run("no matching bundle, should not dispatch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		hub, err := NewCommonHub(args)
+		require.Nil(t, err)
+
+		wasCalled := false
+		hub.registerDispatcher(&mocks.DispatcherStub{
+			TxBundlesEventCalled: func(event data.BlockTxBundles) {
+				wasCalled = true
+			},
+		})
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType: common.TxBundles,
+					Address:   "erd1addr4",
+				},
+			},
+		})
+
+		hub.handleTxBundlesBroadcast(blockTxBundles)
+
+		assert.False(t, wasCalled)
+	})
+
+	t.Run("should dispatch through the hub loop", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		hub, err := NewCommonHub(args)
+		require.Nil(t, err)
+
+		numCalls := uint32(0)
+		hub.registerDispatcher(&mocks.DispatcherStub{
+			TxBundlesEventCalled: func(event data.BlockTxBundles) {
+				atomic.AddUint32(&numCalls, 1)
+			},
+		})
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType: common.TxBundles,
+				},
+			},
+		})
+
+		hub.Run()
+		defer hub.Close()
+
+		hub.BroadcastTxBundles(blockTxBundles)
+
+		time.Sleep(time.Millisecond * 100)
+
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
+}
+
+func TestCommonHub_HandleTxStatusBroadcast(t *testing.T) {
+	t.Parallel()
+
+	txStatus := data.TxStatus{
+		TxHash:   "txHash1",
+		Sender:   "erd1addr1",
+		Receiver: "erd1addr2",
+		Status:   common.TxStatusSuccess,
+	}
+
+	testCases := []struct {
+		name           string
+		entry          data.SubscriptionEntry
+		shouldDispatch bool
+	}{
+		{
+			name:           "no tx hash and no address, should dispatch",
+			entry:          data.SubscriptionEntry{EventType: common.TxStatus},
+			shouldDispatch: true,
+		},
+		{
+			name:           "matching tx hash, should dispatch",
+			entry:          data.SubscriptionEntry{EventType: common.TxStatus, TxHash: "txHash1"},
+			shouldDispatch: true,
+		},
+		{
+			name:           "other tx hash, should not dispatch",
+			entry:          data.SubscriptionEntry{EventType: common.TxStatus, TxHash: "txHash2"},
+			shouldDispatch: false,
+		},
+		{
+			name:           "matching sender, should dispatch",
+			entry:          data.SubscriptionEntry{EventType: common.TxStatus, Address: "erd1addr1"},
+			shouldDispatch: true,
+		},
+		{
+			name:           "matching receiver, should dispatch",
+			entry:          data.SubscriptionEntry{EventType: common.TxStatus, Address: "erd1addr2"},
+			shouldDispatch: true,
+		},
+		{
+			name:           "other address, should not dispatch",
+			entry:          data.SubscriptionEntry{EventType: common.TxStatus, Address: "erd1addr3"},
+			shouldDispatch: false,
+		},
+		{
+			name:           "other event type, should not dispatch",
+			entry:          data.SubscriptionEntry{EventType: common.TxBundles},
+			shouldDispatch: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := createMockCommonHubArgs()
+			hub, err := NewCommonHub(args)
+			require.Nil(t, err)
+
+			var receivedEvents []data.TxStatus
+			hub.registerDispatcher(&mocks.DispatcherStub{
+				TxStatusEventCalled: func(event data.TxStatus) {
+					receivedEvents = append(receivedEvents, event)
+				},
+			})
+			hub.Subscribe(data.SubscribeEvent{
+				SubscriptionEntries: []data.SubscriptionEntry{tc.entry},
+			})
+
+			hub.handleTxStatusBroadcast(txStatus)
+
+			if !tc.shouldDispatch {
+				assert.Equal(t, 0, len(receivedEvents))
+				return
+			}
+			require.Equal(t, 1, len(receivedEvents))
+			assert.Equal(t, txStatus, receivedEvents[0])
+		})
+	}
+
+	t.Run("should dispatch through the hub loop", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		hub, err := NewCommonHub(args)
+		require.Nil(t, err)
+
+		numCalls := uint32(0)
+		hub.registerDispatcher(&mocks.DispatcherStub{
+			TxStatusEventCalled: func(event data.TxStatus) {
+				atomic.AddUint32(&numCalls, 1)
+			},
+		})
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType: common.TxStatus,
+					TxHash:    "txHash1",
+				},
+			},
+		})
+
+		hub.Run()
+		defer hub.Close()
+
+		hub.BroadcastTxStatus(txStatus)
+
+		time.Sleep(time.Millisecond * 100)
+
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
+}
+
+func TestCommonHub_HandleAlteredAccountsBroadcast(t *testing.T) {
+	t.Parallel()
+
+	blockAlteredAccounts := data.BlockAlteredAccounts{
+		Hash: "hash1",
+		AlteredAccounts: map[string]*alteredAccount.AlteredAccount{
+			"erd1addr1": {
+				Address: "erd1addr1",
+				Balance: "10",
+				Tokens: []*alteredAccount.AccountTokenData{
+					{Identifier: "TKN-abcdef", Balance: "5"},
+					{Identifier: "OTHER-abcdef", Balance: "7"},
+				},
+			},
+			"erd1addr2": {
+				Address: "erd1addr2",
+				Balance: "20",
+			},
+		},
+	}
+
+	t.Run("should filter by address", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		hub, err := NewCommonHub(args)
+		require.Nil(t, err)
+
+		var receivedEvents []data.BlockAlteredAccounts
+		hub.registerDispatcher(&mocks.DispatcherStub{
+			AlteredAccountsEventCalled: func(event data.BlockAlteredAccounts) {
+				receivedEvents = append(receivedEvents, event)
+			},
+		})
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType: common.AlteredAccounts,
+					Address:   "erd1addr2",
+				},
+			},
+		})
+
+		hub.handleAlteredAccountsBroadcast(blockAlteredAccounts)
+
+		require.Equal(t, 1, len(receivedEvents))
+		assert.Equal(t, "hash1", receivedEvents[0].Hash)
+		assert.Equal(t, map[string]*alteredAccount.AlteredAccount{
+			"erd1addr2": blockAlteredAccounts.AlteredAccounts["erd1addr2"],
+		}, receivedEvents[0].AlteredAccounts)
+	})
+
+	t.Run("should filter by token identifier", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		hub, err := NewCommonHub(args)
+		require.Nil(t, err)
+
+		var receivedEvents []data.BlockAlteredAccounts
+		hub.registerDispatcher(&mocks.DispatcherStub{
+			AlteredAccountsEventCalled: func(event data.BlockAlteredAccounts) {
+				receivedEvents = append(receivedEvents, event)
+			},
+		})
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType:  common.AlteredAccounts,
+					Identifier: "TKN-abcdef",
+				},
+			},
+		})
+
+		hub.handleAlteredAccountsBroadcast(blockAlteredAccounts)
+
+		require.Equal(t, 1, len(receivedEvents))
+		require.Equal(t, 1, len(receivedEvents[0].AlteredAccounts))
+		account := receivedEvents[0].AlteredAccounts["erd1addr1"]
+		require.NotNil(t, account)
+		assert.Equal(t, "10", account.Balance)
+		assert.Equal(t, []*alteredAccount.AccountTokenData{{Identifier: "TKN-abcdef", Balance: "5"}}, account.Tokens)
+		assert.Equal(t, 2, len(blockAlteredAccounts.AlteredAccounts["erd1addr1"].Tokens))
+	})
+
+	t.Run("no matching account, should not dispatch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		hub, err := NewCommonHub(args)
+		require.Nil(t, err)
+
+		wasCalled := false
+		hub.registerDispatcher(&mocks.DispatcherStub{
+			AlteredAccountsEventCalled: func(event data.BlockAlteredAccounts) {
+				wasCalled = true
+			},
+		})
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType:  common.AlteredAccounts,
+					Address:    "erd1addr2",
+					Identifier: "TKN-abcdef",
+				},
+			},
+		})
+
+		hub.handleAlteredAccountsBroadcast(blockAlteredAccounts)
+
+		assert.False(t, wasCalled)
+	})
+
+	t.Run("should dispatch through the hub loop", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockCommonHubArgs()
+		hub, err := NewCommonHub(args)
+		require.Nil(t, err)
+
+		numCalls := uint32(0)
+		hub.registerDispatcher(&mocks.DispatcherStub{
+			AlteredAccountsEventCalled: func(event data.BlockAlteredAccounts) {
+				atomic.AddUint32(&numCalls, 1)
+			},
+		})
+		hub.Subscribe(data.SubscribeEvent{
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{
+					EventType: common.AlteredAccounts,
+				},
+			},
+		})
+
+		hub.Run()
+		defer hub.Close()
+
+		hub.BroadcastAlteredAccounts(blockAlteredAccounts)
+
+		time.Sleep(time.Millisecond * 100)
+
+		assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
+}
+
+func TestCommonHub_HandleSourceStaleBroadcast(t *testing.T) {
+	t.Parallel()
+
+	args := createMockCommonHubArgs()
+	hub, err := NewCommonHub(args)
+	require.Nil(t, err)
+
+	numCalls := uint32(0)
+	hub.registerDispatcher(&mocks.DispatcherStub{
+		SourceStaleEventCalled: func(event data.SourceStaleEvent) {
+			assert.Equal(t, uint32(2), event.ShardID)
+			atomic.AddUint32(&numCalls, 1)
+		},
+	})
+
+	hub.Subscribe(data.SubscribeEvent{
+		SubscriptionEntries: []data.SubscriptionEntry{
+			{
+				EventType: common.SourceStaleEvents,
+			},
+		},
+	})
+
+	hub.Run()
+	defer hub.Close()
+
+	hub.BroadcastSourceStale(data.SourceStaleEvent{ShardID: 2})
+
+	time.Sleep(time.Millisecond * 100)
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
 func getEvents() data.BlockEvents {
 	return data.BlockEvents{
 		Hash: "374d75573060d840257045add9cd104b70180065f2406808ebabe02a1a3cb5f8",
@@ -396,3 +1118,135 @@ func getEvents() data.BlockEvents {
 		},
 	}
 }
+
+func TestCommonHub_AdminOperations(t *testing.T) {
+	t.Parallel()
+
+	args := createMockCommonHubArgs()
+	hub, err := NewCommonHub(args)
+	require.Nil(t, err)
+
+	disconnected := false
+	dispatcherID := uuid.New()
+	dispatcherStub := &mocks.DispatcherStub{
+		GetIDCalled: func() uuid.UUID {
+			return dispatcherID
+		},
+		GetInfoCalled: func() data.DispatcherInfo {
+			return data.DispatcherInfo{ID: dispatcherID, QueueDepth: 3}
+		},
+		DisconnectCalled: func() error {
+			disconnected = true
+			return nil
+		},
+	}
+	otherDispatcher := mocks.NewDispatcherMock(nil, hub)
+
+	hub.Run()
+	defer hub.Close()
+
+	hub.RegisterEvent(dispatcherStub)
+	hub.RegisterEvent(otherDispatcher)
+	hub.Subscribe(data.SubscribeEvent{
+		DispatcherID: dispatcherID,
+		SubscriptionEntries: []data.SubscriptionEntry{
+			{Identifier: "swap"},
+			{EventType: common.BlockTxs},
+		},
+	})
+
+	time.Sleep(time.Millisecond * 100)
+
+	dispatchers := hub.GetDispatchers()
+	require.Equal(t, 2, len(dispatchers))
+	for _, info := range dispatchers {
+		if info.ID != dispatcherID {
+			assert.Empty(t, info.Subscriptions)
+			continue
+		}
+
+		assert.Equal(t, 3, info.QueueDepth)
+		assert.Equal(t, 2, len(info.Subscriptions))
+	}
+
+	stats := hub.GetSubscriptionsStats()
+	assert.Equal(t, data.SubscriptionsStats{
+		NumDispatchers:   2,
+		NumSubscriptions: 2,
+		ByEventType: map[string]int{
+			common.PushLogsAndEvents: 1,
+			common.BlockTxs:          1,
+		},
+		ByMatchLevel: map[string]int{
+			dispatcher.MatchIdentifier: 1,
+			dispatcher.MatchAll:        1,
+		},
+	}, stats)
+
+	require.Nil(t, hub.DisconnectDispatcher(dispatcherID))
+	assert.True(t, disconnected)
+	assert.Equal(t, common.ErrDispatcherNotFound, hub.DisconnectDispatcher(uuid.New()))
+}
+
+func TestCommonHub_Drain(t *testing.T) {
+	t.Parallel()
+
+	createDispatcher := func(shutdownErr error, numShutdownCalls *uint32) *mocks.DispatcherStub {
+		id := uuid.New()
+		return &mocks.DispatcherStub{
+			GetIDCalled: func() uuid.UUID {
+				return id
+			},
+			ShutdownCalled: func(ctx context.Context) error {
+				atomic.AddUint32(numShutdownCalls, 1)
+				return shutdownErr
+			},
+		}
+	}
+
+	t.Run("hub not running should work", func(t *testing.T) {
+		t.Parallel()
+
+		hub, err := NewCommonHub(createMockCommonHubArgs())
+		require.Nil(t, err)
+
+		err = hub.Drain(context.Background())
+		require.Nil(t, err)
+	})
+
+	t.Run("should shut down the dispatchers", func(t *testing.T) {
+		t.Parallel()
+
+		hub, err := NewCommonHub(createMockCommonHubArgs())
+		require.Nil(t, err)
+
+		hub.Run()
+		defer hub.Close()
+
+		numShutdownCalls := uint32(0)
+		hub.RegisterEvent(createDispatcher(nil, &numShutdownCalls))
+		hub.RegisterEvent(createDispatcher(nil, &numShutdownCalls))
+
+		err = hub.Drain(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, uint32(2), atomic.LoadUint32(&numShutdownCalls))
+	})
+
+	t.Run("dispatcher not drained should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, err := NewCommonHub(createMockCommonHubArgs())
+		require.Nil(t, err)
+
+		hub.Run()
+		defer hub.Close()
+
+		numShutdownCalls := uint32(0)
+		hub.RegisterEvent(createDispatcher(nil, &numShutdownCalls))
+		hub.RegisterEvent(createDispatcher(common.ErrUndeliveredData, &numShutdownCalls))
+
+		err = hub.Drain(context.Background())
+		require.True(t, errors.Is(err, common.ErrUndeliveredData))
+		require.Equal(t, uint32(2), atomic.LoadUint32(&numShutdownCalls))
+	})
+}
@@ -7,3 +7,6 @@ var ErrNilEventFilter = errors.New("nil events filter")
 
 // ErrNilSubscriptionMapper signals that a nil subscription mapper has been provided
 var ErrNilSubscriptionMapper = errors.New("nil subscription mapper")
+
+// ErrHubNotResponding signals that the hub loop did not respond in time
+var ErrHubNotResponding = errors.New("hub loop not responding")
@@ -1,6 +1,7 @@
 package dispatcher
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"time"
@@ -18,6 +19,18 @@ type EventDispatcher interface {
 	TxsEvent(event data.BlockTxs)
 	BlockEvents(event data.BlockEventsWithOrder)
 	ScrsEvent(event data.BlockScrs)
+	RewardsEvent(event data.BlockRewards)
+	ReceiptsEvent(event data.BlockReceipts)
+	InvalidTxsEvent(event data.BlockInvalidTxs)
+	AlteredAccountsEvent(event data.BlockAlteredAccounts)
+	BlockHeaderEvent(event data.BlockHeader)
+	EpochStartEvent(event data.EpochStart)
+	TxBundlesEvent(event data.BlockTxBundles)
+	TxStatusEvent(event data.TxStatus)
+	SourceStaleEvent(event data.SourceStaleEvent)
+	GetInfo() data.DispatcherInfo
+	Disconnect() error
+	Shutdown(ctx context.Context) error
 }
 
 // Hub defines the behaviour of a hub component which should be able to register
@@ -29,10 +42,24 @@ type Hub interface {
 	BroadcastFinalized(event data.FinalizedBlock)
 	BroadcastTxs(event data.BlockTxs)
 	BroadcastScrs(event data.BlockScrs)
+	BroadcastRewards(event data.BlockRewards)
+	BroadcastReceipts(event data.BlockReceipts)
+	BroadcastInvalidTxs(event data.BlockInvalidTxs)
+	BroadcastAlteredAccounts(event data.BlockAlteredAccounts)
+	BroadcastBlockHeader(event data.BlockHeader)
+	BroadcastEpochStart(event data.EpochStart)
+	BroadcastTxBundles(event data.BlockTxBundles)
+	BroadcastTxStatus(event data.TxStatus)
 	BroadcastBlockEventsWithOrder(event data.BlockEventsWithOrder)
+	BroadcastSourceStale(event data.SourceStaleEvent)
+	CheckHealth(ctx context.Context) error
 	RegisterEvent(event EventDispatcher)
 	UnregisterEvent(event EventDispatcher)
 	Subscribe(event data.SubscribeEvent)
+	GetDispatchers() []data.DispatcherInfo
+	DisconnectDispatcher(dispatcherID uuid.UUID) error
+	GetSubscriptionsStats() data.SubscriptionsStats
+	Drain(ctx context.Context) error
 	Close() error
 	IsInterfaceNil() bool
 }
@@ -67,3 +94,24 @@ type SubscriptionMapperHandler interface {
 	Subscriptions() []data.Subscription
 	IsInterfaceNil() bool
 }
+
+// SubscribersAuthenticator defines the behaviour of a component which authenticates the websocket
+// subscribers and keeps track of their connections
+type SubscribersAuthenticator interface {
+	Authenticate(r *http.Request) (data.SubscriberQuota, error)
+	ReleaseConnection(quota data.SubscriberQuota)
+	IsInterfaceNil() bool
+}
+
+// BlockTimestampsHandler defines the behaviour of a component which keeps the timestamps of the recent blocks
+type BlockTimestampsHandler interface {
+	Add(hash string, timestamp uint64)
+	Get(hash string) (uint64, bool)
+	IsInterfaceNil() bool
+}
+
+// NativeAuthVerifier defines the behaviour of a component which verifies MultiversX native-auth tokens
+type NativeAuthVerifier interface {
+	Verify(token string) (string, error)
+	IsInterfaceNil() bool
+}
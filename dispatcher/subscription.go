@@ -1,6 +1,7 @@
 package dispatcher
 
 import (
+	"fmt"
 	"strings"
 	"sync"
 
@@ -27,6 +28,9 @@ const (
 
 	// MatchTopics signals that events will be filtered by (address,identifier,[topics_pattern])
 	MatchTopics = "match:topics"
+
+	// MatchTxHash signals that the tx status events will be filtered by (txHash)
+	MatchTxHash = "match:txHash"
 )
 
 const (
@@ -49,7 +53,22 @@ func NewSubscriptionMapper() *SubscriptionMapper {
 
 // MatchSubscribeEvent creates a subscription entry in the subscriptions map
 // It assigns each SubscribeEvent a match level from the input provided
+// If the event is restricted to the authenticated address, the subscriptions are bound to that
+// address and the event is dropped if it asks for the events of other addresses
 func (sm *SubscriptionMapper) MatchSubscribeEvent(event data.SubscribeEvent) {
+	if event.OwnAddressOnly {
+		subscriptionEntries, err := RestrictToOwnAddress(event)
+		if err != nil {
+			log.Warn("rejected subscribe event",
+				"dispatcherID", event.DispatcherID,
+				"address", event.AuthenticatedAddress,
+				"err", err.Error(),
+			)
+			return
+		}
+		event.SubscriptionEntries = subscriptionEntries
+	}
+
 	if event.SubscriptionEntries == nil || len(event.SubscriptionEntries) == 0 {
 		sm.appendSubscription(data.Subscription{
 			DispatcherID: event.DispatcherID,
@@ -65,11 +84,12 @@ func (sm *SubscriptionMapper) MatchSubscribeEvent(event data.SubscribeEvent) {
 
 	for _, subEntry := range event.SubscriptionEntries {
 		matchLevel := sm.matchLevelFromInput(subEntry)
-		eventType := getEventType(subEntry)
+		eventType := GetEventType(subEntry)
 		subscription := data.Subscription{
 			Address:      subEntry.Address,
 			Identifier:   subEntry.Identifier,
 			Topics:       subEntry.Topics,
+			TxHash:       subEntry.TxHash,
 			DispatcherID: event.DispatcherID,
 			MatchLevel:   matchLevel,
 			EventType:    eventType,
@@ -115,6 +135,9 @@ func (sm *SubscriptionMapper) matchLevelFromInput(subEntry data.SubscriptionEntr
 	hasIdentifier := subEntry.Identifier != ""
 	hasTopics := len(subEntry.Topics) > 0
 
+	if subEntry.TxHash != "" {
+		return MatchTxHash
+	}
 	if hasAddress && hasIdentifier && hasTopics {
 		return MatchTopics
 	}
@@ -138,18 +161,65 @@ func (sm *SubscriptionMapper) appendSubscription(sub data.Subscription) {
 	sm.subscriptions[sub.DispatcherID] = append(sm.subscriptions[sub.DispatcherID], sub)
 }
 
-func getEventType(subEntry data.SubscriptionEntry) string {
+// GetEventType returns the event type of the provided subscription entry. Unknown event types
+// fall back to all_events
+func GetEventType(subEntry data.SubscriptionEntry) string {
 	if subEntry.EventType == common.FinalizedBlockEvents ||
 		subEntry.EventType == common.RevertBlockEvents ||
 		subEntry.EventType == common.BlockTxs ||
 		subEntry.EventType == common.BlockScrs ||
-		subEntry.EventType == common.BlockEvents {
+		subEntry.EventType == common.BlockRewards ||
+		subEntry.EventType == common.BlockReceipts ||
+		subEntry.EventType == common.BlockInvalidTxs ||
+		subEntry.EventType == common.AlteredAccounts ||
+		subEntry.EventType == common.BlockHeader ||
+		subEntry.EventType == common.EpochStart ||
+		subEntry.EventType == common.TxBundles ||
+		subEntry.EventType == common.TxStatus ||
+		subEntry.EventType == common.BlockEvents ||
+		subEntry.EventType == common.SourceStaleEvents {
 		return subEntry.EventType
 	}
 
 	return common.PushLogsAndEvents
 }
 
+// RestrictToOwnAddress returns the subscription entries of the event bound to the authenticated address
+// Entries without address get the authenticated address, while entries for other addresses or for the
+// event types carrying the data of any address (block txs, scrs, rewards, receipts, invalid txs and events)
+// are rejected
+func RestrictToOwnAddress(event data.SubscribeEvent) ([]data.SubscriptionEntry, error) {
+	if len(event.SubscriptionEntries) == 0 {
+		return []data.SubscriptionEntry{{Address: event.AuthenticatedAddress}}, nil
+	}
+
+	subscriptionEntries := make([]data.SubscriptionEntry, 0, len(event.SubscriptionEntries))
+	for _, subEntry := range event.SubscriptionEntries {
+		if subEntry.Address == "" {
+			subEntry.Address = event.AuthenticatedAddress
+		}
+		if subEntry.Address != event.AuthenticatedAddress {
+			return nil, fmt.Errorf("%w: %s", ErrAddressNotOwned, subEntry.Address)
+		}
+		if !isFilteredByAddress(GetEventType(subEntry)) {
+			return nil, fmt.Errorf("%w: %s", ErrEventTypeNotFilteredByAddress, subEntry.EventType)
+		}
+
+		subscriptionEntries = append(subscriptionEntries, subEntry)
+	}
+
+	return subscriptionEntries, nil
+}
+
+func isFilteredByAddress(eventType string) bool {
+	switch eventType {
+	case common.BlockTxs, common.BlockScrs, common.BlockRewards, common.BlockReceipts, common.BlockInvalidTxs, common.BlockEvents:
+		return false
+	default:
+		return true
+	}
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sm *SubscriptionMapper) IsInterfaceNil() bool {
 	return sm == nil
@@ -205,3 +205,122 @@ func randStr(length int) string {
 	}
 	return string(b)
 }
+
+func TestSubscriptionMapper_MatchSubscribeEventOwnAddressOnly(t *testing.T) {
+	t.Parallel()
+
+	ownAddress := "erd1own"
+
+	t.Run("empty entries should subscribe to own address", func(t *testing.T) {
+		t.Parallel()
+
+		subMap := NewSubscriptionMapper()
+		subMap.MatchSubscribeEvent(data.SubscribeEvent{
+			DispatcherID:         uuid.New(),
+			AuthenticatedAddress: ownAddress,
+			OwnAddressOnly:       true,
+		})
+
+		subscriptions := subMap.Subscriptions()
+		require.Equal(t, 1, len(subscriptions))
+		require.Equal(t, ownAddress, subscriptions[0].Address)
+		require.Equal(t, MatchAddress, subscriptions[0].MatchLevel)
+		require.Equal(t, common.PushLogsAndEvents, subscriptions[0].EventType)
+	})
+
+	t.Run("entries without address should be bound to own address", func(t *testing.T) {
+		t.Parallel()
+
+		subMap := NewSubscriptionMapper()
+		subMap.MatchSubscribeEvent(data.SubscribeEvent{
+			DispatcherID: uuid.New(),
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{Identifier: "swap"},
+				{Address: ownAddress, EventType: common.RevertBlockEvents},
+			},
+			AuthenticatedAddress: ownAddress,
+			OwnAddressOnly:       true,
+		})
+
+		subscriptions := subMap.Subscriptions()
+		require.Equal(t, 2, len(subscriptions))
+		require.Equal(t, ownAddress, subscriptions[0].Address)
+		require.Equal(t, MatchAddressIdentifier, subscriptions[0].MatchLevel)
+		require.Equal(t, ownAddress, subscriptions[1].Address)
+	})
+
+	t.Run("other address should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		subMap := NewSubscriptionMapper()
+		subMap.MatchSubscribeEvent(data.SubscribeEvent{
+			DispatcherID: uuid.New(),
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{Identifier: "swap"},
+				{Address: "erd1other"},
+			},
+			AuthenticatedAddress: ownAddress,
+			OwnAddressOnly:       true,
+		})
+
+		require.Equal(t, 0, len(subMap.Subscriptions()))
+	})
+
+	t.Run("block data event types should be rejected", func(t *testing.T) {
+		t.Parallel()
+
+		subMap := NewSubscriptionMapper()
+		subMap.MatchSubscribeEvent(data.SubscribeEvent{
+			DispatcherID: uuid.New(),
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{EventType: common.BlockTxs},
+			},
+			AuthenticatedAddress: ownAddress,
+			OwnAddressOnly:       true,
+		})
+
+		require.Equal(t, 0, len(subMap.Subscriptions()))
+	})
+
+	t.Run("not restricted event should keep other addresses", func(t *testing.T) {
+		t.Parallel()
+
+		subMap := NewSubscriptionMapper()
+		subMap.MatchSubscribeEvent(data.SubscribeEvent{
+			DispatcherID: uuid.New(),
+			SubscriptionEntries: []data.SubscriptionEntry{
+				{Address: "erd1other"},
+			},
+			AuthenticatedAddress: ownAddress,
+		})
+
+		subscriptions := subMap.Subscriptions()
+		require.Equal(t, 1, len(subscriptions))
+		require.Equal(t, "erd1other", subscriptions[0].Address)
+	})
+}
+
+func TestSubscriptionMapper_MatchSubscribeEventWithTxHash(t *testing.T) {
+	t.Parallel()
+
+	dispatcherId := uuid.New()
+	subEvent := data.SubscribeEvent{
+		SubscriptionEntries: []data.SubscriptionEntry{
+			{
+				EventType: common.TxStatus,
+				TxHash:    "txHash1",
+			},
+		},
+		DispatcherID: dispatcherId,
+	}
+
+	subMap := NewSubscriptionMapper()
+	subMap.MatchSubscribeEvent(subEvent)
+
+	subs := subMap.Subscriptions()
+
+	require.Equal(t, 1, len(subs))
+	require.Equal(t, common.TxStatus, subs[0].EventType)
+	require.Equal(t, "txHash1", subs[0].TxHash)
+	require.Equal(t, MatchTxHash, subs[0].MatchLevel)
+}
@@ -10,3 +10,36 @@ var ErrNilWSUpgrader = errors.New("nil websocket upgrader")
 
 // ErrNilWSConn signals that a nil websocket connection has been provided
 var ErrNilWSConn = errors.New("nil ws connection")
+
+// ErrNilSubscribersAuthenticator signals that a nil subscribers authenticator has been provided
+var ErrNilSubscribersAuthenticator = errors.New("nil subscribers authenticator")
+
+// ErrInvalidSubscribersAuthConfig signals that an invalid subscribers authentication configuration has been provided
+var ErrInvalidSubscribersAuthConfig = errors.New("invalid subscribers auth config")
+
+// ErrSubscriberUnauthorized signals that the websocket subscriber could not be authenticated
+var ErrSubscriberUnauthorized = errors.New("subscriber is not authorized")
+
+// ErrConnectionsLimitReached signals that the subscriber already uses all its allowed connections
+var ErrConnectionsLimitReached = errors.New("connections limit reached")
+
+// ErrSubscriptionsLimitReached signals that the connection already uses all its allowed subscriptions
+var ErrSubscriptionsLimitReached = errors.New("subscriptions limit reached")
+
+// ErrEventTypeNotAllowed signals that the subscriber is not allowed to subscribe to the event type
+var ErrEventTypeNotAllowed = errors.New("event type not allowed")
+
+// ErrInvalidNativeAuthToken signals that the native-auth token could not be verified
+var ErrInvalidNativeAuthToken = errors.New("invalid native-auth token")
+
+// ErrNilNativeAuthVerifier signals that a nil native-auth verifier has been provided
+var ErrNilNativeAuthVerifier = errors.New("nil native-auth verifier")
+
+// ErrInvalidNativeAuthConfig signals that an invalid native-auth configuration has been provided
+var ErrInvalidNativeAuthConfig = errors.New("invalid native-auth config")
+
+// ErrNilPubKeyConverter signals that a nil pubkey converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil pubkey converter")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
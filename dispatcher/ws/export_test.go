@@ -1,5 +1,7 @@
 package ws
 
+import "time"
+
 // ArgsWSDispatcher -
 type ArgsWSDispatcher struct {
 	argsWebSocketDispatcher
@@ -8,8 +10,12 @@ type ArgsWSDispatcher struct {
 // NewTestWSDispatcher -
 func NewTestWSDispatcher(args ArgsWSDispatcher) (*websocketDispatcher, error) {
 	wsArgs := argsWebSocketDispatcher{
-		Hub:  args.Hub,
-		Conn: args.Conn,
+		Hub:                  args.Hub,
+		Conn:                 args.Conn,
+		StatusMetricsHandler: args.StatusMetricsHandler,
+		Authenticator:        args.Authenticator,
+		RemoteAddress:        args.RemoteAddress,
+		Quota:                args.Quota,
 	}
 
 	return newWebSocketDispatcher(wsArgs)
@@ -30,3 +36,13 @@ func (wd *websocketDispatcher) ReadSendChannel() []byte {
 	d := <-wd.send
 	return d
 }
+
+// TrySendSubscribeEvent -
+func (wd *websocketDispatcher) TrySendSubscribeEvent(eventBytes []byte) {
+	wd.trySendSubscribeEvent(eventBytes)
+}
+
+// IsMessageAllowed -
+func (wd *websocketDispatcher) IsMessageAllowed(now time.Time) bool {
+	return wd.isMessageAllowed(now)
+}
@@ -0,0 +1,188 @@
+package ws
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
+)
+
+const (
+	defaultNativeAuthMaxExpiryInSec = 86400
+
+	// signedMessagePrefix is prepended to the messages signed by MultiversX wallets
+	signedMessagePrefix = "\x17Elrond Signed Message:\n"
+)
+
+// ArgsNativeAuthVerifier defines the arguments needed to create a nativeAuthVerifier
+type ArgsNativeAuthVerifier struct {
+	Config          config.NativeAuthConfig
+	BlockTimestamps dispatcher.BlockTimestampsHandler
+	PubKeyConverter core.PubkeyConverter
+	Hasher          hashing.Hasher
+}
+
+type nativeAuthVerifier struct {
+	acceptedOrigins map[string]struct{}
+	maxExpiryInSec  uint64
+	blockTimestamps dispatcher.BlockTimestampsHandler
+	pubKeyConverter core.PubkeyConverter
+	hasher          hashing.Hasher
+}
+
+// NewNativeAuthVerifier creates a component which verifies MultiversX native-auth tokens locally: the
+// ed25519 signature of the address, the accepted origins and the TTL counted from the block timestamp
+func NewNativeAuthVerifier(args ArgsNativeAuthVerifier) (*nativeAuthVerifier, error) {
+	err := checkNativeAuthVerifierArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	acceptedOrigins := make(map[string]struct{}, len(args.Config.AcceptedOrigins))
+	for _, origin := range args.Config.AcceptedOrigins {
+		acceptedOrigins[origin] = struct{}{}
+	}
+
+	maxExpiryInSec := args.Config.MaxExpiryInSec
+	if maxExpiryInSec == 0 {
+		maxExpiryInSec = defaultNativeAuthMaxExpiryInSec
+	}
+
+	return &nativeAuthVerifier{
+		acceptedOrigins: acceptedOrigins,
+		maxExpiryInSec:  maxExpiryInSec,
+		blockTimestamps: args.BlockTimestamps,
+		pubKeyConverter: args.PubKeyConverter,
+		hasher:          args.Hasher,
+	}, nil
+}
+
+func checkNativeAuthVerifierArgs(args ArgsNativeAuthVerifier) error {
+	if len(args.Config.AcceptedOrigins) == 0 {
+		return fmt.Errorf("%w: no accepted origins provided", ErrInvalidNativeAuthConfig)
+	}
+	if check.IfNil(args.BlockTimestamps) {
+		return dispatcher.ErrNilBlockTimestampsHandler
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if check.IfNil(args.Hasher) {
+		return ErrNilHasher
+	}
+
+	return nil
+}
+
+// Verify checks the provided native-auth token and returns the authenticated address. The token has
+// the format base64(address).base64(body).hex(signature), where the body is
+// base64(origin).blockHash.ttl.base64(extraInfo)
+func (nav *nativeAuthVerifier) Verify(token string) (string, error) {
+	tokenParts := strings.Split(token, ".")
+	if len(tokenParts) != 3 {
+		return "", fmt.Errorf("%w: invalid format", ErrInvalidNativeAuthToken)
+	}
+
+	address, err := decodeNativeAuthValue(tokenParts[0])
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid address encoding", ErrInvalidNativeAuthToken)
+	}
+	body, err := decodeNativeAuthValue(tokenParts[1])
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid body encoding", ErrInvalidNativeAuthToken)
+	}
+
+	err = nav.checkBody(body)
+	if err != nil {
+		return "", err
+	}
+
+	err = nav.checkSignature(address, body, tokenParts[2])
+	if err != nil {
+		return "", err
+	}
+
+	return address, nil
+}
+
+func (nav *nativeAuthVerifier) checkBody(body string) error {
+	bodyParts := strings.Split(body, ".")
+	if len(bodyParts) != 4 {
+		return fmt.Errorf("%w: invalid body format", ErrInvalidNativeAuthToken)
+	}
+
+	origin, err := decodeNativeAuthValue(bodyParts[0])
+	if err != nil {
+		return fmt.Errorf("%w: invalid origin encoding", ErrInvalidNativeAuthToken)
+	}
+	_, isAccepted := nav.acceptedOrigins[origin]
+	if !isAccepted {
+		return fmt.Errorf("%w: origin %s not accepted", ErrInvalidNativeAuthToken, origin)
+	}
+
+	ttl, err := strconv.ParseUint(bodyParts[2], 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: invalid ttl", ErrInvalidNativeAuthToken)
+	}
+	if ttl > nav.maxExpiryInSec {
+		return fmt.Errorf("%w: ttl %d exceeds %d seconds", ErrInvalidNativeAuthToken, ttl, nav.maxExpiryInSec)
+	}
+
+	blockHash := bodyParts[1]
+	blockTimestamp, found := nav.blockTimestamps.Get(blockHash)
+	if !found {
+		return fmt.Errorf("%w: unknown block hash %s", ErrInvalidNativeAuthToken, blockHash)
+	}
+	if uint64(time.Now().Unix()) > blockTimestamp+ttl {
+		return fmt.Errorf("%w: token expired", ErrInvalidNativeAuthToken)
+	}
+
+	return nil
+}
+
+func (nav *nativeAuthVerifier) checkSignature(address string, body string, hexSignature string) error {
+	pubKey, err := nav.pubKeyConverter.Decode(address)
+	if err != nil || len(pubKey) != ed25519.PublicKeySize {
+		return fmt.Errorf("%w: invalid address %s", ErrInvalidNativeAuthToken, address)
+	}
+	signature, err := hex.DecodeString(hexSignature)
+	if err != nil {
+		return fmt.Errorf("%w: invalid signature encoding", ErrInvalidNativeAuthToken)
+	}
+
+	message := nav.computeSignableMessage(address + body)
+	if !ed25519.Verify(pubKey, message, signature) {
+		return fmt.Errorf("%w: invalid signature", ErrInvalidNativeAuthToken)
+	}
+
+	return nil
+}
+
+// computeSignableMessage returns the hash signed by the MultiversX wallets for the provided message
+func (nav *nativeAuthVerifier) computeSignableMessage(message string) []byte {
+	return nav.hasher.Compute(signedMessagePrefix + strconv.Itoa(len(message)) + message)
+}
+
+// decodeNativeAuthValue decodes the url safe base64 values of the token, with or without padding
+func decodeNativeAuthValue(value string) (string, error) {
+	decoded, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(value, "="))
+	if err != nil {
+		return "", err
+	}
+
+	return string(decoded), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (nav *nativeAuthVerifier) IsInterfaceNil() bool {
+	return nav == nil
+}
@@ -0,0 +1,212 @@
+package ws_test
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
+	"github.com/multiversx/mx-chain-notifier-go/dispatcher/ws"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	nativeAuthOrigin    = "https://dapp.multiversx.com"
+	nativeAuthBlockHash = "b3d07565293fd5684c97d2b96eb862d124fd698678f3f95b2515ed07178a27b4"
+)
+
+func createMockNativeAuthVerifierArgs(blockTimestamp uint64) ws.ArgsNativeAuthVerifier {
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+
+	return ws.ArgsNativeAuthVerifier{
+		Config: config.NativeAuthConfig{
+			Enabled:         true,
+			AcceptedOrigins: []string{nativeAuthOrigin},
+			MaxExpiryInSec:  3600,
+		},
+		BlockTimestamps: &mocks.BlockTimestampsHandlerStub{
+			GetCalled: func(hash string) (uint64, bool) {
+				return blockTimestamp, hash == nativeAuthBlockHash
+			},
+		},
+		PubKeyConverter: converter,
+		Hasher:          keccak.NewKeccak(),
+	}
+}
+
+func encodeNativeAuthValue(value string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+// createNativeAuthToken builds a token the same way as the native-auth client does
+func createNativeAuthToken(privateKey ed25519.PrivateKey, address string, origin string, ttl uint64) string {
+	body := fmt.Sprintf("%s.%s.%d.%s", encodeNativeAuthValue(origin), nativeAuthBlockHash, ttl, encodeNativeAuthValue("{}"))
+	message := address + body
+	signableMessage := keccak.NewKeccak().Compute("\x17Elrond Signed Message:\n" + strconv.Itoa(len(message)) + message)
+	signature := ed25519.Sign(privateKey, signableMessage)
+
+	return encodeNativeAuthValue(address) + "." + encodeNativeAuthValue(body) + "." + hex.EncodeToString(signature)
+}
+
+func createNativeAuthAccount(t *testing.T) (ed25519.PrivateKey, string) {
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.Nil(t, err)
+
+	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, "erd")
+	address, err := converter.Encode(publicKey)
+	require.Nil(t, err)
+
+	return privateKey, address
+}
+
+func TestNewNativeAuthVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no accepted origins should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNativeAuthVerifierArgs(0)
+		args.Config.AcceptedOrigins = nil
+
+		nav, err := ws.NewNativeAuthVerifier(args)
+		require.True(t, check.IfNil(nav))
+		require.True(t, errors.Is(err, ws.ErrInvalidNativeAuthConfig))
+	})
+
+	t.Run("nil block timestamps handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNativeAuthVerifierArgs(0)
+		args.BlockTimestamps = nil
+
+		nav, err := ws.NewNativeAuthVerifier(args)
+		require.True(t, check.IfNil(nav))
+		require.Equal(t, dispatcher.ErrNilBlockTimestampsHandler, err)
+	})
+
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNativeAuthVerifierArgs(0)
+		args.PubKeyConverter = nil
+
+		nav, err := ws.NewNativeAuthVerifier(args)
+		require.True(t, check.IfNil(nav))
+		require.Equal(t, ws.ErrNilPubKeyConverter, err)
+	})
+
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNativeAuthVerifierArgs(0)
+		args.Hasher = nil
+
+		nav, err := ws.NewNativeAuthVerifier(args)
+		require.True(t, check.IfNil(nav))
+		require.Equal(t, ws.ErrNilHasher, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		nav, err := ws.NewNativeAuthVerifier(createMockNativeAuthVerifierArgs(0))
+		require.Nil(t, err)
+		require.False(t, check.IfNil(nav))
+	})
+}
+
+func TestNativeAuthVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	privateKey, address := createNativeAuthAccount(t)
+	recentBlockTimestamp := uint64(time.Now().Unix()) - 60
+
+	t.Run("valid token should work", func(t *testing.T) {
+		t.Parallel()
+
+		nav, _ := ws.NewNativeAuthVerifier(createMockNativeAuthVerifierArgs(recentBlockTimestamp))
+
+		authenticatedAddress, err := nav.Verify(createNativeAuthToken(privateKey, address, nativeAuthOrigin, 600))
+		require.Nil(t, err)
+		require.Equal(t, address, authenticatedAddress)
+	})
+
+	t.Run("invalid format should error", func(t *testing.T) {
+		t.Parallel()
+
+		nav, _ := ws.NewNativeAuthVerifier(createMockNativeAuthVerifierArgs(recentBlockTimestamp))
+
+		_, err := nav.Verify("token")
+		require.True(t, errors.Is(err, ws.ErrInvalidNativeAuthToken))
+
+		_, err = nav.Verify(encodeNativeAuthValue(address) + "." + encodeNativeAuthValue("body") + ".abcd")
+		require.True(t, errors.Is(err, ws.ErrInvalidNativeAuthToken))
+	})
+
+	t.Run("not accepted origin should error", func(t *testing.T) {
+		t.Parallel()
+
+		nav, _ := ws.NewNativeAuthVerifier(createMockNativeAuthVerifierArgs(recentBlockTimestamp))
+
+		_, err := nav.Verify(createNativeAuthToken(privateKey, address, "https://other.com", 600))
+		require.True(t, errors.Is(err, ws.ErrInvalidNativeAuthToken))
+	})
+
+	t.Run("ttl above max expiry should error", func(t *testing.T) {
+		t.Parallel()
+
+		nav, _ := ws.NewNativeAuthVerifier(createMockNativeAuthVerifierArgs(recentBlockTimestamp))
+
+		_, err := nav.Verify(createNativeAuthToken(privateKey, address, nativeAuthOrigin, 3601))
+		require.True(t, errors.Is(err, ws.ErrInvalidNativeAuthToken))
+	})
+
+	t.Run("unknown block hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNativeAuthVerifierArgs(recentBlockTimestamp)
+		args.BlockTimestamps = &mocks.BlockTimestampsHandlerStub{}
+		nav, _ := ws.NewNativeAuthVerifier(args)
+
+		_, err := nav.Verify(createNativeAuthToken(privateKey, address, nativeAuthOrigin, 600))
+		require.True(t, errors.Is(err, ws.ErrInvalidNativeAuthToken))
+	})
+
+	t.Run("expired token should error", func(t *testing.T) {
+		t.Parallel()
+
+		nav, _ := ws.NewNativeAuthVerifier(createMockNativeAuthVerifierArgs(recentBlockTimestamp))
+
+		_, err := nav.Verify(createNativeAuthToken(privateKey, address, nativeAuthOrigin, 30))
+		require.True(t, errors.Is(err, ws.ErrInvalidNativeAuthToken))
+	})
+
+	t.Run("signature of another account should error", func(t *testing.T) {
+		t.Parallel()
+
+		otherPrivateKey, _ := createNativeAuthAccount(t)
+		nav, _ := ws.NewNativeAuthVerifier(createMockNativeAuthVerifierArgs(recentBlockTimestamp))
+
+		_, err := nav.Verify(createNativeAuthToken(otherPrivateKey, address, nativeAuthOrigin, 600))
+		require.True(t, errors.Is(err, ws.ErrInvalidNativeAuthToken))
+	})
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		nav, _ := ws.NewNativeAuthVerifier(createMockNativeAuthVerifierArgs(recentBlockTimestamp))
+
+		_, err := nav.Verify(createNativeAuthToken(privateKey, "erd1invalid", nativeAuthOrigin, 600))
+		require.True(t, errors.Is(err, ws.ErrInvalidNativeAuthToken))
+	})
+}
@@ -0,0 +1,314 @@
+package ws
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
+)
+
+const (
+	apiKeyHeader     = "X-Api-Key"
+	apiKeyQueryParam = "apiKey"
+	tokenQueryParam  = "token"
+	bearerPrefix     = "Bearer "
+
+	// jwtPrefix is the base64 encoding of the opening of the JSON header of a JWT, used to tell
+	// the JWTs apart from the native-auth tokens
+	jwtPrefix = "eyJ"
+
+	unknownSubscriber = "unknown"
+
+	unauthorizedReason        = "unauthorized"
+	connectionsLimitReason    = "connections_limit"
+	subscriptionsLimitReason  = "subscriptions_limit"
+	eventTypeNotAllowedReason = "event_type_not_allowed"
+	addressNotAllowedReason   = "address_not_allowed"
+)
+
+var knownEventTypes = map[string]struct{}{
+	common.PushLogsAndEvents:    {},
+	common.BlockEvents:          {},
+	common.RevertBlockEvents:    {},
+	common.FinalizedBlockEvents: {},
+	common.BlockTxs:             {},
+	common.BlockScrs:            {},
+	common.BlockRewards:         {},
+	common.BlockReceipts:        {},
+	common.BlockInvalidTxs:      {},
+	common.AlteredAccounts:      {},
+	common.BlockHeader:          {},
+	common.EpochStart:           {},
+	common.TxBundles:            {},
+	common.TxStatus:             {},
+	common.SourceStaleEvents:    {},
+}
+
+// subscriberClaims defines the claims of a JWT issued to a websocket subscriber. The subject is used
+// as subscriber name and the limits are carried by the token
+type subscriberClaims struct {
+	jwt.RegisteredClaims
+	MaxConnections                uint32   `json:"maxConnections"`
+	MaxSubscriptionsPerConnection uint32   `json:"maxSubscriptionsPerConnection"`
+	AllowedEventTypes             []string `json:"allowedEventTypes"`
+	MaxMessagesPerSecond          uint32   `json:"maxMessagesPerSecond"`
+}
+
+// ArgsSubscribersAuthenticator defines the arguments needed to create a subscribersAuthenticator
+type ArgsSubscribersAuthenticator struct {
+	Config               config.SubscribersAuthConfig
+	StatusMetricsHandler common.StatusMetricsHandler
+	NativeAuthVerifier   dispatcher.NativeAuthVerifier
+}
+
+type subscribersAuthenticator struct {
+	jwtSecret          []byte
+	apiKeys            []config.SubscriberKeyConfig
+	nativeAuthConfig   config.NativeAuthConfig
+	nativeAuthVerifier dispatcher.NativeAuthVerifier
+	metricsHandler     common.StatusMetricsHandler
+
+	mutConnections sync.Mutex
+	// connections are counted per subscriber name, or per address for native-auth subscribers,
+	// while subscriberConnections holds the totals per subscriber name exported as metrics
+	connections           map[string]int
+	subscriberConnections map[string]int
+}
+
+// NewSubscribersAuthenticator creates a component which authenticates the websocket subscribers by
+// API key, by JWT or by native-auth token and enforces their connections limit
+func NewSubscribersAuthenticator(args ArgsSubscribersAuthenticator) (*subscribersAuthenticator, error) {
+	err := checkAuthenticatorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscribersAuthenticator{
+		jwtSecret:             []byte(args.Config.JWTSecret),
+		apiKeys:               args.Config.APIKeys,
+		nativeAuthConfig:      args.Config.NativeAuth,
+		nativeAuthVerifier:    args.NativeAuthVerifier,
+		metricsHandler:        args.StatusMetricsHandler,
+		connections:           make(map[string]int),
+		subscriberConnections: make(map[string]int),
+	}, nil
+}
+
+func checkAuthenticatorArgs(args ArgsSubscribersAuthenticator) error {
+	if check.IfNil(args.StatusMetricsHandler) {
+		return common.ErrNilStatusMetricsHandler
+	}
+	if check.IfNil(args.NativeAuthVerifier) {
+		return ErrNilNativeAuthVerifier
+	}
+	if args.Config.JWTSecret == "" && len(args.Config.APIKeys) == 0 && !args.Config.NativeAuth.Enabled {
+		return fmt.Errorf("%w: neither JWTSecret, APIKeys nor NativeAuth provided", ErrInvalidSubscribersAuthConfig)
+	}
+	if args.Config.NativeAuth.Enabled {
+		err := checkEventTypes(args.Config.NativeAuth.AllowedEventTypes)
+		if err != nil {
+			return fmt.Errorf("%w for native-auth", err)
+		}
+	}
+
+	names := make(map[string]struct{})
+	keys := make(map[string]struct{})
+	for _, apiKey := range args.Config.APIKeys {
+		if apiKey.Name == "" || apiKey.Key == "" {
+			return fmt.Errorf("%w: empty name or key", ErrInvalidSubscribersAuthConfig)
+		}
+		_, exists := names[apiKey.Name]
+		if exists {
+			return fmt.Errorf("%w: duplicated name %s", ErrInvalidSubscribersAuthConfig, apiKey.Name)
+		}
+		_, exists = keys[apiKey.Key]
+		if exists {
+			return fmt.Errorf("%w: duplicated key for %s", ErrInvalidSubscribersAuthConfig, apiKey.Name)
+		}
+		err := checkEventTypes(apiKey.AllowedEventTypes)
+		if err != nil {
+			return fmt.Errorf("%w for %s", err, apiKey.Name)
+		}
+
+		names[apiKey.Name] = struct{}{}
+		keys[apiKey.Key] = struct{}{}
+	}
+
+	return nil
+}
+
+func checkEventTypes(eventTypes []string) error {
+	for _, eventType := range eventTypes {
+		_, isKnown := knownEventTypes[eventType]
+		if !isKnown {
+			return fmt.Errorf("%w: unknown event type %s", ErrInvalidSubscribersAuthConfig, eventType)
+		}
+	}
+
+	return nil
+}
+
+// Authenticate checks the credentials of the upgrade request and reserves one of the connections
+// allowed for the subscriber. The API key is read from the X-Api-Key header or from the apiKey query
+// parameter, while the JWT or the native-auth token is read from the Authorization bearer header or
+// from the token query parameter
+func (sa *subscribersAuthenticator) Authenticate(r *http.Request) (data.SubscriberQuota, error) {
+	quota, err := sa.getQuota(r)
+	if err != nil {
+		sa.metricsHandler.AddSubscriberRejection(unknownSubscriber, unauthorizedReason)
+		return data.SubscriberQuota{}, fmt.Errorf("%w: %s", ErrSubscriberUnauthorized, err.Error())
+	}
+
+	sa.mutConnections.Lock()
+	defer sa.mutConnections.Unlock()
+
+	key := getConnectionsKey(quota)
+	numConnections := sa.connections[key]
+	if quota.MaxConnections > 0 && numConnections >= int(quota.MaxConnections) {
+		sa.metricsHandler.AddSubscriberRejection(quota.Name, connectionsLimitReason)
+		return data.SubscriberQuota{}, fmt.Errorf("%w: %d connections allowed", ErrConnectionsLimitReached, quota.MaxConnections)
+	}
+
+	sa.connections[key] = numConnections + 1
+	sa.subscriberConnections[quota.Name]++
+	sa.metricsHandler.SetSubscriberConnections(quota.Name, sa.subscriberConnections[quota.Name])
+
+	return quota, nil
+}
+
+func getConnectionsKey(quota data.SubscriberQuota) string {
+	if quota.Address != "" {
+		return quota.Address
+	}
+
+	return quota.Name
+}
+
+func (sa *subscribersAuthenticator) getQuota(r *http.Request) (data.SubscriberQuota, error) {
+	apiKey := r.Header.Get(apiKeyHeader)
+	if apiKey == "" {
+		apiKey = r.URL.Query().Get(apiKeyQueryParam)
+	}
+	if apiKey != "" {
+		return sa.getQuotaByAPIKey(apiKey)
+	}
+
+	token := r.URL.Query().Get(tokenQueryParam)
+	authorization := r.Header.Get("Authorization")
+	if strings.HasPrefix(authorization, bearerPrefix) {
+		token = strings.TrimPrefix(authorization, bearerPrefix)
+	}
+	if token == "" {
+		return data.SubscriberQuota{}, fmt.Errorf("missing credentials")
+	}
+	if sa.nativeAuthConfig.Enabled && !strings.HasPrefix(token, jwtPrefix) {
+		return sa.getQuotaByNativeAuth(token)
+	}
+
+	return sa.getQuotaByToken(token)
+}
+
+func (sa *subscribersAuthenticator) getQuotaByNativeAuth(token string) (data.SubscriberQuota, error) {
+	address, err := sa.nativeAuthVerifier.Verify(token)
+	if err != nil {
+		return data.SubscriberQuota{}, err
+	}
+
+	return data.SubscriberQuota{
+		Name:                          common.NativeAuthSubscriber,
+		Address:                       address,
+		OwnAddressOnly:                sa.nativeAuthConfig.OwnAddressOnly,
+		MaxConnections:                sa.nativeAuthConfig.MaxConnections,
+		MaxSubscriptionsPerConnection: sa.nativeAuthConfig.MaxSubscriptionsPerConnection,
+		AllowedEventTypes:             sa.nativeAuthConfig.AllowedEventTypes,
+		MaxMessagesPerSecond:          sa.nativeAuthConfig.MaxMessagesPerSecond,
+	}, nil
+}
+
+func (sa *subscribersAuthenticator) getQuotaByAPIKey(apiKey string) (data.SubscriberQuota, error) {
+	for _, keyConfig := range sa.apiKeys {
+		if subtle.ConstantTimeCompare([]byte(apiKey), []byte(keyConfig.Key)) != 1 {
+			continue
+		}
+
+		return data.SubscriberQuota{
+			Name:                          keyConfig.Name,
+			MaxConnections:                keyConfig.MaxConnections,
+			MaxSubscriptionsPerConnection: keyConfig.MaxSubscriptionsPerConnection,
+			AllowedEventTypes:             keyConfig.AllowedEventTypes,
+			MaxMessagesPerSecond:          keyConfig.MaxMessagesPerSecond,
+		}, nil
+	}
+
+	return data.SubscriberQuota{}, fmt.Errorf("invalid API key")
+}
+
+func (sa *subscribersAuthenticator) getQuotaByToken(token string) (data.SubscriberQuota, error) {
+	if len(sa.jwtSecret) == 0 {
+		return data.SubscriberQuota{}, fmt.Errorf("JWT authentication is not enabled")
+	}
+
+	claims := &subscriberClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		_, isHMAC := token.Method.(*jwt.SigningMethodHMAC)
+		if !isHMAC {
+			return nil, fmt.Errorf("unexpected signing method %v", token.Header["alg"])
+		}
+
+		return sa.jwtSecret, nil
+	})
+	if err != nil {
+		return data.SubscriberQuota{}, err
+	}
+	if claims.Subject == "" {
+		return data.SubscriberQuota{}, fmt.Errorf("empty JWT subject")
+	}
+	err = checkEventTypes(claims.AllowedEventTypes)
+	if err != nil {
+		return data.SubscriberQuota{}, err
+	}
+
+	return data.SubscriberQuota{
+		Name:                          claims.Subject,
+		MaxConnections:                claims.MaxConnections,
+		MaxSubscriptionsPerConnection: claims.MaxSubscriptionsPerConnection,
+		AllowedEventTypes:             claims.AllowedEventTypes,
+		MaxMessagesPerSecond:          claims.MaxMessagesPerSecond,
+	}, nil
+}
+
+// ReleaseConnection frees one of the connections reserved for the provided subscriber
+func (sa *subscribersAuthenticator) ReleaseConnection(quota data.SubscriberQuota) {
+	sa.mutConnections.Lock()
+	defer sa.mutConnections.Unlock()
+
+	decrementConnections(sa.connections, getConnectionsKey(quota))
+	numConnections := decrementConnections(sa.subscriberConnections, quota.Name)
+
+	sa.metricsHandler.SetSubscriberConnections(quota.Name, numConnections)
+}
+
+func decrementConnections(connections map[string]int, key string) int {
+	numConnections := connections[key] - 1
+	if numConnections <= 0 {
+		delete(connections, key)
+		return 0
+	}
+
+	connections[key] = numConnections
+
+	return numConnections
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sa *subscribersAuthenticator) IsInterfaceNil() bool {
+	return sa == nil
+}
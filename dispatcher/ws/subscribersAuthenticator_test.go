@@ -0,0 +1,444 @@
+package ws_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/dispatcher/ws"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const jwtSecret = "secret"
+
+func createMockSubscribersAuthenticatorArgs() ws.ArgsSubscribersAuthenticator {
+	return ws.ArgsSubscribersAuthenticator{
+		Config: config.SubscribersAuthConfig{
+			Enabled:   true,
+			JWTSecret: jwtSecret,
+			APIKeys: []config.SubscriberKeyConfig{
+				{
+					Name:                          "free",
+					Key:                           "free-key",
+					MaxConnections:                1,
+					MaxSubscriptionsPerConnection: 5,
+					AllowedEventTypes:             []string{common.PushLogsAndEvents},
+					MaxMessagesPerSecond:          10,
+				},
+				{
+					Name: "partner",
+					Key:  "partner-key",
+				},
+			},
+		},
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+		NativeAuthVerifier:   &mocks.NativeAuthVerifierStub{},
+	}
+}
+
+func createSignedToken(t *testing.T, method jwt.SigningMethod, key interface{}, claims jwt.MapClaims) string {
+	token, err := jwt.NewWithClaims(method, claims).SignedString(key)
+	require.Nil(t, err)
+
+	return token
+}
+
+func TestNewSubscribersAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil status metrics handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscribersAuthenticatorArgs()
+		args.StatusMetricsHandler = nil
+
+		sa, err := ws.NewSubscribersAuthenticator(args)
+		require.True(t, check.IfNil(sa))
+		require.Equal(t, common.ErrNilStatusMetricsHandler, err)
+	})
+
+	t.Run("nil native-auth verifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscribersAuthenticatorArgs()
+		args.NativeAuthVerifier = nil
+
+		sa, err := ws.NewSubscribersAuthenticator(args)
+		require.True(t, check.IfNil(sa))
+		require.Equal(t, ws.ErrNilNativeAuthVerifier, err)
+	})
+
+	t.Run("only native-auth should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscribersAuthenticatorArgs()
+		args.Config.JWTSecret = ""
+		args.Config.APIKeys = nil
+		args.Config.NativeAuth.Enabled = true
+
+		sa, err := ws.NewSubscribersAuthenticator(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(sa))
+	})
+
+	t.Run("unknown native-auth event type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscribersAuthenticatorArgs()
+		args.Config.NativeAuth.Enabled = true
+		args.Config.NativeAuth.AllowedEventTypes = []string{"unknown"}
+
+		sa, err := ws.NewSubscribersAuthenticator(args)
+		require.True(t, check.IfNil(sa))
+		require.True(t, errors.Is(err, ws.ErrInvalidSubscribersAuthConfig))
+	})
+
+	t.Run("no credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscribersAuthenticatorArgs()
+		args.Config.JWTSecret = ""
+		args.Config.APIKeys = nil
+
+		sa, err := ws.NewSubscribersAuthenticator(args)
+		require.True(t, check.IfNil(sa))
+		require.True(t, errors.Is(err, ws.ErrInvalidSubscribersAuthConfig))
+	})
+
+	t.Run("empty key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscribersAuthenticatorArgs()
+		args.Config.APIKeys[1].Key = ""
+
+		sa, err := ws.NewSubscribersAuthenticator(args)
+		require.True(t, check.IfNil(sa))
+		require.True(t, errors.Is(err, ws.ErrInvalidSubscribersAuthConfig))
+	})
+
+	t.Run("duplicated name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscribersAuthenticatorArgs()
+		args.Config.APIKeys[1].Name = "free"
+
+		sa, err := ws.NewSubscribersAuthenticator(args)
+		require.True(t, check.IfNil(sa))
+		require.True(t, errors.Is(err, ws.ErrInvalidSubscribersAuthConfig))
+		require.True(t, strings.Contains(err.Error(), "duplicated name"))
+	})
+
+	t.Run("duplicated key should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscribersAuthenticatorArgs()
+		args.Config.APIKeys[1].Key = "free-key"
+
+		sa, err := ws.NewSubscribersAuthenticator(args)
+		require.True(t, check.IfNil(sa))
+		require.True(t, errors.Is(err, ws.ErrInvalidSubscribersAuthConfig))
+		require.True(t, strings.Contains(err.Error(), "duplicated key"))
+	})
+
+	t.Run("unknown event type should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscribersAuthenticatorArgs()
+		args.Config.APIKeys[0].AllowedEventTypes = []string{"firehose"}
+
+		sa, err := ws.NewSubscribersAuthenticator(args)
+		require.True(t, check.IfNil(sa))
+		require.True(t, errors.Is(err, ws.ErrInvalidSubscribersAuthConfig))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		sa, err := ws.NewSubscribersAuthenticator(createMockSubscribersAuthenticatorArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(sa))
+	})
+}
+
+func TestSubscribersAuthenticator_AuthenticateWithAPIKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing credentials should error", func(t *testing.T) {
+		t.Parallel()
+
+		rejections := make([]string, 0)
+		args := createMockSubscribersAuthenticatorArgs()
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddSubscriberRejectionCalled: func(subscriber string, reason string) {
+				rejections = append(rejections, subscriber+":"+reason)
+			},
+		}
+		sa, _ := ws.NewSubscribersAuthenticator(args)
+
+		_, err := sa.Authenticate(httptest.NewRequest(http.MethodGet, "/hub/ws", nil))
+		require.True(t, errors.Is(err, ws.ErrSubscriberUnauthorized))
+		assert.Equal(t, []string{"unknown:unauthorized"}, rejections)
+	})
+
+	t.Run("invalid key should error", func(t *testing.T) {
+		t.Parallel()
+
+		sa, _ := ws.NewSubscribersAuthenticator(createMockSubscribersAuthenticatorArgs())
+
+		req := httptest.NewRequest(http.MethodGet, "/hub/ws", nil)
+		req.Header.Set("X-Api-Key", "invalid")
+		_, err := sa.Authenticate(req)
+		require.True(t, errors.Is(err, ws.ErrSubscriberUnauthorized))
+	})
+
+	t.Run("key from header should work", func(t *testing.T) {
+		t.Parallel()
+
+		sa, _ := ws.NewSubscribersAuthenticator(createMockSubscribersAuthenticatorArgs())
+
+		req := httptest.NewRequest(http.MethodGet, "/hub/ws", nil)
+		req.Header.Set("X-Api-Key", "free-key")
+		quota, err := sa.Authenticate(req)
+		require.Nil(t, err)
+		assert.Equal(t, data.SubscriberQuota{
+			Name:                          "free",
+			MaxConnections:                1,
+			MaxSubscriptionsPerConnection: 5,
+			AllowedEventTypes:             []string{common.PushLogsAndEvents},
+			MaxMessagesPerSecond:          10,
+		}, quota)
+	})
+
+	t.Run("key from query should work", func(t *testing.T) {
+		t.Parallel()
+
+		sa, _ := ws.NewSubscribersAuthenticator(createMockSubscribersAuthenticatorArgs())
+
+		quota, err := sa.Authenticate(httptest.NewRequest(http.MethodGet, "/hub/ws?apiKey=partner-key", nil))
+		require.Nil(t, err)
+		assert.Equal(t, "partner", quota.Name)
+	})
+}
+
+func TestSubscribersAuthenticator_AuthenticateWithJWT(t *testing.T) {
+	t.Parallel()
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"sub":                           "dapp",
+			"exp":                           time.Now().Add(time.Hour).Unix(),
+			"maxConnections":                3,
+			"maxSubscriptionsPerConnection": 4,
+			"allowedEventTypes":             []string{common.BlockEvents},
+			"maxMessagesPerSecond":          5,
+		}
+	}
+
+	t.Run("bearer token should work", func(t *testing.T) {
+		t.Parallel()
+
+		sa, _ := ws.NewSubscribersAuthenticator(createMockSubscribersAuthenticatorArgs())
+
+		req := httptest.NewRequest(http.MethodGet, "/hub/ws", nil)
+		req.Header.Set("Authorization", "Bearer "+createSignedToken(t, jwt.SigningMethodHS256, []byte(jwtSecret), validClaims()))
+		quota, err := sa.Authenticate(req)
+		require.Nil(t, err)
+		assert.Equal(t, data.SubscriberQuota{
+			Name:                          "dapp",
+			MaxConnections:                3,
+			MaxSubscriptionsPerConnection: 4,
+			AllowedEventTypes:             []string{common.BlockEvents},
+			MaxMessagesPerSecond:          5,
+		}, quota)
+	})
+
+	t.Run("token from query should work", func(t *testing.T) {
+		t.Parallel()
+
+		sa, _ := ws.NewSubscribersAuthenticator(createMockSubscribersAuthenticatorArgs())
+
+		token := createSignedToken(t, jwt.SigningMethodHS256, []byte(jwtSecret), validClaims())
+		quota, err := sa.Authenticate(httptest.NewRequest(http.MethodGet, "/hub/ws?token="+token, nil))
+		require.Nil(t, err)
+		assert.Equal(t, "dapp", quota.Name)
+	})
+
+	t.Run("wrong secret should error", func(t *testing.T) {
+		t.Parallel()
+
+		sa, _ := ws.NewSubscribersAuthenticator(createMockSubscribersAuthenticatorArgs())
+
+		token := createSignedToken(t, jwt.SigningMethodHS256, []byte("other secret"), validClaims())
+		_, err := sa.Authenticate(httptest.NewRequest(http.MethodGet, "/hub/ws?token="+token, nil))
+		require.True(t, errors.Is(err, ws.ErrSubscriberUnauthorized))
+	})
+
+	t.Run("expired token should error", func(t *testing.T) {
+		t.Parallel()
+
+		sa, _ := ws.NewSubscribersAuthenticator(createMockSubscribersAuthenticatorArgs())
+
+		claims := validClaims()
+		claims["exp"] = time.Now().Add(-time.Minute).Unix()
+		token := createSignedToken(t, jwt.SigningMethodHS256, []byte(jwtSecret), claims)
+		_, err := sa.Authenticate(httptest.NewRequest(http.MethodGet, "/hub/ws?token="+token, nil))
+		require.True(t, errors.Is(err, ws.ErrSubscriberUnauthorized))
+	})
+
+	t.Run("unsigned token should error", func(t *testing.T) {
+		t.Parallel()
+
+		sa, _ := ws.NewSubscribersAuthenticator(createMockSubscribersAuthenticatorArgs())
+
+		token := createSignedToken(t, jwt.SigningMethodNone, jwt.UnsafeAllowNoneSignatureType, validClaims())
+		_, err := sa.Authenticate(httptest.NewRequest(http.MethodGet, "/hub/ws?token="+token, nil))
+		require.True(t, errors.Is(err, ws.ErrSubscriberUnauthorized))
+	})
+
+	t.Run("empty subject should error", func(t *testing.T) {
+		t.Parallel()
+
+		sa, _ := ws.NewSubscribersAuthenticator(createMockSubscribersAuthenticatorArgs())
+
+		claims := validClaims()
+		delete(claims, "sub")
+		token := createSignedToken(t, jwt.SigningMethodHS256, []byte(jwtSecret), claims)
+		_, err := sa.Authenticate(httptest.NewRequest(http.MethodGet, "/hub/ws?token="+token, nil))
+		require.True(t, errors.Is(err, ws.ErrSubscriberUnauthorized))
+	})
+
+	t.Run("JWT disabled should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockSubscribersAuthenticatorArgs()
+		args.Config.JWTSecret = ""
+		sa, _ := ws.NewSubscribersAuthenticator(args)
+
+		token := createSignedToken(t, jwt.SigningMethodHS256, []byte(""), validClaims())
+		_, err := sa.Authenticate(httptest.NewRequest(http.MethodGet, "/hub/ws?token="+token, nil))
+		require.True(t, errors.Is(err, ws.ErrSubscriberUnauthorized))
+	})
+}
+
+func TestSubscribersAuthenticator_ConnectionsLimit(t *testing.T) {
+	t.Parallel()
+
+	connections := make(map[string]int)
+	rejections := make([]string, 0)
+	args := createMockSubscribersAuthenticatorArgs()
+	args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+		SetSubscriberConnectionsCalled: func(subscriber string, count int) {
+			connections[subscriber] = count
+		},
+		AddSubscriberRejectionCalled: func(subscriber string, reason string) {
+			rejections = append(rejections, subscriber+":"+reason)
+		},
+	}
+	sa, _ := ws.NewSubscribersAuthenticator(args)
+
+	newRequest := func() *http.Request {
+		return httptest.NewRequest(http.MethodGet, "/hub/ws?apiKey=free-key", nil)
+	}
+
+	_, err := sa.Authenticate(newRequest())
+	require.Nil(t, err)
+	assert.Equal(t, 1, connections["free"])
+
+	_, err = sa.Authenticate(newRequest())
+	require.True(t, errors.Is(err, ws.ErrConnectionsLimitReached))
+	assert.Equal(t, []string{"free:connections_limit"}, rejections)
+
+	sa.ReleaseConnection(data.SubscriberQuota{Name: "free"})
+	assert.Equal(t, 0, connections["free"])
+
+	_, err = sa.Authenticate(newRequest())
+	require.Nil(t, err)
+	assert.Equal(t, 1, connections["free"])
+
+	// the partner key has no connections limit
+	for i := 0; i < 10; i++ {
+		_, err = sa.Authenticate(httptest.NewRequest(http.MethodGet, "/hub/ws?apiKey=partner-key", nil))
+		require.Nil(t, err)
+	}
+	assert.Equal(t, 10, connections["partner"])
+}
+
+func TestSubscribersAuthenticator_NativeAuth(t *testing.T) {
+	t.Parallel()
+
+	nativeAuthToken := "ZXJkMWFkZHJlc3M.Ym9keQ.abcd"
+	connections := make(map[string]int)
+	args := createMockSubscribersAuthenticatorArgs()
+	args.Config.NativeAuth = config.NativeAuthConfig{
+		Enabled:                       true,
+		OwnAddressOnly:                true,
+		MaxConnections:                1,
+		MaxSubscriptionsPerConnection: 3,
+		AllowedEventTypes:             []string{common.PushLogsAndEvents},
+		MaxMessagesPerSecond:          5,
+	}
+	args.NativeAuthVerifier = &mocks.NativeAuthVerifierStub{
+		VerifyCalled: func(token string) (string, error) {
+			if token == nativeAuthToken {
+				return "erd1address", nil
+			}
+			if token == "invalid" {
+				return "", ws.ErrInvalidNativeAuthToken
+			}
+
+			return "erd1other", nil
+		},
+	}
+	args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+		SetSubscriberConnectionsCalled: func(subscriber string, count int) {
+			connections[subscriber] = count
+		},
+	}
+	sa, _ := ws.NewSubscribersAuthenticator(args)
+
+	req := httptest.NewRequest(http.MethodGet, "/hub/ws", nil)
+	req.Header.Set("Authorization", "Bearer "+nativeAuthToken)
+	quota, err := sa.Authenticate(req)
+	require.Nil(t, err)
+	assert.Equal(t, data.SubscriberQuota{
+		Name:                          common.NativeAuthSubscriber,
+		Address:                       "erd1address",
+		OwnAddressOnly:                true,
+		MaxConnections:                1,
+		MaxSubscriptionsPerConnection: 3,
+		AllowedEventTypes:             []string{common.PushLogsAndEvents},
+		MaxMessagesPerSecond:          5,
+	}, quota)
+	assert.Equal(t, 1, connections[common.NativeAuthSubscriber])
+
+	// the connections limit is applied per address
+	_, err = sa.Authenticate(httptest.NewRequest(http.MethodGet, "/hub/ws?token="+nativeAuthToken, nil))
+	require.True(t, errors.Is(err, ws.ErrConnectionsLimitReached))
+
+	otherQuota, err := sa.Authenticate(httptest.NewRequest(http.MethodGet, "/hub/ws?token=other", nil))
+	require.Nil(t, err)
+	assert.Equal(t, "erd1other", otherQuota.Address)
+	assert.Equal(t, 2, connections[common.NativeAuthSubscriber])
+
+	sa.ReleaseConnection(quota)
+	assert.Equal(t, 1, connections[common.NativeAuthSubscriber])
+
+	_, err = sa.Authenticate(httptest.NewRequest(http.MethodGet, "/hub/ws?token=invalid", nil))
+	require.True(t, errors.Is(err, ws.ErrSubscriberUnauthorized))
+
+	// JWTs are still accepted
+	token := createSignedToken(t, jwt.SigningMethodHS256, []byte(jwtSecret), jwt.MapClaims{"sub": "dapp"})
+	quota, err = sa.Authenticate(httptest.NewRequest(http.MethodGet, "/hub/ws?token="+token, nil))
+	require.Nil(t, err)
+	assert.Empty(t, quota.Address)
+}
@@ -2,9 +2,12 @@ package ws
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"fmt"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -23,6 +26,11 @@ const (
 	pongWait   = 60 * time.Second
 	pingPeriod = (pongWait * 9) / 10
 	maxMsgSize = 1024 * 1024
+
+	sentMessageStatus      = "sent"
+	throttledMessageStatus = "throttled"
+
+	shutdownCloseReason = "server shutting down"
 )
 
 var (
@@ -32,16 +40,36 @@ var (
 
 // argsWebSocketDispatcher defines the arguments needed for ws dispatcher
 type argsWebSocketDispatcher struct {
-	Hub  dispatcher.Hub
-	Conn dispatcher.WSConnection
+	Hub                  dispatcher.Hub
+	Conn                 dispatcher.WSConnection
+	StatusMetricsHandler common.StatusMetricsHandler
+	Authenticator        dispatcher.SubscribersAuthenticator
+	RemoteAddress        string
+	Quota                data.SubscriberQuota
 }
 
 type websocketDispatcher struct {
-	id   uuid.UUID
-	wg   sync.WaitGroup
-	send chan []byte
-	conn dispatcher.WSConnection
-	hub  dispatcher.Hub
+	messagesSent   uint64
+	id             uuid.UUID
+	wg             sync.WaitGroup
+	send           chan []byte
+	shutdown       chan struct{}
+	shutdownOnce   sync.Once
+	writeDone      chan struct{}
+	conn           dispatcher.WSConnection
+	hub            dispatcher.Hub
+	metricsHandler common.StatusMetricsHandler
+	authenticator  dispatcher.SubscribersAuthenticator
+	remoteAddress  string
+	connectedAt    time.Time
+	quota          data.SubscriberQuota
+
+	// numSubscriptions is only accessed from the read pump
+	numSubscriptions uint32
+
+	mutMessagesWindow   sync.Mutex
+	messagesWindowStart time.Time
+	numMessagesInWindow uint32
 }
 
 // newWebSocketDispatcher createa a new ws dispatcher instance
@@ -52,12 +80,25 @@ func newWebSocketDispatcher(args argsWebSocketDispatcher) (*websocketDispatcher,
 	if args.Conn == nil {
 		return nil, ErrNilWSConn
 	}
+	if check.IfNil(args.StatusMetricsHandler) {
+		return nil, common.ErrNilStatusMetricsHandler
+	}
+	if check.IfNil(args.Authenticator) {
+		return nil, ErrNilSubscribersAuthenticator
+	}
 
 	return &websocketDispatcher{
-		id:   uuid.New(),
-		send: make(chan []byte, 256),
-		conn: args.Conn,
-		hub:  args.Hub,
+		id:             uuid.New(),
+		send:           make(chan []byte, 256),
+		shutdown:       make(chan struct{}),
+		writeDone:      make(chan struct{}),
+		conn:           args.Conn,
+		hub:            args.Hub,
+		metricsHandler: args.StatusMetricsHandler,
+		authenticator:  args.Authenticator,
+		remoteAddress:  args.RemoteAddress,
+		connectedAt:    time.Now(),
+		quota:          args.Quota,
 	}, nil
 }
 
@@ -66,6 +107,41 @@ func (wd *websocketDispatcher) GetID() uuid.UUID {
 	return wd.id
 }
 
+// GetInfo returns the connection details and the sending statistics of this dispatcher
+func (wd *websocketDispatcher) GetInfo() data.DispatcherInfo {
+	return data.DispatcherInfo{
+		ID:            wd.id,
+		Subscriber:    wd.quota.Name,
+		Address:       wd.quota.Address,
+		RemoteAddress: wd.remoteAddress,
+		ConnectedAt:   wd.connectedAt.Unix(),
+		QueueDepth:    len(wd.send),
+		MessagesSent:  atomic.LoadUint64(&wd.messagesSent),
+	}
+}
+
+// Disconnect closes the underlying connection. The read pump will then unregister the dispatcher from the hub
+func (wd *websocketDispatcher) Disconnect() error {
+	return wd.conn.Close()
+}
+
+// Shutdown makes the write pump send the queued messages, followed by a close frame with the going
+// away code. An error is returned if the queued messages are not sent before the context is done
+func (wd *websocketDispatcher) Shutdown(ctx context.Context) error {
+	wd.shutdownOnce.Do(func() {
+		close(wd.shutdown)
+	})
+
+	select {
+	case <-wd.writeDone:
+		return nil
+	case <-ctx.Done():
+		numUndelivered := len(wd.send)
+		_ = wd.conn.Close()
+		return fmt.Errorf("%w: %d messages not sent to dispatcher %s", common.ErrUndeliveredData, numUndelivered, wd.id)
+	}
+}
+
 // PushEvents receives an events slice and processes it before pushing to socket
 func (wd *websocketDispatcher) PushEvents(events []data.Event) {
 	eventBytes, err := json.Marshal(events)
@@ -84,7 +160,7 @@ func (wd *websocketDispatcher) PushEvents(events []data.Event) {
 		return
 	}
 
-	wd.send <- wsEventBytes
+	wd.sendMessage(wsEventBytes)
 }
 
 // RevertEvent receives a reverted block event and process it before pushing to socket
@@ -104,7 +180,7 @@ func (wd *websocketDispatcher) RevertEvent(event data.RevertBlock) {
 		return
 	}
 
-	wd.send <- wsEventBytes
+	wd.sendMessage(wsEventBytes)
 }
 
 // FinalizedEvent receives a finalized block event and process it before pushing to socket
@@ -124,7 +200,7 @@ func (wd *websocketDispatcher) FinalizedEvent(event data.FinalizedBlock) {
 		return
 	}
 
-	wd.send <- wsEventBytes
+	wd.sendMessage(wsEventBytes)
 }
 
 // TxsEvent receives a block txs event and process it before pushing to socket
@@ -144,7 +220,7 @@ func (wd *websocketDispatcher) TxsEvent(event data.BlockTxs) {
 		return
 	}
 
-	wd.send <- wsEventBytes
+	wd.sendMessage(wsEventBytes)
 }
 
 // BlockEvents receives block events with data and processes it before pushing to socket
@@ -164,7 +240,7 @@ func (wd *websocketDispatcher) BlockEvents(event data.BlockEventsWithOrder) {
 		return
 	}
 
-	wd.send <- wsEventBytes
+	wd.sendMessage(wsEventBytes)
 }
 
 // ScrsEvent receives a block scrs event and process it before pushing to socket
@@ -184,13 +260,227 @@ func (wd *websocketDispatcher) ScrsEvent(event data.BlockScrs) {
 		return
 	}
 
-	wd.send <- wsEventBytes
+	wd.sendMessage(wsEventBytes)
+}
+
+// RewardsEvent receives a block rewards event and process it before pushing to socket
+func (wd *websocketDispatcher) RewardsEvent(event data.BlockRewards) {
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+	wsEvent := &data.WebSocketEvent{
+		Type: common.BlockRewards,
+		Data: eventBytes,
+	}
+	wsEventBytes, err := json.Marshal(wsEvent)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+
+	wd.sendMessage(wsEventBytes)
+}
+
+// ReceiptsEvent receives a block receipts event and process it before pushing to socket
+func (wd *websocketDispatcher) ReceiptsEvent(event data.BlockReceipts) {
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+	wsEvent := &data.WebSocketEvent{
+		Type: common.BlockReceipts,
+		Data: eventBytes,
+	}
+	wsEventBytes, err := json.Marshal(wsEvent)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+
+	wd.sendMessage(wsEventBytes)
+}
+
+// InvalidTxsEvent receives a block invalid txs event and process it before pushing to socket
+func (wd *websocketDispatcher) InvalidTxsEvent(event data.BlockInvalidTxs) {
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+	wsEvent := &data.WebSocketEvent{
+		Type: common.BlockInvalidTxs,
+		Data: eventBytes,
+	}
+	wsEventBytes, err := json.Marshal(wsEvent)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+
+	wd.sendMessage(wsEventBytes)
+}
+
+// AlteredAccountsEvent receives a block altered accounts event and process it before pushing to socket
+func (wd *websocketDispatcher) AlteredAccountsEvent(event data.BlockAlteredAccounts) {
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+	wsEvent := &data.WebSocketEvent{
+		Type: common.AlteredAccounts,
+		Data: eventBytes,
+	}
+	wsEventBytes, err := json.Marshal(wsEvent)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+
+	wd.sendMessage(wsEventBytes)
+}
+
+// BlockHeaderEvent receives a block header event and process it before pushing to socket
+func (wd *websocketDispatcher) BlockHeaderEvent(event data.BlockHeader) {
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+	wsEvent := &data.WebSocketEvent{
+		Type: common.BlockHeader,
+		Data: eventBytes,
+	}
+	wsEventBytes, err := json.Marshal(wsEvent)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+
+	wd.sendMessage(wsEventBytes)
+}
+
+// EpochStartEvent receives an epoch start event and process it before pushing to socket
+func (wd *websocketDispatcher) EpochStartEvent(event data.EpochStart) {
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+	wsEvent := &data.WebSocketEvent{
+		Type: common.EpochStart,
+		Data: eventBytes,
+	}
+	wsEventBytes, err := json.Marshal(wsEvent)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+
+	wd.sendMessage(wsEventBytes)
+}
+
+// TxBundlesEvent receives a tx bundles event and process it before pushing to socket
+func (wd *websocketDispatcher) TxBundlesEvent(event data.BlockTxBundles) {
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+	wsEvent := &data.WebSocketEvent{
+		Type: common.TxBundles,
+		Data: eventBytes,
+	}
+	wsEventBytes, err := json.Marshal(wsEvent)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+
+	wd.sendMessage(wsEventBytes)
+}
+
+// TxStatusEvent receives a tx status event and process it before pushing to socket
+func (wd *websocketDispatcher) TxStatusEvent(event data.TxStatus) {
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+	wsEvent := &data.WebSocketEvent{
+		Type: common.TxStatus,
+		Data: eventBytes,
+	}
+	wsEventBytes, err := json.Marshal(wsEvent)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+
+	wd.sendMessage(wsEventBytes)
+}
+
+// SourceStaleEvent will send the source stale event to the websocket client
+func (wd *websocketDispatcher) SourceStaleEvent(event data.SourceStaleEvent) {
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+	wsEvent := &data.WebSocketEvent{
+		Type: common.SourceStaleEvents,
+		Data: eventBytes,
+	}
+	wsEventBytes, err := json.Marshal(wsEvent)
+	if err != nil {
+		log.Error("failure marshalling events", "err", err.Error())
+		return
+	}
+
+	wd.sendMessage(wsEventBytes)
+}
+
+// sendMessage queues the message for the write pump, unless the subscriber exceeded its messages rate
+func (wd *websocketDispatcher) sendMessage(message []byte) {
+	if !wd.isMessageAllowed(time.Now()) {
+		wd.metricsHandler.AddSubscriberMessage(wd.quota.Name, throttledMessageStatus)
+		log.Trace("websocket message throttled", "dispatcherID", wd.id, "subscriber", wd.quota.Name)
+		return
+	}
+
+	wd.send <- message
+}
+
+// isMessageAllowed counts the messages sent within the current second
+func (wd *websocketDispatcher) isMessageAllowed(now time.Time) bool {
+	if wd.quota.MaxMessagesPerSecond == 0 {
+		return true
+	}
+
+	wd.mutMessagesWindow.Lock()
+	defer wd.mutMessagesWindow.Unlock()
+
+	if now.Sub(wd.messagesWindowStart) >= time.Second {
+		wd.messagesWindowStart = now
+		wd.numMessagesInWindow = 0
+	}
+	if wd.numMessagesInWindow >= wd.quota.MaxMessagesPerSecond {
+		return false
+	}
+
+	wd.numMessagesInWindow++
+
+	return true
 }
 
 // writePump listens on the send-channel and pushes data on the socket stream
 func (wd *websocketDispatcher) writePump() {
 	ticker := time.NewTicker(pingPeriod)
 	defer func() {
+		defer close(wd.writeDone)
 		ticker.Stop()
 		if err := wd.conn.Close(); err != nil {
 			if _, ok := err.(*net.OpError); ok {
@@ -202,18 +492,6 @@ func (wd *websocketDispatcher) writePump() {
 		}
 	}()
 
-	nextWriterWrap := func(msgType int, data []byte) error {
-		writer, err := wd.conn.NextWriter(msgType)
-		if err != nil {
-			return err
-		}
-		_, err = writer.Write(data)
-		if err != nil {
-			return err
-		}
-		return writer.Close()
-	}
-
 	for {
 		select {
 		case message, ok := <-wd.send:
@@ -229,10 +507,14 @@ func (wd *websocketDispatcher) writePump() {
 				}
 			}
 
-			if err := nextWriterWrap(websocket.TextMessage, message); err != nil {
+			err := wd.writeTextMessage(message)
+			if err != nil {
 				log.Error("failed to write text message", "err", err.Error())
 				return
 			}
+		case <-wd.shutdown:
+			wd.writeQueuedMessagesAndClose()
+			return
 		case <-ticker.C:
 			if err := wd.setSocketWriteLimits(); err != nil {
 				log.Error("ticker: failed to set socket write limits", "err", err.Error())
@@ -245,10 +527,72 @@ func (wd *websocketDispatcher) writePump() {
 	}
 }
 
+// writeTextMessage writes the message on the socket stream and updates the sending statistics
+func (wd *websocketDispatcher) writeTextMessage(message []byte) error {
+	startTime := time.Now()
+	err := wd.nextWriterWrap(websocket.TextMessage, message)
+	wd.metricsHandler.ObservePublish(common.WebSocketSink, time.Since(startTime))
+	if err != nil {
+		wd.metricsHandler.AddStageError(common.WebSocketSink)
+		return err
+	}
+
+	atomic.AddUint64(&wd.messagesSent, 1)
+	wd.metricsHandler.AddSubscriberMessage(wd.quota.Name, sentMessageStatus)
+
+	return nil
+}
+
+func (wd *websocketDispatcher) nextWriterWrap(msgType int, data []byte) error {
+	writer, err := wd.conn.NextWriter(msgType)
+	if err != nil {
+		return err
+	}
+	_, err = writer.Write(data)
+	if err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+// writeQueuedMessagesAndClose sends the messages already queued, then lets the client know that the
+// server is going away
+func (wd *websocketDispatcher) writeQueuedMessagesAndClose() {
+	for {
+		select {
+		case message, ok := <-wd.send:
+			if !ok {
+				return
+			}
+
+			if err := wd.setSocketWriteLimits(); err != nil {
+				log.Error("shutdown: failed to set socket write limits", "err", err.Error())
+				return
+			}
+			if err := wd.writeTextMessage(message); err != nil {
+				log.Error("shutdown: failed to write text message", "err", err.Error())
+				return
+			}
+		default:
+			if err := wd.setSocketWriteLimits(); err != nil {
+				log.Error("shutdown: failed to set socket write limits", "err", err.Error())
+				return
+			}
+
+			closeMessage := websocket.FormatCloseMessage(websocket.CloseGoingAway, shutdownCloseReason)
+			if err := wd.conn.WriteMessage(websocket.CloseMessage, closeMessage); err != nil {
+				log.Debug("failed to write close message", "err", err.Error())
+			}
+			return
+		}
+	}
+}
+
 // readPump listens for incoming events and reads the content from the socket stream
 func (wd *websocketDispatcher) readPump() {
 	defer func() {
 		wd.hub.UnregisterEvent(wd)
+		wd.authenticator.ReleaseConnection(wd.quota)
 		if err := wd.conn.Close(); err != nil {
 			log.Error("failed to close socket on defer", "err", err.Error())
 		}
@@ -287,9 +631,95 @@ func (wd *websocketDispatcher) trySendSubscribeEvent(eventBytes []byte) {
 		return
 	}
 	subscribeEvent.DispatcherID = wd.id
+	subscribeEvent.AuthenticatedAddress = wd.quota.Address
+	subscribeEvent.OwnAddressOnly = wd.quota.OwnAddressOnly
+
+	numSubscriptions, err := wd.checkSubscribeEvent(subscribeEvent)
+	if err != nil {
+		log.Debug("websocket subscription rejected", "dispatcherID", wd.id, "subscriber", wd.quota.Name, "err", err.Error())
+		wd.sendSubscriptionError(err)
+		return
+	}
+
+	wd.numSubscriptions += numSubscriptions
 	wd.hub.Subscribe(subscribeEvent)
 }
 
+// checkSubscribeEvent verifies the subscribe event against the subscriber quota and
+// returns the number of subscriptions it creates
+func (wd *websocketDispatcher) checkSubscribeEvent(subscribeEvent data.SubscribeEvent) (uint32, error) {
+	if subscribeEvent.OwnAddressOnly {
+		_, err := dispatcher.RestrictToOwnAddress(subscribeEvent)
+		if err != nil {
+			wd.metricsHandler.AddSubscriberRejection(wd.quota.Name, addressNotAllowedReason)
+			return 0, err
+		}
+	}
+
+	numSubscriptions := uint32(len(subscribeEvent.SubscriptionEntries))
+	eventTypes := []string{common.PushLogsAndEvents}
+	if numSubscriptions == 0 {
+		numSubscriptions = 1
+	} else {
+		eventTypes = make([]string, 0, numSubscriptions)
+		for _, subEntry := range subscribeEvent.SubscriptionEntries {
+			eventTypes = append(eventTypes, dispatcher.GetEventType(subEntry))
+		}
+	}
+
+	maxSubscriptions := wd.quota.MaxSubscriptionsPerConnection
+	if maxSubscriptions > 0 && wd.numSubscriptions+numSubscriptions > maxSubscriptions {
+		wd.metricsHandler.AddSubscriberRejection(wd.quota.Name, subscriptionsLimitReason)
+		return 0, fmt.Errorf("%w: %d subscriptions allowed", ErrSubscriptionsLimitReached, maxSubscriptions)
+	}
+
+	for _, eventType := range eventTypes {
+		if !wd.isEventTypeAllowed(eventType) {
+			wd.metricsHandler.AddSubscriberRejection(wd.quota.Name, eventTypeNotAllowedReason)
+			return 0, fmt.Errorf("%w: %s", ErrEventTypeNotAllowed, eventType)
+		}
+	}
+
+	return numSubscriptions, nil
+}
+
+func (wd *websocketDispatcher) isEventTypeAllowed(eventType string) bool {
+	if len(wd.quota.AllowedEventTypes) == 0 {
+		return true
+	}
+
+	for _, allowedEventType := range wd.quota.AllowedEventTypes {
+		if allowedEventType == eventType {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (wd *websocketDispatcher) sendSubscriptionError(subscriptionErr error) {
+	errorBytes, err := json.Marshal(data.SubscriptionError{Error: subscriptionErr.Error()})
+	if err != nil {
+		log.Error("failure marshalling subscription error", "err", err.Error())
+		return
+	}
+	wsEvent := &data.WebSocketEvent{
+		Type: common.SubscriptionErrorEvent,
+		Data: errorBytes,
+	}
+	wsEventBytes, err := json.Marshal(wsEvent)
+	if err != nil {
+		log.Error("failure marshalling subscription error", "err", err.Error())
+		return
+	}
+
+	select {
+	case wd.send <- wsEventBytes:
+	default:
+		log.Debug("dropped subscription error, send queue is full", "dispatcherID", wd.id)
+	}
+}
+
 func (wd *websocketDispatcher) setSocketWriteLimits() error {
 	if err := wd.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
 		return err
@@ -1,11 +1,15 @@
 package ws_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"io"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/gorilla/websocket"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/data"
@@ -33,6 +37,8 @@ func createMockWSDispatcherArgs() ws.ArgsWSDispatcher {
 
 	args.Hub = &mocks.HubStub{}
 	args.Conn = &mocks.WSConnStub{}
+	args.StatusMetricsHandler = &mocks.StatusMetricsStub{}
+	args.Authenticator = &mocks.SubscribersAuthenticatorStub{}
 	return args
 }
 
@@ -61,6 +67,28 @@ func TestNewWebSocketDispatcher(t *testing.T) {
 		assert.Equal(t, ws.ErrNilWSConn, err)
 	})
 
+	t.Run("nil status metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWSDispatcherArgs()
+		args.StatusMetricsHandler = nil
+
+		wd, err := ws.NewTestWSDispatcher(args)
+		require.Nil(t, wd)
+		assert.Equal(t, common.ErrNilStatusMetricsHandler, err)
+	})
+
+	t.Run("nil subscribers authenticator", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWSDispatcherArgs()
+		args.Authenticator = nil
+
+		wd, err := ws.NewTestWSDispatcher(args)
+		require.Nil(t, wd)
+		assert.Equal(t, ws.ErrNilSubscribersAuthenticator, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -91,6 +119,18 @@ func TestWritePump(t *testing.T) {
 			return &testWriter{}, nil
 		},
 	}
+	numPublishObservations := 0
+	numPublishErrors := 0
+	args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+		ObservePublishCalled: func(sink string, duration time.Duration) {
+			assert.Equal(t, common.WebSocketSink, sink)
+			numPublishObservations++
+		},
+		AddStageErrorCalled: func(stage string) {
+			assert.Equal(t, common.WebSocketSink, stage)
+			numPublishErrors++
+		},
+	}
 
 	wd, err := ws.NewTestWSDispatcher(args)
 	require.Nil(t, err)
@@ -107,6 +147,8 @@ func TestWritePump(t *testing.T) {
 	wd.WritePump()
 
 	assert.True(t, wasCalled)
+	assert.Equal(t, 2, numPublishObservations)
+	assert.Equal(t, 1, numPublishErrors)
 }
 
 func TestReadPump(t *testing.T) {
@@ -130,12 +172,21 @@ func TestReadPump(t *testing.T) {
 		},
 	}
 
+	releasedSubscriber := ""
+	args.Quota = data.SubscriberQuota{Name: "free"}
+	args.Authenticator = &mocks.SubscribersAuthenticatorStub{
+		ReleaseConnectionCalled: func(quota data.SubscriberQuota) {
+			releasedSubscriber = quota.Name
+		},
+	}
+
 	wd, err := ws.NewTestWSDispatcher(args)
 	require.Nil(t, err)
 
 	wd.ReadPump()
 
 	assert.True(t, wasCalled)
+	assert.Equal(t, "free", releasedSubscriber)
 }
 
 func TestPushEvents(t *testing.T) {
@@ -173,8 +224,9 @@ func TestBlockEventsWithOrder(t *testing.T) {
 	wd, err := ws.NewTestWSDispatcher(args)
 	require.Nil(t, err)
 
-	txs := map[string]*data.NotifierTransaction{
-		"txHash1": {
+	txs := []*data.NotifierTransaction{
+		{
+			Hash: "txHash1",
 			Transaction: &transaction.Transaction{
 				Nonce: 1,
 			},
@@ -202,3 +254,255 @@ func TestBlockEventsWithOrder(t *testing.T) {
 
 	require.Equal(t, expectedEventBytes, eventsData)
 }
+
+func TestGetInfoAndDisconnect(t *testing.T) {
+	t.Parallel()
+
+	wasClosed := false
+	args := createMockWSDispatcherArgs()
+	args.RemoteAddress = "127.0.0.1:1234"
+	args.Conn = &mocks.WSConnStub{
+		CloseCalled: func() error {
+			wasClosed = true
+			return nil
+		},
+	}
+	wd, err := ws.NewTestWSDispatcher(args)
+	require.Nil(t, err)
+
+	wd.PushEvents([]data.Event{{Address: "addr1"}})
+
+	info := wd.GetInfo()
+	assert.Equal(t, wd.GetID(), info.ID)
+	assert.Equal(t, "127.0.0.1:1234", info.RemoteAddress)
+	assert.Equal(t, 1, info.QueueDepth)
+	assert.Equal(t, uint64(0), info.MessagesSent)
+	assert.NotZero(t, info.ConnectedAt)
+
+	require.Nil(t, wd.Disconnect())
+	assert.True(t, wasClosed)
+}
+
+func TestShutdown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should send queued messages and close frame", func(t *testing.T) {
+		t.Parallel()
+
+		numTextMessages := uint32(0)
+		var closeMessage atomic.Value
+		args := createMockWSDispatcherArgs()
+		args.Conn = &mocks.WSConnStub{
+			NextWriterCalled: func(messageType int) (io.WriteCloser, error) {
+				atomic.AddUint32(&numTextMessages, 1)
+				return &testWriter{}, nil
+			},
+			WriteMessageCalled: func(messageType int, data []byte) error {
+				if messageType == websocket.CloseMessage {
+					closeMessage.Store(data)
+				}
+				return nil
+			},
+		}
+		wd, err := ws.NewTestWSDispatcher(args)
+		require.Nil(t, err)
+
+		wd.PushEvents([]data.Event{{Address: "addr1"}})
+		wd.PushEvents([]data.Event{{Address: "addr2"}})
+
+		go wd.WritePump()
+
+		err = wd.Shutdown(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, uint32(2), atomic.LoadUint32(&numTextMessages))
+		expectedCloseMessage := websocket.FormatCloseMessage(websocket.CloseGoingAway, "server shutting down")
+		assert.Equal(t, expectedCloseMessage, closeMessage.Load())
+	})
+
+	t.Run("messages not sent before deadline should error", func(t *testing.T) {
+		t.Parallel()
+
+		releaseWriter := make(chan struct{})
+		args := createMockWSDispatcherArgs()
+		args.Conn = &mocks.WSConnStub{
+			NextWriterCalled: func(messageType int) (io.WriteCloser, error) {
+				<-releaseWriter
+				return &testWriter{}, nil
+			},
+		}
+		wd, err := ws.NewTestWSDispatcher(args)
+		require.Nil(t, err)
+
+		wd.PushEvents([]data.Event{{Address: "addr1"}})
+		wd.PushEvents([]data.Event{{Address: "addr2"}})
+
+		go wd.WritePump()
+		defer close(releaseWriter)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err = wd.Shutdown(ctx)
+		require.True(t, errors.Is(err, common.ErrUndeliveredData))
+	})
+}
+
+func TestSubscriptionQuota(t *testing.T) {
+	t.Parallel()
+
+	createDispatcher := func(quota data.SubscriberQuota, subscribed *[]data.SubscribeEvent, rejections *[]string) ws.ArgsWSDispatcher {
+		args := createMockWSDispatcherArgs()
+		args.Quota = quota
+		args.Hub = &mocks.HubStub{
+			SubscribeCalled: func(event data.SubscribeEvent) {
+				*subscribed = append(*subscribed, event)
+			},
+		}
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddSubscriberRejectionCalled: func(subscriber string, reason string) {
+				assert.Equal(t, quota.Name, subscriber)
+				*rejections = append(*rejections, reason)
+			},
+		}
+
+		return args
+	}
+
+	t.Run("subscriptions limit", func(t *testing.T) {
+		t.Parallel()
+
+		subscribed := make([]data.SubscribeEvent, 0)
+		rejections := make([]string, 0)
+		args := createDispatcher(data.SubscriberQuota{Name: "free", MaxSubscriptionsPerConnection: 2}, &subscribed, &rejections)
+		wd, err := ws.NewTestWSDispatcher(args)
+		require.Nil(t, err)
+
+		wd.TrySendSubscribeEvent([]byte(`{"subscriptionEntries":[{"identifier":"swap"}]}`))
+		wd.TrySendSubscribeEvent([]byte(`{"subscriptionEntries":[{"identifier":"a"},{"identifier":"b"}]}`))
+		wd.TrySendSubscribeEvent([]byte(`{}`))
+		wd.TrySendSubscribeEvent([]byte(`{}`))
+
+		assert.Equal(t, 2, len(subscribed))
+		assert.Equal(t, []string{"subscriptions_limit", "subscriptions_limit"}, rejections)
+
+		var wsEvent data.WebSocketEvent
+		err = json.Unmarshal(wd.ReadSendChannel(), &wsEvent)
+		require.Nil(t, err)
+		assert.Equal(t, common.SubscriptionErrorEvent, wsEvent.Type)
+
+		var subscriptionError data.SubscriptionError
+		err = json.Unmarshal(wsEvent.Data, &subscriptionError)
+		require.Nil(t, err)
+		assert.Contains(t, subscriptionError.Error, ws.ErrSubscriptionsLimitReached.Error())
+	})
+
+	t.Run("event type not allowed", func(t *testing.T) {
+		t.Parallel()
+
+		subscribed := make([]data.SubscribeEvent, 0)
+		rejections := make([]string, 0)
+		quota := data.SubscriberQuota{
+			Name:              "free",
+			AllowedEventTypes: []string{common.PushLogsAndEvents, common.FinalizedBlockEvents},
+		}
+		args := createDispatcher(quota, &subscribed, &rejections)
+		wd, err := ws.NewTestWSDispatcher(args)
+		require.Nil(t, err)
+
+		wd.TrySendSubscribeEvent([]byte(`{}`))
+		wd.TrySendSubscribeEvent([]byte(`{"subscriptionEntries":[{"eventType":"finalized_events"},{"identifier":"swap"}]}`))
+		wd.TrySendSubscribeEvent([]byte(`{"subscriptionEntries":[{"eventType":"finalized_events"},{"eventType":"block_txs"}]}`))
+
+		assert.Equal(t, 2, len(subscribed))
+		assert.Equal(t, []string{"event_type_not_allowed"}, rejections)
+
+		var wsEvent data.WebSocketEvent
+		err = json.Unmarshal(wd.ReadSendChannel(), &wsEvent)
+		require.Nil(t, err)
+		assert.Equal(t, common.SubscriptionErrorEvent, wsEvent.Type)
+		assert.Contains(t, string(wsEvent.Data), common.BlockTxs)
+	})
+
+	t.Run("own address only", func(t *testing.T) {
+		t.Parallel()
+
+		subscribed := make([]data.SubscribeEvent, 0)
+		rejections := make([]string, 0)
+		quota := data.SubscriberQuota{
+			Name:           common.NativeAuthSubscriber,
+			Address:        "erd1own",
+			OwnAddressOnly: true,
+		}
+		args := createDispatcher(quota, &subscribed, &rejections)
+		wd, err := ws.NewTestWSDispatcher(args)
+		require.Nil(t, err)
+
+		wd.TrySendSubscribeEvent([]byte(`{"subscriptionEntries":[{"identifier":"swap"},{"address":"erd1own"}]}`))
+		wd.TrySendSubscribeEvent([]byte(`{"subscriptionEntries":[{"address":"erd1other"}]}`))
+		wd.TrySendSubscribeEvent([]byte(`{"subscriptionEntries":[{"eventType":"block_txs"}]}`))
+
+		require.Equal(t, 1, len(subscribed))
+		assert.Equal(t, "erd1own", subscribed[0].AuthenticatedAddress)
+		assert.True(t, subscribed[0].OwnAddressOnly)
+		assert.Equal(t, []string{"address_not_allowed", "address_not_allowed"}, rejections)
+
+		var wsEvent data.WebSocketEvent
+		err = json.Unmarshal(wd.ReadSendChannel(), &wsEvent)
+		require.Nil(t, err)
+		assert.Equal(t, common.SubscriptionErrorEvent, wsEvent.Type)
+		assert.Contains(t, string(wsEvent.Data), "erd1other")
+	})
+}
+
+func TestMessagesRateLimit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no limit", func(t *testing.T) {
+		t.Parallel()
+
+		wd, err := ws.NewTestWSDispatcher(createMockWSDispatcherArgs())
+		require.Nil(t, err)
+
+		now := time.Now()
+		for i := 0; i < 1000; i++ {
+			require.True(t, wd.IsMessageAllowed(now))
+		}
+	})
+
+	t.Run("limit per second", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockWSDispatcherArgs()
+		args.Quota = data.SubscriberQuota{Name: "free", MaxMessagesPerSecond: 2}
+		wd, err := ws.NewTestWSDispatcher(args)
+		require.Nil(t, err)
+
+		now := time.Now()
+		assert.True(t, wd.IsMessageAllowed(now))
+		assert.True(t, wd.IsMessageAllowed(now.Add(100*time.Millisecond)))
+		assert.False(t, wd.IsMessageAllowed(now.Add(900*time.Millisecond)))
+		assert.True(t, wd.IsMessageAllowed(now.Add(time.Second)))
+	})
+
+	t.Run("throttled messages are dropped", func(t *testing.T) {
+		t.Parallel()
+
+		statuses := make([]string, 0)
+		args := createMockWSDispatcherArgs()
+		args.Quota = data.SubscriberQuota{Name: "free", MaxMessagesPerSecond: 1}
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddSubscriberMessageCalled: func(subscriber string, status string) {
+				assert.Equal(t, "free", subscriber)
+				statuses = append(statuses, status)
+			},
+		}
+		wd, err := ws.NewTestWSDispatcher(args)
+		require.Nil(t, err)
+
+		wd.PushEvents([]data.Event{{Address: "addr1"}})
+		wd.PushEvents([]data.Event{{Address: "addr2"}})
+
+		assert.Equal(t, 1, wd.GetInfo().QueueDepth)
+		assert.Equal(t, []string{"throttled"}, statuses)
+	})
+}
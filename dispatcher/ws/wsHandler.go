@@ -1,21 +1,27 @@
 package ws
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
 )
 
 // ArgsWebSocketProcessor defines the argument needed to create a websocketHandler
 type ArgsWebSocketProcessor struct {
-	Hub      dispatcher.Hub
-	Upgrader dispatcher.WSUpgrader
+	Hub                  dispatcher.Hub
+	Upgrader             dispatcher.WSUpgrader
+	StatusMetricsHandler common.StatusMetricsHandler
+	Authenticator        dispatcher.SubscribersAuthenticator
 }
 
 type websocketProcessor struct {
-	hub      dispatcher.Hub
-	upgrader dispatcher.WSUpgrader
+	hub            dispatcher.Hub
+	upgrader       dispatcher.WSUpgrader
+	metricsHandler common.StatusMetricsHandler
+	authenticator  dispatcher.SubscribersAuthenticator
 }
 
 // NewWebSocketProcessor creates a new websocketProcessor component
@@ -26,8 +32,10 @@ func NewWebSocketProcessor(args ArgsWebSocketProcessor) (*websocketProcessor, er
 	}
 
 	return &websocketProcessor{
-		hub:      args.Hub,
-		upgrader: args.Upgrader,
+		hub:            args.Hub,
+		upgrader:       args.Upgrader,
+		metricsHandler: args.StatusMetricsHandler,
+		authenticator:  args.Authenticator,
 	}, nil
 }
 
@@ -38,24 +46,43 @@ func checkArgs(args ArgsWebSocketProcessor) error {
 	if args.Upgrader == nil {
 		return ErrNilWSUpgrader
 	}
+	if check.IfNil(args.StatusMetricsHandler) {
+		return common.ErrNilStatusMetricsHandler
+	}
+	if check.IfNil(args.Authenticator) {
+		return ErrNilSubscribersAuthenticator
+	}
 
 	return nil
 }
 
 // ServeHTTP is the entry point used by a http server to serve the websocket upgrader
 func (wh *websocketProcessor) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	quota, err := wh.authenticator.Authenticate(r)
+	if err != nil {
+		log.Debug("websocket subscriber rejected", "remote address", r.RemoteAddr, "err", err.Error())
+		http.Error(w, err.Error(), getRejectionStatusCode(err))
+		return
+	}
+
 	conn, err := wh.upgrader.Upgrade(w, r, nil)
 	if err != nil {
+		wh.authenticator.ReleaseConnection(quota)
 		log.Error("failed upgrading connection", "err", err.Error())
 		return
 	}
 
 	args := argsWebSocketDispatcher{
-		Hub:  wh.hub,
-		Conn: conn,
+		Hub:                  wh.hub,
+		Conn:                 conn,
+		StatusMetricsHandler: wh.metricsHandler,
+		Authenticator:        wh.authenticator,
+		RemoteAddress:        r.RemoteAddr,
+		Quota:                quota,
 	}
 	wsDispatcher, err := newWebSocketDispatcher(args)
 	if err != nil {
+		wh.authenticator.ReleaseConnection(quota)
 		log.Error("failed creating a new websocket dispatcher", "err", err.Error())
 		return
 	}
@@ -65,6 +92,14 @@ func (wh *websocketProcessor) ServeHTTP(w http.ResponseWriter, r *http.Request)
 	go wsDispatcher.readPump()
 }
 
+func getRejectionStatusCode(err error) int {
+	if errors.Is(err, ErrConnectionsLimitReached) {
+		return http.StatusTooManyRequests
+	}
+
+	return http.StatusUnauthorized
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (wh *websocketProcessor) IsInterfaceNil() bool {
 	return wh == nil
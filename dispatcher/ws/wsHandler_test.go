@@ -1,9 +1,16 @@
 package ws_test
 
 import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher/ws"
 	"github.com/multiversx/mx-chain-notifier-go/mocks"
 	"github.com/stretchr/testify/assert"
@@ -12,8 +19,10 @@ import (
 
 func createMockArgsWSHandler() ws.ArgsWebSocketProcessor {
 	return ws.ArgsWebSocketProcessor{
-		Hub:      &mocks.HubStub{},
-		Upgrader: &mocks.WSUpgraderStub{},
+		Hub:                  &mocks.HubStub{},
+		Upgrader:             &mocks.WSUpgraderStub{},
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+		Authenticator:        &mocks.SubscribersAuthenticatorStub{},
 	}
 }
 
@@ -42,6 +51,28 @@ func TestNewWebSocketHandler(t *testing.T) {
 		assert.Equal(t, ws.ErrNilWSUpgrader, err)
 	})
 
+	t.Run("nil status metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWSHandler()
+		args.StatusMetricsHandler = nil
+
+		wh, err := ws.NewWebSocketProcessor(args)
+		require.True(t, check.IfNil(wh))
+		assert.Equal(t, common.ErrNilStatusMetricsHandler, err)
+	})
+
+	t.Run("nil subscribers authenticator", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWSHandler()
+		args.Authenticator = nil
+
+		wh, err := ws.NewWebSocketProcessor(args)
+		require.True(t, check.IfNil(wh))
+		assert.Equal(t, ws.ErrNilSubscribersAuthenticator, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -51,3 +82,72 @@ func TestNewWebSocketHandler(t *testing.T) {
 		require.Nil(t, err)
 	})
 }
+
+func TestWebSocketProcessor_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unauthorized subscriber should not upgrade", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWSHandler()
+		args.Authenticator = &mocks.SubscribersAuthenticatorStub{
+			AuthenticateCalled: func(r *http.Request) (data.SubscriberQuota, error) {
+				return data.SubscriberQuota{}, ws.ErrSubscriberUnauthorized
+			},
+		}
+		args.Upgrader = &mocks.WSUpgraderStub{
+			UpgradeCalled: func(w http.ResponseWriter, r *http.Request, responseHeader http.Header) (dispatcher.WSConnection, error) {
+				require.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+		wh, err := ws.NewWebSocketProcessor(args)
+		require.Nil(t, err)
+
+		resp := httptest.NewRecorder()
+		wh.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/hub/ws", nil))
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+	})
+
+	t.Run("connections limit should return too many requests", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsWSHandler()
+		args.Authenticator = &mocks.SubscribersAuthenticatorStub{
+			AuthenticateCalled: func(r *http.Request) (data.SubscriberQuota, error) {
+				return data.SubscriberQuota{}, fmt.Errorf("%w: 1 connections allowed", ws.ErrConnectionsLimitReached)
+			},
+		}
+		wh, err := ws.NewWebSocketProcessor(args)
+		require.Nil(t, err)
+
+		resp := httptest.NewRecorder()
+		wh.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/hub/ws", nil))
+		assert.Equal(t, http.StatusTooManyRequests, resp.Code)
+	})
+
+	t.Run("failed upgrade should release the connection", func(t *testing.T) {
+		t.Parallel()
+
+		releasedSubscriber := ""
+		args := createMockArgsWSHandler()
+		args.Authenticator = &mocks.SubscribersAuthenticatorStub{
+			AuthenticateCalled: func(r *http.Request) (data.SubscriberQuota, error) {
+				return data.SubscriberQuota{Name: "free"}, nil
+			},
+			ReleaseConnectionCalled: func(quota data.SubscriberQuota) {
+				releasedSubscriber = quota.Name
+			},
+		}
+		args.Upgrader = &mocks.WSUpgraderStub{
+			UpgradeCalled: func(w http.ResponseWriter, r *http.Request, responseHeader http.Header) (dispatcher.WSConnection, error) {
+				return nil, errors.New("upgrade error")
+			},
+		}
+		wh, err := ws.NewWebSocketProcessor(args)
+		require.Nil(t, err)
+
+		wh.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/hub/ws", nil))
+		assert.Equal(t, "free", releasedSubscriber)
+	})
+}
@@ -10,3 +10,27 @@ var ErrNilWSHandler = errors.New("nil websocket handler")
 
 // ErrNilEventsInterceptor signals that a nil events interceptor was provided
 var ErrNilEventsInterceptor = errors.New("nil events interceptor")
+
+// ErrNilObserversQuorum signals that a nil observers quorum component was provided
+var ErrNilObserversQuorum = errors.New("nil observers quorum")
+
+// ErrNilObserversTracker signals that a nil observers tracker was provided
+var ErrNilObserversTracker = errors.New("nil observers tracker")
+
+// ErrNilHealthService signals that a nil health service was provided
+var ErrNilHealthService = errors.New("nil health service")
+
+// ErrNilHub signals that a nil hub was provided
+var ErrNilHub = errors.New("nil hub")
+
+// ErrNilIngestionQueue signals that a nil ingestion queue was provided
+var ErrNilIngestionQueue = errors.New("nil ingestion queue")
+
+// ErrNilTxStatusTracker signals that a nil tx status tracker was provided
+var ErrNilTxStatusTracker = errors.New("nil tx status tracker")
+
+// ErrNilBlockHeader signals that a block without header was pushed
+var ErrNilBlockHeader = errors.New("nil block header")
+
+// ErrUnauthenticatedObserver signals that a block was pushed without an authenticated observer ID while the quorum is enabled
+var ErrUnauthenticatedObserver = errors.New("unauthenticated observer cannot vote for the observers quorum")
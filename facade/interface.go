@@ -1,6 +1,9 @@
 package facade
 
 import (
+	"context"
+
+	"github.com/google/uuid"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
 )
@@ -13,6 +16,13 @@ type EventsHandler interface {
 	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock)
 	HandleBlockTxs(blockTxs data.BlockTxs)
 	HandleBlockScrs(blockScrs data.BlockScrs)
+	HandleBlockRewards(blockRewards data.BlockRewards)
+	HandleBlockReceipts(blockReceipts data.BlockReceipts)
+	HandleBlockInvalidTxs(blockInvalidTxs data.BlockInvalidTxs)
+	HandleAlteredAccounts(blockAlteredAccounts data.BlockAlteredAccounts)
+	HandleBlockHeader(blockHeader data.BlockHeader)
+	HandleEpochStart(epochStart data.EpochStart)
+	HandleTxBundles(blockTxBundles data.BlockTxBundles)
 	HandleBlockEventsWithOrder(blockTxs data.BlockEventsWithOrder)
 	IsInterfaceNil() bool
 }
@@ -29,6 +39,14 @@ type HubHandler interface {
 	IsInterfaceNil() bool
 }
 
+// AdminHub defines the behaviour of a hub component which exposes its dispatchers and subscriptions
+type AdminHub interface {
+	GetDispatchers() []data.DispatcherInfo
+	DisconnectDispatcher(dispatcherID uuid.UUID) error
+	GetSubscriptionsStats() data.SubscriptionsStats
+	IsInterfaceNil() bool
+}
+
 // Publisher defines the behaviour of a publisher component which should be
 // able to publish received events and broadcast them to channels
 type Publisher interface {
@@ -43,3 +61,28 @@ type EventsInterceptor interface {
 	ProcessBlockEvents(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error)
 	IsInterfaceNil() bool
 }
+
+// ObserversQuorum defines the behaviour of a component which decides when a block pushed
+// by observers can be published
+type ObserversQuorum interface {
+	ProcessBlock(vote data.ObserverBlockVote, publishHandler func())
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// TxStatusTracker defines the behaviour of a component which follows the transactions across blocks
+// and shards and handles their final status
+type TxStatusTracker interface {
+	ProcessTxBundles(blockTxBundles data.BlockTxBundles)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// IngestionQueue defines the behaviour of a component which queues the blocks pushed by observers
+// and processes them in order, per shard
+type IngestionQueue interface {
+	Enqueue(shardID uint32, processHandler func()) error
+	Drain(ctx context.Context) error
+	Close() error
+	IsInterfaceNil() bool
+}
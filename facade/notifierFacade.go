@@ -1,18 +1,43 @@
 package facade
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
 	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
+	"github.com/multiversx/mx-chain-notifier-go/tracing"
 )
 
 var log = logger.GetOrCreate("facade")
 
+const (
+	observerMetricPrefix = "Observer"
+	// unauthenticatedObserverID is used in the observers health for the pushes without an authenticated observer
+	unauthenticatedObserverID = "unauthenticated"
+
+	defaultMaxBodySizeInMB = 128
+	bytesInMB              = 1024 * 1024
+)
+
 // ArgsNotifierFacade defines the arguments necessary for notifierFacade creation
 type ArgsNotifierFacade struct {
 	APIConfig            config.ConnectorApiConfig
@@ -20,6 +45,12 @@ type ArgsNotifierFacade struct {
 	WSHandler            dispatcher.WSHandler
 	EventsInterceptor    EventsInterceptor
 	StatusMetricsHandler common.StatusMetricsHandler
+	ObserversQuorum      ObserversQuorum
+	ObserversTracker     common.ObserversTracker
+	HealthService        common.HealthService
+	Hub                  AdminHub
+	IngestionQueue       IngestionQueue
+	TxStatusTracker      TxStatusTracker
 }
 
 type notifierFacade struct {
@@ -28,6 +59,16 @@ type notifierFacade struct {
 	wsHandler         dispatcher.WSHandler
 	eventsInterceptor EventsInterceptor
 	statusMetrics     common.StatusMetricsHandler
+	observersQuorum   ObserversQuorum
+	observersTracker  common.ObserversTracker
+	healthService     common.HealthService
+	hub               AdminHub
+	ingestionQueue    IngestionQueue
+	txStatusTracker   TxStatusTracker
+
+	mutDraining   sync.Mutex
+	isDraining    bool
+	pendingPushes sync.WaitGroup
 }
 
 // NewNotifierFacade creates a new notifier facade instance
@@ -43,6 +84,12 @@ func NewNotifierFacade(args ArgsNotifierFacade) (*notifierFacade, error) {
 		wsHandler:         args.WSHandler,
 		eventsInterceptor: args.EventsInterceptor,
 		statusMetrics:     args.StatusMetricsHandler,
+		observersQuorum:   args.ObserversQuorum,
+		observersTracker:  args.ObserversTracker,
+		healthService:     args.HealthService,
+		hub:               args.Hub,
+		ingestionQueue:    args.IngestionQueue,
+		txStatusTracker:   args.TxStatusTracker,
 	}, nil
 }
 
@@ -59,53 +106,343 @@ func checkArgs(args ArgsNotifierFacade) error {
 	if check.IfNil(args.StatusMetricsHandler) {
 		return common.ErrNilStatusMetricsHandler
 	}
+	if check.IfNil(args.ObserversQuorum) {
+		return ErrNilObserversQuorum
+	}
+	if check.IfNil(args.ObserversTracker) {
+		return ErrNilObserversTracker
+	}
+	if check.IfNil(args.HealthService) {
+		return ErrNilHealthService
+	}
+	if check.IfNil(args.Hub) {
+		return ErrNilHub
+	}
+	if check.IfNil(args.IngestionQueue) {
+		return ErrNilIngestionQueue
+	}
+	if check.IfNil(args.TxStatusTracker) {
+		return ErrNilTxStatusTracker
+	}
 
 	return nil
 }
 
 // HandlePushEventsV2 will handle push events received from observer
-// It splits block data and handles log, txs and srcs events separately
-func (nf *notifierFacade) HandlePushEventsV2(allEvents data.ArgsSaveBlockData) error {
-	eventsData, err := nf.eventsInterceptor.ProcessBlockEvents(&allEvents)
+// It splits block data and handles log, txs and srcs events separately,
+// once the observers quorum allows the block to be published. Only authenticated observers can vote for the quorum
+func (nf *notifierFacade) HandlePushEventsV2(ctx context.Context, allEvents data.ArgsSaveBlockData, observerID string) error {
+	if observerID == "" && nf.config.Quorum.Enabled {
+		return ErrUnauthenticatedObserver
+	}
+
+	eventsData, err := nf.processBlockEvents(ctx, allEvents)
 	if err != nil {
 		return err
 	}
+	if eventsData.Hash == "" {
+		return common.ErrReceivedEmptyEvents
+	}
 
-	pushEvents := data.BlockEvents{
-		Hash:      eventsData.Hash,
-		ShardID:   eventsData.Header.GetShardID(),
-		TimeStamp: eventsData.Header.GetTimeStamp(),
-		Events:    eventsData.LogEvents,
+	nf.observersTracker.RecordPush(getTrackedObserverID(observerID), eventsData.Header.GetShardID(), eventsData.Header.GetNonce())
+
+	digest, err := computeBlockDigest(eventsData)
+	if err != nil {
+		return err
 	}
 
-	err = nf.eventsHandler.HandlePushEvents(pushEvents)
+	vote := data.ObserverBlockVote{
+		ObserverID: observerID,
+		ShardID:    eventsData.Header.GetShardID(),
+		Nonce:      eventsData.Header.GetNonce(),
+		Hash:       eventsData.Hash,
+		Digest:     digest,
+	}
+	nf.observersQuorum.ProcessBlock(vote, func() {
+		nf.publishBlockEvents(ctx, eventsData)
+	})
+
+	return nil
+}
+
+// EnqueuePushEventsV2 adds the block pushed by an observer to the ingestion queue of its shard and waits for
+// it to be processed, so that the observer is answered only after the block was handled. An error is returned
+// if the queue is full, if the block could not be processed or if the request is canceled before. A canceled
+// request does not remove the block from the queue
+func (nf *notifierFacade) EnqueuePushEventsV2(ctx context.Context, allEvents data.ArgsSaveBlockData, observerID string) error {
+	if check.IfNil(allEvents.Header) {
+		return ErrNilBlockHeader
+	}
+	if observerID == "" && nf.config.Quorum.Enabled {
+		return ErrUnauthenticatedObserver
+	}
+
+	// the queued block is processed even if the request is canceled, so only the trace is kept
+	processCtx := tracing.ExtractContext(context.Background(), tracing.InjectContext(ctx))
+
+	processed := make(chan error, 1)
+	err := nf.ingestionQueue.Enqueue(allEvents.Header.GetShardID(), func() {
+		processed <- nf.HandlePushEventsV2(processCtx, allEvents, observerID)
+	})
 	if err != nil {
 		return err
 	}
 
+	select {
+	case err = <-processed:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// IsIngestionQueueEnabled returns true if the pushed blocks are processed through the ingestion queue of their shard
+func (nf *notifierFacade) IsIngestionQueueEnabled() bool {
+	return nf.config.IngestionQueue.Enabled
+}
+
+func (nf *notifierFacade) processBlockEvents(ctx context.Context, allEvents data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+	_, span := tracing.StartSpan(ctx, "eventsInterceptor.ProcessBlockEvents", hex.EncodeToString(allEvents.HeaderHash))
+	defer span.End()
+
+	startTime := time.Now()
+	eventsData, err := nf.eventsInterceptor.ProcessBlockEvents(&allEvents)
+	nf.statusMetrics.ObserveStage(common.InterceptorStage, time.Since(startTime))
+	if err != nil {
+		tracing.RecordError(span, err)
+		nf.statusMetrics.AddStageError(common.InterceptorStage)
+		return nil, err
+	}
+
+	return eventsData, nil
+}
+
+// computeBlockDigest returns the hash of the processed block data, which is identical
+// for all the observers which agree on the block
+func computeBlockDigest(eventsData *data.InterceptorBlockData) (string, error) {
+	marshalledData, err := json.Marshal(eventsData)
+	if err != nil {
+		return "", err
+	}
+
+	digest := sha256.Sum256(marshalledData)
+
+	return hex.EncodeToString(digest[:]), nil
+}
+
+func (nf *notifierFacade) publishBlockEvents(ctx context.Context, eventsData *data.InterceptorBlockData) {
+	traceContext := tracing.InjectContext(ctx)
+
+	pushEvents := data.BlockEvents{
+		Hash:         eventsData.Hash,
+		ShardID:      eventsData.Header.GetShardID(),
+		Nonce:        eventsData.Header.GetNonce(),
+		Round:        eventsData.Header.GetRound(),
+		Epoch:        eventsData.Header.GetEpoch(),
+		TimeStamp:    eventsData.Header.GetTimeStamp(),
+		Events:       eventsData.LogEvents,
+		TraceContext: traceContext,
+	}
+
+	err := nf.eventsHandler.HandlePushEvents(pushEvents)
+	if err != nil {
+		log.Error("failed to handle push events", "block hash", eventsData.Hash, "error", err.Error())
+		return
+	}
+
+	blockHeader := createBlockHeader(eventsData)
+	blockHeader.TraceContext = traceContext
+	nf.eventsHandler.HandleBlockHeader(blockHeader)
+
+	epochStart, isEpochStart := createEpochStart(eventsData)
+	if isEpochStart {
+		epochStart.TraceContext = traceContext
+		nf.eventsHandler.HandleEpochStart(epochStart)
+	}
+
 	txs := data.BlockTxs{
-		Hash: eventsData.Hash,
-		Txs:  eventsData.Txs,
+		Hash:         eventsData.Hash,
+		Txs:          eventsData.Txs,
+		TraceContext: traceContext,
 	}
 	nf.eventsHandler.HandleBlockTxs(txs)
 
 	scrs := data.BlockScrs{
-		Hash: eventsData.Hash,
-		Scrs: eventsData.Scrs,
+		Hash:         eventsData.Hash,
+		Scrs:         eventsData.Scrs,
+		TraceContext: traceContext,
 	}
 	nf.eventsHandler.HandleBlockScrs(scrs)
 
+	txBundles := data.BlockTxBundles{
+		Hash:         eventsData.Hash,
+		ShardID:      eventsData.Header.GetShardID(),
+		Nonce:        eventsData.Header.GetNonce(),
+		Round:        eventsData.Header.GetRound(),
+		Epoch:        eventsData.Header.GetEpoch(),
+		TimeStamp:    eventsData.Header.GetTimeStamp(),
+		Bundles:      eventsData.TxBundles,
+		TraceContext: traceContext,
+	}
+	nf.eventsHandler.HandleTxBundles(txBundles)
+	nf.txStatusTracker.ProcessTxBundles(txBundles)
+
+	rewards := data.BlockRewards{
+		Hash:         eventsData.Hash,
+		Rewards:      eventsData.Rewards,
+		TraceContext: traceContext,
+	}
+	nf.eventsHandler.HandleBlockRewards(rewards)
+
+	receipts := data.BlockReceipts{
+		Hash:         eventsData.Hash,
+		Receipts:     eventsData.Receipts,
+		TraceContext: traceContext,
+	}
+	nf.eventsHandler.HandleBlockReceipts(receipts)
+
+	invalidTxs := data.BlockInvalidTxs{
+		Hash:         eventsData.Hash,
+		InvalidTxs:   eventsData.InvalidTxs,
+		TraceContext: traceContext,
+	}
+	nf.eventsHandler.HandleBlockInvalidTxs(invalidTxs)
+
+	alteredAccounts := data.BlockAlteredAccounts{
+		Hash:            eventsData.Hash,
+		AlteredAccounts: eventsData.AlteredAccounts,
+		TraceContext:    traceContext,
+	}
+	nf.eventsHandler.HandleAlteredAccounts(alteredAccounts)
+
 	txsWithOrder := data.BlockEventsWithOrder{
-		Hash:      eventsData.Hash,
-		ShardID:   eventsData.Header.GetShardID(),
-		TimeStamp: eventsData.Header.GetTimeStamp(),
-		Txs:       eventsData.TxsWithOrder,
-		Scrs:      eventsData.ScrsWithOrder,
-		Events:    eventsData.LogEvents,
+		Hash:         eventsData.Hash,
+		ShardID:      eventsData.Header.GetShardID(),
+		Nonce:        eventsData.Header.GetNonce(),
+		Round:        eventsData.Header.GetRound(),
+		Epoch:        eventsData.Header.GetEpoch(),
+		TimeStamp:    eventsData.Header.GetTimeStamp(),
+		Txs:          eventsData.Txs,
+		Scrs:         eventsData.Scrs,
+		Events:       eventsData.LogEvents,
+		TraceContext: traceContext,
 	}
 	nf.eventsHandler.HandleBlockEventsWithOrder(txsWithOrder)
+}
 
-	return nil
+// createBlockHeader returns the header summary of the block. The notarized headers hashes
+// are set only for the metachain blocks
+func createBlockHeader(eventsData *data.InterceptorBlockData) data.BlockHeader {
+	header := eventsData.Header
+	blockHeader := data.BlockHeader{
+		Hash:            eventsData.Hash,
+		ShardID:         header.GetShardID(),
+		Nonce:           header.GetNonce(),
+		Round:           header.GetRound(),
+		Epoch:           header.GetEpoch(),
+		TimeStamp:       header.GetTimeStamp(),
+		PrevHash:        hex.EncodeToString(header.GetPrevHash()),
+		TxCount:         header.GetTxCount(),
+		AccumulatedFees: bigIntToString(header.GetAccumulatedFees()),
+		DeveloperFees:   bigIntToString(header.GetDeveloperFees()),
+		GasProvided:     eventsData.HeaderGasConsumption.GasProvided,
+		GasRefunded:     eventsData.HeaderGasConsumption.GasRefunded,
+		GasPenalized:    eventsData.HeaderGasConsumption.GasPenalized,
+		MaxGasPerBlock:  eventsData.HeaderGasConsumption.MaxGasPerBlock,
+	}
+	if header.GetShardID() == core.MetachainShardId {
+		blockHeader.NotarizedHeadersHashes = eventsData.NotarizedHeadersHashes
+	}
+
+	return blockHeader
+}
+
+// createEpochStart returns the epoch start data of the block, if the block is a metachain block which starts an epoch
+func createEpochStart(eventsData *data.InterceptorBlockData) (data.EpochStart, bool) {
+	metaBlock, ok := eventsData.Header.(*block.MetaBlock)
+	if !ok || !metaBlock.IsStartOfEpochBlock() {
+		return data.EpochStart{}, false
+	}
+
+	economics := metaBlock.EpochStart.Economics
+	lastFinalizedHeaders := make([]data.EpochStartShardHeader, 0, len(metaBlock.EpochStart.LastFinalizedHeaders))
+	for _, shardData := range metaBlock.EpochStart.LastFinalizedHeaders {
+		lastFinalizedHeaders = append(lastFinalizedHeaders, data.EpochStartShardHeader{
+			ShardID:               shardData.ShardID,
+			Epoch:                 shardData.Epoch,
+			Round:                 shardData.Round,
+			Nonce:                 shardData.Nonce,
+			HeaderHash:            hex.EncodeToString(shardData.HeaderHash),
+			RootHash:              hex.EncodeToString(shardData.RootHash),
+			FirstPendingMetaBlock: hex.EncodeToString(shardData.FirstPendingMetaBlock),
+			LastFinishedMetaBlock: hex.EncodeToString(shardData.LastFinishedMetaBlock),
+		})
+	}
+
+	return data.EpochStart{
+		Hash:      eventsData.Hash,
+		Epoch:     metaBlock.GetEpoch(),
+		Round:     metaBlock.GetRound(),
+		Nonce:     metaBlock.GetNonce(),
+		TimeStamp: metaBlock.GetTimeStamp(),
+		Economics: data.EpochStartEconomics{
+			TotalSupply:                      bigIntToString(economics.TotalSupply),
+			TotalToDistribute:                bigIntToString(economics.TotalToDistribute),
+			TotalNewlyMinted:                 bigIntToString(economics.TotalNewlyMinted),
+			RewardsPerBlock:                  bigIntToString(economics.RewardsPerBlock),
+			RewardsForProtocolSustainability: bigIntToString(economics.RewardsForProtocolSustainability),
+			NodePrice:                        bigIntToString(economics.NodePrice),
+			PrevEpochStartRound:              economics.PrevEpochStartRound,
+			PrevEpochStartHash:               hex.EncodeToString(economics.PrevEpochStartHash),
+		},
+		LastFinalizedHeaders: lastFinalizedHeaders,
+	}, true
+}
+
+func bigIntToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+
+	return value.String()
+}
+
+// getV1Txs returns the transactions of a v1 push, ordered by hash since they have no execution order
+func getV1Txs(txs map[string]*transaction.Transaction) []*data.NotifierTransaction {
+	hashes := make([]string, 0, len(txs))
+	for hash := range txs {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	notifierTxs := make([]*data.NotifierTransaction, 0, len(hashes))
+	for _, hash := range hashes {
+		notifierTxs = append(notifierTxs, &data.NotifierTransaction{
+			Hash:        hash,
+			Transaction: txs[hash],
+		})
+	}
+
+	return notifierTxs
+}
+
+// getV1Scrs returns the smart contract results of a v1 push, ordered by hash since they have no execution order
+func getV1Scrs(scrs map[string]*smartContractResult.SmartContractResult) []*data.NotifierSmartContractResult {
+	hashes := make([]string, 0, len(scrs))
+	for hash := range scrs {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+
+	notifierScrs := make([]*data.NotifierSmartContractResult, 0, len(hashes))
+	for _, hash := range hashes {
+		notifierScrs = append(notifierScrs, &data.NotifierSmartContractResult{
+			Hash:                hash,
+			SmartContractResult: scrs[hash],
+		})
+	}
+
+	return notifierScrs
 }
 
 // HandlePushEventsV1 will handle push events received from observer
@@ -123,13 +460,13 @@ func (nf *notifierFacade) HandlePushEventsV1(eventsData data.SaveBlockData) erro
 
 	txs := data.BlockTxs{
 		Hash: eventsData.Hash,
-		Txs:  eventsData.Txs,
+		Txs:  getV1Txs(eventsData.Txs),
 	}
 	nf.eventsHandler.HandleBlockTxs(txs)
 
 	scrs := data.BlockScrs{
 		Hash: eventsData.Hash,
-		Scrs: eventsData.Scrs,
+		Scrs: getV1Scrs(eventsData.Scrs),
 	}
 	nf.eventsHandler.HandleBlockScrs(scrs)
 
@@ -146,17 +483,146 @@ func (nf *notifierFacade) HandleFinalizedEvents(events data.FinalizedBlock) {
 	nf.eventsHandler.HandleFinalizedEvents(events)
 }
 
-// ServeHTTP will handle a websocket request
+// ServeHTTP will handle a websocket request. New connections are rejected while draining
 func (nf *notifierFacade) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if nf.getIsDraining() {
+		w.Header().Set("Retry-After", strconv.Itoa(common.DrainingRetryAfterInSec))
+		http.Error(w, common.ErrNotifierDraining.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
 	nf.wsHandler.ServeHTTP(w, r)
 }
 
+// BeginPush registers an in-flight push from an observer. It returns false if the notifier is
+// draining, in which case the push has to be rejected
+func (nf *notifierFacade) BeginPush() bool {
+	nf.mutDraining.Lock()
+	defer nf.mutDraining.Unlock()
+
+	if nf.isDraining {
+		return false
+	}
+	nf.pendingPushes.Add(1)
+
+	return true
+}
+
+// EndPush signals that an in-flight push has been handled
+func (nf *notifierFacade) EndPush() {
+	nf.pendingPushes.Done()
+}
+
+// Drain stops accepting pushes and websocket connections, then waits for the in-flight pushes
+// and for the queued blocks to be handled. An error is returned if the context is done before
+func (nf *notifierFacade) Drain(ctx context.Context) error {
+	nf.mutDraining.Lock()
+	nf.isDraining = true
+	nf.mutDraining.Unlock()
+
+	pushesDone := make(chan struct{})
+	go func() {
+		nf.pendingPushes.Wait()
+		close(pushesDone)
+	}()
+
+	select {
+	case <-pushesDone:
+	case <-ctx.Done():
+		return fmt.Errorf("%w: in-flight pushes not handled: %s", common.ErrUndeliveredData, ctx.Err().Error())
+	}
+
+	return nf.ingestionQueue.Drain(ctx)
+}
+
+func (nf *notifierFacade) getIsDraining() bool {
+	nf.mutDraining.Lock()
+	defer nf.mutDraining.Unlock()
+
+	return nf.isDraining
+}
+
 // GetConnectorUserAndPass will return username and password (for basic authentication)
 // from config
 func (nf *notifierFacade) GetConnectorUserAndPass() (string, string) {
 	return nf.config.Username, nf.config.Password
 }
 
+// GetAdminUserAndPass will return the username and password needed to access the admin endpoints
+func (nf *notifierFacade) GetAdminUserAndPass() (string, string) {
+	return nf.config.Admin.Username, nf.config.Admin.Password
+}
+
+// IsObserverCertificateRequired returns true if observers must present a verified
+// client certificate when pushing events
+func (nf *notifierFacade) IsObserverCertificateRequired() bool {
+	return nf.config.TLS.Enabled && nf.config.TLS.RequireObserverCertificate
+}
+
+// GetMaxBodySize returns the maximum size, in bytes, of a request pushed by an observer
+func (nf *notifierFacade) GetMaxBodySize() int64 {
+	maxBodySizeInMB := nf.config.MaxBodySizeInMB
+	if maxBodySizeInMB == 0 {
+		maxBodySizeInMB = defaultMaxBodySizeInMB
+	}
+
+	return int64(maxBodySizeInMB) * bytesInMB
+}
+
+// GetObserversAuthConfig returns the per-observer authentication configuration
+func (nf *notifierFacade) GetObserversAuthConfig() config.ObserversAuthConfig {
+	return nf.config.ObserversAuth
+}
+
+// AddObserverRequest counts an authenticated request from the provided observer
+func (nf *notifierFacade) AddObserverRequest(observerID string) {
+	nf.statusMetrics.AddCounter(getObserverOpID(observerID), 1)
+}
+
+// ObserveStage records the duration of the provided pipeline stage
+func (nf *notifierFacade) ObserveStage(stage string, duration time.Duration) {
+	nf.statusMetrics.ObserveStage(stage, duration)
+}
+
+// AddStageError counts an error of the provided pipeline stage
+func (nf *notifierFacade) AddStageError(stage string) {
+	nf.statusMetrics.AddStageError(stage)
+}
+
+// RecordObserverError records a failed push from the provided observer
+func (nf *notifierFacade) RecordObserverError(observerID string) {
+	nf.observersTracker.RecordError(getTrackedObserverID(observerID))
+}
+
+func getTrackedObserverID(observerID string) string {
+	if observerID == "" {
+		return unauthenticatedObserverID
+	}
+
+	return observerID
+}
+
+// GetObserversHealth will return the push statistics of the observers and shards
+func (nf *notifierFacade) GetObserversHealth() data.ObserversHealth {
+	return nf.observersTracker.GetObserversHealth()
+}
+
+// GetHealth will return the liveness status together with the status of each component
+func (nf *notifierFacade) GetHealth() data.HealthStatus {
+	return nf.healthService.GetHealth()
+}
+
+// GetReadiness will return the readiness status together with the status of each component
+// While draining, the notifier is not ready, whatever the status of the components
+func (nf *notifierFacade) GetReadiness() data.HealthStatus {
+	readiness := nf.healthService.GetReadiness()
+	if nf.getIsDraining() {
+		readiness.Status = common.HealthStatusDraining
+	}
+
+	return readiness
+}
+
 // GetMetrics will return metrics in json format
 func (nf *notifierFacade) GetMetrics() map[string]*data.EndpointMetricsResponse {
 	return nf.statusMetrics.GetAll()
@@ -164,7 +630,26 @@ func (nf *notifierFacade) GetMetrics() map[string]*data.EndpointMetricsResponse
 
 // GetMetricsForPrometheus will return metrics in prometheus format
 func (nf *notifierFacade) GetMetricsForPrometheus() string {
-	return nf.statusMetrics.GetMetricsForPrometheus()
+	return nf.statusMetrics.GetMetricsForPrometheus() + nf.observersTracker.GetMetricsForPrometheus()
+}
+
+// GetDispatchers will return the connected websocket dispatchers, together with their subscriptions
+func (nf *notifierFacade) GetDispatchers() []data.DispatcherInfo {
+	return nf.hub.GetDispatchers()
+}
+
+// DisconnectDispatcher will force the disconnection of the provided websocket dispatcher
+func (nf *notifierFacade) DisconnectDispatcher(dispatcherID uuid.UUID) error {
+	return nf.hub.DisconnectDispatcher(dispatcherID)
+}
+
+// GetSubscriptionsStats will return the subscriptions aggregated by event type and by match level
+func (nf *notifierFacade) GetSubscriptionsStats() data.SubscriptionsStats {
+	return nf.hub.GetSubscriptionsStats()
+}
+
+func getObserverOpID(observerID string) string {
+	return fmt.Sprintf("%s-%s", observerMetricPrefix, observerID)
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -1,13 +1,23 @@
 package facade_test
 
 import (
+	"context"
+	"encoding/hex"
 	"errors"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-notifier-go/common"
@@ -26,6 +36,12 @@ func createMockFacadeArgs() facade.ArgsNotifierFacade {
 		WSHandler:            &mocks.WSHandlerStub{},
 		EventsInterceptor:    &mocks.EventsInterceptorStub{},
 		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+		ObserversQuorum:      &mocks.ObserversQuorumStub{},
+		ObserversTracker:     &mocks.ObserversTrackerStub{},
+		HealthService:        &mocks.HealthServiceStub{},
+		Hub:                  &mocks.HubStub{},
+		IngestionQueue:       &mocks.IngestionQueueStub{},
+		TxStatusTracker:      &mocks.TxStatusTrackerStub{},
 	}
 }
 
@@ -76,6 +92,72 @@ func TestNewNotifierFacade(t *testing.T) {
 		require.Equal(t, common.ErrNilStatusMetricsHandler, err)
 	})
 
+	t.Run("nil observers quorum", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.ObserversQuorum = nil
+
+		f, err := facade.NewNotifierFacade(args)
+		require.True(t, check.IfNil(f))
+		require.Equal(t, facade.ErrNilObserversQuorum, err)
+	})
+
+	t.Run("nil observers tracker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.ObserversTracker = nil
+
+		f, err := facade.NewNotifierFacade(args)
+		require.True(t, check.IfNil(f))
+		require.Equal(t, facade.ErrNilObserversTracker, err)
+	})
+
+	t.Run("nil health service", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.HealthService = nil
+
+		f, err := facade.NewNotifierFacade(args)
+		require.True(t, check.IfNil(f))
+		require.Equal(t, facade.ErrNilHealthService, err)
+	})
+
+	t.Run("nil hub", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.Hub = nil
+
+		f, err := facade.NewNotifierFacade(args)
+		require.True(t, check.IfNil(f))
+		require.Equal(t, facade.ErrNilHub, err)
+	})
+
+	t.Run("nil ingestion queue", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.IngestionQueue = nil
+
+		f, err := facade.NewNotifierFacade(args)
+		require.True(t, check.IfNil(f))
+		require.Equal(t, facade.ErrNilIngestionQueue, err)
+	})
+
+	t.Run("nil tx status tracker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.TxStatusTracker = nil
+
+		f, err := facade.NewNotifierFacade(args)
+		require.True(t, check.IfNil(f))
+		require.Equal(t, facade.ErrNilTxStatusTracker, err)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -100,6 +182,16 @@ func TestHandlePushEvents(t *testing.T) {
 				return nil, expectedErr
 			},
 		}
+		observedStage := ""
+		failedStage := ""
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			ObserveStageCalled: func(stage string, duration time.Duration) {
+				observedStage = stage
+			},
+			AddStageErrorCalled: func(stage string) {
+				failedStage = stage
+			},
+		}
 
 		facade, err := facade.NewNotifierFacade(args)
 		require.Nil(t, err)
@@ -108,8 +200,164 @@ func TestHandlePushEvents(t *testing.T) {
 			HeaderHash: []byte("blockHash"),
 			Header:     &block.HeaderV2{},
 		}
-		err = facade.HandlePushEventsV2(blockData)
+		err = facade.HandlePushEventsV2(context.Background(), blockData, "observer")
 		require.Equal(t, expectedErr, err)
+		require.Equal(t, common.InterceptorStage, observedStage)
+		require.Equal(t, common.InterceptorStage, failedStage)
+	})
+
+	t.Run("empty block hash, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return &data.InterceptorBlockData{
+					Header: &block.HeaderV2{},
+				}, nil
+			},
+		}
+		args.ObserversQuorum = &mocks.ObserversQuorumStub{
+			ProcessBlockCalled: func(vote data.ObserverBlockVote, publishHandler func()) {
+				assert.Fail(t, "should not have been called")
+			},
+		}
+
+		facade, err := facade.NewNotifierFacade(args)
+		require.Nil(t, err)
+
+		err = facade.HandlePushEventsV2(context.Background(), data.ArgsSaveBlockData{}, "observer")
+		require.Equal(t, common.ErrReceivedEmptyEvents, err)
+	})
+
+	t.Run("should record the push in observers tracker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return &data.InterceptorBlockData{
+					Hash:   "blockHash1",
+					Header: &block.HeaderV2{Header: &block.Header{ShardID: 2, Nonce: 37}},
+				}, nil
+			},
+		}
+		wasCalled := false
+		args.ObserversTracker = &mocks.ObserversTrackerStub{
+			RecordPushCalled: func(observerID string, shardID uint32, nonce uint64) {
+				wasCalled = true
+				assert.Equal(t, "observer0", observerID)
+				assert.Equal(t, uint32(2), shardID)
+				assert.Equal(t, uint64(37), nonce)
+			},
+		}
+
+		facade, err := facade.NewNotifierFacade(args)
+		require.Nil(t, err)
+
+		err = facade.HandlePushEventsV2(context.Background(), data.ArgsSaveBlockData{}, "observer0")
+		require.Nil(t, err)
+		require.True(t, wasCalled)
+	})
+
+	t.Run("unauthenticated observer with quorum enabled, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.APIConfig.Quorum.Enabled = true
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				assert.Fail(t, "should not have been called")
+				return nil, nil
+			},
+		}
+		args.ObserversQuorum = &mocks.ObserversQuorumStub{
+			ProcessBlockCalled: func(vote data.ObserverBlockVote, publishHandler func()) {
+				assert.Fail(t, "should not have been called")
+			},
+		}
+
+		f, err := facade.NewNotifierFacade(args)
+		require.Nil(t, err)
+
+		err = f.HandlePushEventsV2(context.Background(), data.ArgsSaveBlockData{}, "")
+		require.Equal(t, facade.ErrUnauthenticatedObserver, err)
+	})
+
+	t.Run("unauthenticated observer should be recorded as unauthenticated", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return &data.InterceptorBlockData{
+					Hash:   "blockHash1",
+					Header: &block.HeaderV2{Header: &block.Header{ShardID: 2, Nonce: 37}},
+				}, nil
+			},
+		}
+		recordedObserverID := ""
+		args.ObserversTracker = &mocks.ObserversTrackerStub{
+			RecordPushCalled: func(observerID string, shardID uint32, nonce uint64) {
+				recordedObserverID = observerID
+			},
+		}
+
+		f, err := facade.NewNotifierFacade(args)
+		require.Nil(t, err)
+
+		err = f.HandlePushEventsV2(context.Background(), data.ArgsSaveBlockData{}, "")
+		require.Nil(t, err)
+		require.Equal(t, "unauthenticated", recordedObserverID)
+	})
+
+	t.Run("should publish only when allowed by observers quorum", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return &data.InterceptorBlockData{
+					Hash:   "blockHash1",
+					Header: &block.HeaderV2{Header: &block.Header{ShardID: 2}},
+				}, nil
+			},
+		}
+		pushWasCalled := false
+		args.EventsHandler = &mocks.EventsHandlerStub{
+			HandlePushEventsCalled: func(events data.BlockEvents) error {
+				pushWasCalled = true
+				return nil
+			},
+		}
+		var handler func()
+		var votes []data.ObserverBlockVote
+		args.ObserversQuorum = &mocks.ObserversQuorumStub{
+			ProcessBlockCalled: func(vote data.ObserverBlockVote, publishHandler func()) {
+				votes = append(votes, vote)
+				handler = publishHandler
+			},
+		}
+
+		facade, err := facade.NewNotifierFacade(args)
+		require.Nil(t, err)
+
+		err = facade.HandlePushEventsV2(context.Background(), data.ArgsSaveBlockData{}, "observer0")
+		require.Nil(t, err)
+		err = facade.HandlePushEventsV2(context.Background(), data.ArgsSaveBlockData{}, "observer1")
+		require.Nil(t, err)
+		require.False(t, pushWasCalled)
+
+		require.Equal(t, 2, len(votes))
+		assert.Equal(t, "observer0", votes[0].ObserverID)
+		assert.Equal(t, "observer1", votes[1].ObserverID)
+		assert.Equal(t, uint32(2), votes[0].ShardID)
+		assert.Equal(t, "blockHash1", votes[0].Hash)
+		assert.NotEmpty(t, votes[0].Digest)
+		assert.Equal(t, votes[0].Digest, votes[1].Digest)
+
+		handler()
+		require.True(t, pushWasCalled)
 	})
 
 	t.Run("should work", func(t *testing.T) {
@@ -151,7 +399,14 @@ func TestHandlePushEvents(t *testing.T) {
 
 		header := &block.HeaderV2{
 			Header: &block.Header{
-				ShardID: 2,
+				ShardID:         2,
+				Nonce:           10,
+				Round:           11,
+				Epoch:           3,
+				TimeStamp:       1234,
+				PrevHash:        []byte("prevHash"),
+				TxCount:         1,
+				AccumulatedFees: big.NewInt(100),
 			},
 		}
 		blockData := data.ArgsSaveBlockData{
@@ -164,14 +419,22 @@ func TestHandlePushEvents(t *testing.T) {
 			Header: &block.HeaderV2{},
 		}
 
-		expTxs := map[string]*transaction.Transaction{
-			"hash1": {
-				Nonce: 1,
+		expTxs := []*data.NotifierTransaction{
+			{
+				Hash: "hash1",
+				Transaction: &transaction.Transaction{
+					Nonce: 1,
+				},
+				ExecutionOrder: 1,
 			},
 		}
-		expScrs := map[string]*smartContractResult.SmartContractResult{
-			"hash2": {
-				Nonce: 2,
+		expScrs := []*data.NotifierSmartContractResult{
+			{
+				Hash: "hash2",
+				SmartContractResult: &smartContractResult.SmartContractResult{
+					Nonce: 2,
+				},
+				ExecutionOrder: 2,
 			},
 		}
 
@@ -184,37 +447,108 @@ func TestHandlePushEvents(t *testing.T) {
 			Scrs: expScrs,
 		}
 		expLogEvents := data.BlockEvents{
-			Hash:    blockHash,
-			Events:  logEvents,
-			ShardID: 2,
+			Hash:      blockHash,
+			Events:    logEvents,
+			ShardID:   2,
+			Nonce:     10,
+			Round:     11,
+			Epoch:     3,
+			TimeStamp: 1234,
 		}
-
-		expTxsWithOrder := map[string]*data.NotifierTransaction{
-			"hash1": {
-				Transaction: &transaction.Transaction{
-					Nonce: 1,
+		expTxBundlesData := data.BlockTxBundles{
+			Hash:      blockHash,
+			ShardID:   2,
+			Nonce:     10,
+			Round:     11,
+			Epoch:     3,
+			TimeStamp: 1234,
+			Bundles: []*data.TxBundle{
+				{
+					TxHash: "hash1",
+					Status: common.TxStatusSuccess,
 				},
-				ExecutionOrder: 1,
 			},
 		}
-		expScrsWithOrder := map[string]*data.NotifierSmartContractResult{
-			"hash2": {
-				SmartContractResult: &smartContractResult.SmartContractResult{
-					Nonce: 2,
+		expBlockHeader := data.BlockHeader{
+			Hash:            blockHash,
+			ShardID:         2,
+			Nonce:           10,
+			Round:           11,
+			Epoch:           3,
+			TimeStamp:       1234,
+			PrevHash:        hex.EncodeToString([]byte("prevHash")),
+			TxCount:         1,
+			AccumulatedFees: "100",
+			DeveloperFees:   "0",
+			GasProvided:     1000,
+			GasRefunded:     100,
+			MaxGasPerBlock:  1500000000,
+		}
+		expRewardsData := data.BlockRewards{
+			Hash: blockHash,
+			Rewards: []*data.NotifierRewardTx{
+				{
+					Hash: "hash3",
+					RewardTx: &rewardTx.RewardTx{
+						Round: 3,
+					},
+				},
+			},
+		}
+		expReceiptsData := data.BlockReceipts{
+			Hash: blockHash,
+			Receipts: []*data.NotifierReceipt{
+				{
+					Hash: "hash4",
+					Receipt: &receipt.Receipt{
+						TxHash: []byte("hash1"),
+					},
+				},
+			},
+		}
+		expInvalidTxsData := data.BlockInvalidTxs{
+			Hash: blockHash,
+			InvalidTxs: []*data.NotifierTransaction{
+				{
+					Hash: "hash5",
+					Transaction: &transaction.Transaction{
+						Nonce: 5,
+					},
 				},
 			},
 		}
+		expAlteredAccountsData := data.BlockAlteredAccounts{
+			Hash: blockHash,
+			AlteredAccounts: map[string]*alteredAccount.AlteredAccount{
+				"erd1addr": {
+					Address: "erd1addr",
+					Balance: "10",
+				},
+			},
+		}
+
 		expTxsWithOrderData := data.BlockEventsWithOrder{
-			Hash:    blockHash,
-			ShardID: 2,
-			Txs:     expTxsWithOrder,
-			Scrs:    expScrsWithOrder,
-			Events:  logEvents,
+			Hash:      blockHash,
+			ShardID:   2,
+			Nonce:     10,
+			Round:     11,
+			Epoch:     3,
+			TimeStamp: 1234,
+			Txs:       expTxs,
+			Scrs:      expScrs,
+			Events:    logEvents,
 		}
 
 		pushWasCalled := false
 		txsWasCalled := false
 		scrsWasCalled := false
+		rewardsWasCalled := false
+		receiptsWasCalled := false
+		invalidTxsWasCalled := false
+		alteredAccountsWasCalled := false
+		blockHeaderWasCalled := false
+		txBundlesWasCalled := false
+		txStatusTrackerWasCalled := false
 		blockEventsWithOrderWasCalled := false
 		args.EventsHandler = &mocks.EventsHandlerStub{
 			HandlePushEventsCalled: func(events data.BlockEvents) error {
@@ -230,21 +564,60 @@ func TestHandlePushEvents(t *testing.T) {
 				scrsWasCalled = true
 				assert.Equal(t, expScrsData, blockScrs)
 			},
+			HandleBlockRewardsCalled: func(blockRewards data.BlockRewards) {
+				rewardsWasCalled = true
+				assert.Equal(t, expRewardsData, blockRewards)
+			},
+			HandleBlockReceiptsCalled: func(blockReceipts data.BlockReceipts) {
+				receiptsWasCalled = true
+				assert.Equal(t, expReceiptsData, blockReceipts)
+			},
+			HandleBlockInvalidTxsCalled: func(blockInvalidTxs data.BlockInvalidTxs) {
+				invalidTxsWasCalled = true
+				assert.Equal(t, expInvalidTxsData, blockInvalidTxs)
+			},
+			HandleAlteredAccountsCalled: func(blockAlteredAccounts data.BlockAlteredAccounts) {
+				alteredAccountsWasCalled = true
+				assert.Equal(t, expAlteredAccountsData, blockAlteredAccounts)
+			},
+			HandleBlockHeaderCalled: func(blockHeader data.BlockHeader) {
+				blockHeaderWasCalled = true
+				assert.Equal(t, expBlockHeader, blockHeader)
+			},
+			HandleTxBundlesCalled: func(blockTxBundles data.BlockTxBundles) {
+				txBundlesWasCalled = true
+				assert.Equal(t, expTxBundlesData, blockTxBundles)
+			},
 			HandleBlockEventsWithOrderCalled: func(blockTxs data.BlockEventsWithOrder) {
 				blockEventsWithOrderWasCalled = true
 				assert.Equal(t, expTxsWithOrderData, blockTxs)
 			},
 		}
+		args.TxStatusTracker = &mocks.TxStatusTrackerStub{
+			ProcessTxBundlesCalled: func(blockTxBundles data.BlockTxBundles) {
+				txStatusTrackerWasCalled = true
+				assert.Equal(t, expTxBundlesData, blockTxBundles)
+			},
+		}
 		args.EventsInterceptor = &mocks.EventsInterceptorStub{
 			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
 				return &data.InterceptorBlockData{
-					Hash:          blockHash,
-					Header:        header,
-					Txs:           expTxs,
-					Scrs:          expScrs,
-					LogEvents:     logEvents,
-					TxsWithOrder:  expTxsWithOrder,
-					ScrsWithOrder: expScrsWithOrder,
+					Hash:            blockHash,
+					Header:          header,
+					Txs:             expTxs,
+					Scrs:            expScrs,
+					Rewards:         expRewardsData.Rewards,
+					Receipts:        expReceiptsData.Receipts,
+					InvalidTxs:      expInvalidTxsData.InvalidTxs,
+					AlteredAccounts: expAlteredAccountsData.AlteredAccounts,
+					HeaderGasConsumption: outport.HeaderGasConsumption{
+						GasProvided:    1000,
+						GasRefunded:    100,
+						MaxGasPerBlock: 1500000000,
+					},
+					NotarizedHeadersHashes: []string{"shardHeaderHash"},
+					LogEvents:              logEvents,
+					TxBundles:              expTxBundlesData.Bundles,
 				}, nil
 			},
 		}
@@ -252,13 +625,319 @@ func TestHandlePushEvents(t *testing.T) {
 		facade, err := facade.NewNotifierFacade(args)
 		require.Nil(t, err)
 
-		facade.HandlePushEventsV2(blockData)
+		facade.HandlePushEventsV2(context.Background(), blockData, "observer")
 
 		assert.True(t, pushWasCalled)
 		assert.True(t, txsWasCalled)
 		assert.True(t, scrsWasCalled)
+		assert.True(t, rewardsWasCalled)
+		assert.True(t, receiptsWasCalled)
+		assert.True(t, invalidTxsWasCalled)
+		assert.True(t, alteredAccountsWasCalled)
+		assert.True(t, blockHeaderWasCalled)
+		assert.True(t, txBundlesWasCalled)
+		assert.True(t, txStatusTrackerWasCalled)
 		assert.True(t, blockEventsWithOrderWasCalled)
 	})
+
+	t.Run("metachain block, should publish notarized headers hashes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+
+		notarizedHeadersHashes := []string{"shardHeaderHash1", "shardHeaderHash2"}
+		blockHeaderWasCalled := false
+		args.EventsHandler = &mocks.EventsHandlerStub{
+			HandleBlockHeaderCalled: func(blockHeader data.BlockHeader) {
+				blockHeaderWasCalled = true
+				assert.Equal(t, core.MetachainShardId, blockHeader.ShardID)
+				assert.Equal(t, uint64(7), blockHeader.Nonce)
+				assert.Equal(t, notarizedHeadersHashes, blockHeader.NotarizedHeadersHashes)
+			},
+			HandleEpochStartCalled: func(epochStart data.EpochStart) {
+				assert.Fail(t, "should not have been called")
+			},
+		}
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return &data.InterceptorBlockData{
+					Hash: "metaBlockHash",
+					Header: &block.MetaBlock{
+						Nonce: 7,
+					},
+					NotarizedHeadersHashes: notarizedHeadersHashes,
+				}, nil
+			},
+		}
+
+		facade, err := facade.NewNotifierFacade(args)
+		require.Nil(t, err)
+
+		blockData := data.ArgsSaveBlockData{
+			HeaderHash:       []byte("metaBlockHash"),
+			TransactionsPool: &data.TransactionsPool{},
+			Header:           &block.MetaBlock{},
+		}
+		facade.HandlePushEventsV2(context.Background(), blockData, "observer")
+
+		assert.True(t, blockHeaderWasCalled)
+	})
+
+	t.Run("epoch start metachain block, should publish epoch start", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+
+		metaBlock := &block.MetaBlock{
+			Nonce:     100,
+			Round:     101,
+			Epoch:     4,
+			TimeStamp: 1234,
+			EpochStart: block.EpochStart{
+				LastFinalizedHeaders: []block.EpochStartShardData{
+					{
+						ShardID:    1,
+						Epoch:      3,
+						Round:      99,
+						Nonce:      98,
+						HeaderHash: []byte("shardHeaderHash"),
+						RootHash:   []byte("rootHash"),
+					},
+				},
+				Economics: block.Economics{
+					TotalSupply:         big.NewInt(1000),
+					RewardsPerBlock:     big.NewInt(10),
+					NodePrice:           big.NewInt(2500),
+					PrevEpochStartRound: 50,
+					PrevEpochStartHash:  []byte("prevEpochStartHash"),
+				},
+			},
+		}
+		expEpochStart := data.EpochStart{
+			Hash:      "metaBlockHash",
+			Epoch:     4,
+			Round:     101,
+			Nonce:     100,
+			TimeStamp: 1234,
+			Economics: data.EpochStartEconomics{
+				TotalSupply:                      "1000",
+				TotalToDistribute:                "0",
+				TotalNewlyMinted:                 "0",
+				RewardsPerBlock:                  "10",
+				RewardsForProtocolSustainability: "0",
+				NodePrice:                        "2500",
+				PrevEpochStartRound:              50,
+				PrevEpochStartHash:               hex.EncodeToString([]byte("prevEpochStartHash")),
+			},
+			LastFinalizedHeaders: []data.EpochStartShardHeader{
+				{
+					ShardID:    1,
+					Epoch:      3,
+					Round:      99,
+					Nonce:      98,
+					HeaderHash: hex.EncodeToString([]byte("shardHeaderHash")),
+					RootHash:   hex.EncodeToString([]byte("rootHash")),
+				},
+			},
+		}
+
+		epochStartWasCalled := false
+		args.EventsHandler = &mocks.EventsHandlerStub{
+			HandleEpochStartCalled: func(epochStart data.EpochStart) {
+				epochStartWasCalled = true
+				assert.Equal(t, expEpochStart, epochStart)
+			},
+		}
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return &data.InterceptorBlockData{
+					Hash:   "metaBlockHash",
+					Header: metaBlock,
+				}, nil
+			},
+		}
+
+		facade, err := facade.NewNotifierFacade(args)
+		require.Nil(t, err)
+
+		blockData := data.ArgsSaveBlockData{
+			HeaderHash:       []byte("metaBlockHash"),
+			TransactionsPool: &data.TransactionsPool{},
+			Header:           metaBlock,
+		}
+		facade.HandlePushEventsV2(context.Background(), blockData, "observer")
+
+		assert.True(t, epochStartWasCalled)
+	})
+}
+
+func TestEnqueuePushEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil header, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.IngestionQueue = &mocks.IngestionQueueStub{
+			EnqueueCalled: func(shardID uint32, processHandler func()) error {
+				assert.Fail(t, "should not have been called")
+				return nil
+			},
+		}
+		f, err := facade.NewNotifierFacade(args)
+		require.Nil(t, err)
+
+		err = f.EnqueuePushEventsV2(context.Background(), data.ArgsSaveBlockData{}, "observer")
+		require.Equal(t, facade.ErrNilBlockHeader, err)
+	})
+
+	t.Run("unauthenticated observer with quorum enabled, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.APIConfig.Quorum.Enabled = true
+		args.IngestionQueue = &mocks.IngestionQueueStub{
+			EnqueueCalled: func(shardID uint32, processHandler func()) error {
+				assert.Fail(t, "should not have been called")
+				return nil
+			},
+		}
+		f, err := facade.NewNotifierFacade(args)
+		require.Nil(t, err)
+
+		blockData := data.ArgsSaveBlockData{
+			HeaderHash: []byte("blockHash"),
+			Header:     &block.HeaderV2{},
+		}
+		err = f.EnqueuePushEventsV2(context.Background(), blockData, "")
+		require.Equal(t, facade.ErrUnauthenticatedObserver, err)
+	})
+
+	t.Run("full queue, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.IngestionQueue = &mocks.IngestionQueueStub{
+			EnqueueCalled: func(shardID uint32, processHandler func()) error {
+				return common.ErrIngestionQueueFull
+			},
+		}
+		f, err := facade.NewNotifierFacade(args)
+		require.Nil(t, err)
+
+		blockData := data.ArgsSaveBlockData{
+			HeaderHash: []byte("blockHash"),
+			Header:     &block.HeaderV2{},
+		}
+		err = f.EnqueuePushEventsV2(context.Background(), blockData, "observer")
+		require.Equal(t, common.ErrIngestionQueueFull, err)
+	})
+
+	t.Run("should wait for the queued block of the shard to be processed", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.IngestionQueue = &mocks.IngestionQueueStub{
+			EnqueueCalled: func(shardID uint32, processHandler func()) error {
+				assert.Equal(t, uint32(2), shardID)
+				go processHandler()
+				return nil
+			},
+		}
+		expectedErr := errors.New("expected error")
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				return nil, expectedErr
+			},
+		}
+		f, err := facade.NewNotifierFacade(args)
+		require.Nil(t, err)
+
+		blockData := data.ArgsSaveBlockData{
+			HeaderHash: []byte("blockHash"),
+			Header:     &block.HeaderV2{Header: &block.Header{ShardID: 2}},
+		}
+		err = f.EnqueuePushEventsV2(context.Background(), blockData, "observer")
+		require.Equal(t, expectedErr, err)
+	})
+
+	t.Run("canceled request should not wait for the queued block", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		var queuedHandler func()
+		args.IngestionQueue = &mocks.IngestionQueueStub{
+			EnqueueCalled: func(shardID uint32, processHandler func()) error {
+				queuedHandler = processHandler
+				return nil
+			},
+		}
+		wasProcessed := false
+		args.EventsInterceptor = &mocks.EventsInterceptorStub{
+			ProcessBlockEventsCalled: func(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error) {
+				wasProcessed = true
+				return nil, errors.New("expected error")
+			},
+		}
+		f, err := facade.NewNotifierFacade(args)
+		require.Nil(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		blockData := data.ArgsSaveBlockData{
+			HeaderHash: []byte("blockHash"),
+			Header:     &block.HeaderV2{Header: &block.Header{ShardID: 2}},
+		}
+		err = f.EnqueuePushEventsV2(ctx, blockData, "observer")
+		require.Equal(t, context.Canceled, err)
+		require.False(t, wasProcessed)
+
+		require.NotPanics(t, queuedHandler)
+		require.True(t, wasProcessed)
+	})
+}
+
+func TestHandlePushEventsV1(t *testing.T) {
+	t.Parallel()
+
+	args := createMockFacadeArgs()
+
+	txsWasCalled := false
+	scrsWasCalled := false
+	args.EventsHandler = &mocks.EventsHandlerStub{
+		HandleBlockTxsCalled: func(blockTxs data.BlockTxs) {
+			txsWasCalled = true
+			require.Len(t, blockTxs.Txs, 2)
+			assert.Equal(t, "txHash1", blockTxs.Txs[0].Hash)
+			assert.Equal(t, uint64(1), blockTxs.Txs[0].Nonce)
+			assert.Equal(t, "txHash2", blockTxs.Txs[1].Hash)
+			assert.Equal(t, uint64(2), blockTxs.Txs[1].Nonce)
+		},
+		HandleBlockScrsCalled: func(blockScrs data.BlockScrs) {
+			scrsWasCalled = true
+			require.Len(t, blockScrs.Scrs, 1)
+			assert.Equal(t, "scrHash1", blockScrs.Scrs[0].Hash)
+		},
+	}
+
+	facade, err := facade.NewNotifierFacade(args)
+	require.Nil(t, err)
+
+	err = facade.HandlePushEventsV1(data.SaveBlockData{
+		Hash: "blockHash",
+		Txs: map[string]*transaction.Transaction{
+			"txHash2": {Nonce: 2},
+			"txHash1": {Nonce: 1},
+		},
+		Scrs: map[string]*smartContractResult.SmartContractResult{
+			"scrHash1": {Nonce: 3},
+		},
+	})
+	require.Nil(t, err)
+
+	assert.True(t, txsWasCalled)
+	assert.True(t, scrsWasCalled)
 }
 
 func TestHandleRevertEvents(t *testing.T) {
@@ -346,3 +1025,217 @@ func TestGetters(t *testing.T) {
 	assert.Equal(t, expuser, user)
 	assert.Equal(t, exppass, pass)
 }
+
+func TestIsObserverCertificateRequired(t *testing.T) {
+	t.Parallel()
+
+	args := createMockFacadeArgs()
+	args.APIConfig.TLS.RequireObserverCertificate = true
+
+	f, err := facade.NewNotifierFacade(args)
+	require.Nil(t, err)
+	assert.False(t, f.IsObserverCertificateRequired())
+
+	args.APIConfig.TLS.Enabled = true
+	f, err = facade.NewNotifierFacade(args)
+	require.Nil(t, err)
+	assert.True(t, f.IsObserverCertificateRequired())
+}
+
+func TestGetMaxBodySize(t *testing.T) {
+	t.Parallel()
+
+	args := createMockFacadeArgs()
+	f, err := facade.NewNotifierFacade(args)
+	require.Nil(t, err)
+	assert.Equal(t, int64(128*1024*1024), f.GetMaxBodySize())
+
+	args.APIConfig.MaxBodySizeInMB = 2
+	f, err = facade.NewNotifierFacade(args)
+	require.Nil(t, err)
+	assert.Equal(t, int64(2*1024*1024), f.GetMaxBodySize())
+}
+
+func TestAddObserverRequest(t *testing.T) {
+	t.Parallel()
+
+	args := createMockFacadeArgs()
+	wasCalled := false
+	args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+		AddCounterCalled: func(operation string, value uint64) {
+			wasCalled = true
+			assert.Equal(t, "Observer-observer-0", operation)
+			assert.Equal(t, uint64(1), value)
+		},
+	}
+
+	f, err := facade.NewNotifierFacade(args)
+	require.Nil(t, err)
+
+	f.AddObserverRequest("observer-0")
+	assert.True(t, wasCalled)
+}
+
+func TestPipelineStageMetrics(t *testing.T) {
+	t.Parallel()
+
+	args := createMockFacadeArgs()
+	observedStage := ""
+	failedStage := ""
+	args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+		ObserveStageCalled: func(stage string, duration time.Duration) {
+			observedStage = stage
+			assert.Equal(t, time.Second, duration)
+		},
+		AddStageErrorCalled: func(stage string) {
+			failedStage = stage
+		},
+	}
+
+	f, err := facade.NewNotifierFacade(args)
+	require.Nil(t, err)
+
+	f.ObserveStage(common.UnmarshalStage, time.Second)
+	f.AddStageError(common.DedupStage)
+	assert.Equal(t, common.UnmarshalStage, observedStage)
+	assert.Equal(t, common.DedupStage, failedStage)
+}
+
+func TestObserversHealth(t *testing.T) {
+	t.Parallel()
+
+	recordedErrors := make([]string, 0)
+	expectedHealth := data.ObserversHealth{
+		Shards: []data.ShardHealth{{ShardID: 2}},
+	}
+	args := createMockFacadeArgs()
+	args.ObserversTracker = &mocks.ObserversTrackerStub{
+		RecordErrorCalled: func(observerID string) {
+			recordedErrors = append(recordedErrors, observerID)
+		},
+		GetObserversHealthCalled: func() data.ObserversHealth {
+			return expectedHealth
+		},
+		GetMetricsForPrometheusCalled: func() string {
+			return "tracker metrics\n"
+		},
+	}
+	args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+		GetMetricsForPrometheusCalled: func() string {
+			return "status metrics\n"
+		},
+	}
+
+	f, err := facade.NewNotifierFacade(args)
+	require.Nil(t, err)
+
+	f.RecordObserverError("observer-0")
+	assert.Equal(t, []string{"observer-0"}, recordedErrors)
+	assert.Equal(t, expectedHealth, f.GetObserversHealth())
+	assert.Equal(t, "status metrics\ntracker metrics\n", f.GetMetricsForPrometheus())
+}
+
+func TestHealthAndReadiness(t *testing.T) {
+	t.Parallel()
+
+	expectedHealth := data.HealthStatus{Status: common.HealthStatusDegraded}
+	expectedReadiness := data.HealthStatus{Status: common.HealthStatusDown}
+	args := createMockFacadeArgs()
+	args.HealthService = &mocks.HealthServiceStub{
+		GetHealthCalled: func() data.HealthStatus {
+			return expectedHealth
+		},
+		GetReadinessCalled: func() data.HealthStatus {
+			return expectedReadiness
+		},
+	}
+
+	f, err := facade.NewNotifierFacade(args)
+	require.Nil(t, err)
+
+	assert.Equal(t, expectedHealth, f.GetHealth())
+	assert.Equal(t, expectedReadiness, f.GetReadiness())
+}
+
+func TestDrain(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should reject new pushes and connections", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockFacadeArgs()
+		args.WSHandler = &mocks.WSHandlerStub{
+			ServeHTTPCalled: func(w http.ResponseWriter, r *http.Request) {
+				assert.Fail(t, "should not have been called")
+			},
+		}
+		f, err := facade.NewNotifierFacade(args)
+		require.Nil(t, err)
+
+		err = f.Drain(context.Background())
+		require.Nil(t, err)
+
+		require.False(t, f.BeginPush())
+		assert.Equal(t, common.HealthStatusDraining, f.GetReadiness().Status)
+
+		resp := httptest.NewRecorder()
+		f.ServeHTTP(resp, httptest.NewRequest("GET", "/", nil))
+		assert.Equal(t, http.StatusServiceUnavailable, resp.Code)
+		assert.Equal(t, "30", resp.Header().Get("Retry-After"))
+	})
+
+	t.Run("should wait for in-flight pushes", func(t *testing.T) {
+		t.Parallel()
+
+		f, err := facade.NewNotifierFacade(createMockFacadeArgs())
+		require.Nil(t, err)
+
+		require.True(t, f.BeginPush())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		err = f.Drain(ctx)
+		require.True(t, errors.Is(err, common.ErrUndeliveredData))
+
+		f.EndPush()
+		err = f.Drain(context.Background())
+		require.Nil(t, err)
+	})
+}
+
+func TestAdminOperations(t *testing.T) {
+	t.Parallel()
+
+	dispatcherID := uuid.New()
+	expectedDispatchers := []data.DispatcherInfo{{ID: dispatcherID, RemoteAddress: "127.0.0.1:1234"}}
+	expectedStats := data.SubscriptionsStats{NumDispatchers: 1, NumSubscriptions: 2}
+	disconnectedID := uuid.UUID{}
+	args := createMockFacadeArgs()
+	args.APIConfig.Admin = config.AdminApiConfig{
+		Username: "admin",
+		Password: "pass",
+	}
+	args.Hub = &mocks.HubStub{
+		GetDispatchersCalled: func() []data.DispatcherInfo {
+			return expectedDispatchers
+		},
+		DisconnectDispatcherCalled: func(id uuid.UUID) error {
+			disconnectedID = id
+			return common.ErrDispatcherNotFound
+		},
+		GetSubscriptionsStatsCalled: func() data.SubscriptionsStats {
+			return expectedStats
+		},
+	}
+
+	f, err := facade.NewNotifierFacade(args)
+	require.Nil(t, err)
+
+	user, pass := f.GetAdminUserAndPass()
+	assert.Equal(t, "admin", user)
+	assert.Equal(t, "pass", pass)
+	assert.Equal(t, expectedDispatchers, f.GetDispatchers())
+	assert.Equal(t, expectedStats, f.GetSubscriptionsStats())
+	assert.Equal(t, common.ErrDispatcherNotFound, f.DisconnectDispatcher(dispatcherID))
+	assert.Equal(t, dispatcherID, disconnectedID)
+}
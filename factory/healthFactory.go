@@ -0,0 +1,71 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
+	"github.com/multiversx/mx-chain-notifier-go/health"
+	"github.com/multiversx/mx-chain-notifier-go/rabbitmq"
+	"github.com/multiversx/mx-chain-notifier-go/redis"
+)
+
+const (
+	redisComponentName      = "redis"
+	rabbitMQComponentName   = "rabbitmq"
+	serviceBusComponentName = "serviceBus"
+	hubComponentName        = "hub"
+)
+
+// ArgsHealthServiceFactory defines the arguments needed to create the health service
+type ArgsHealthServiceFactory struct {
+	Config             config.HealthCheckConfig
+	APIType            string
+	LockServiceEnabled bool
+	LockService        redis.LockService
+	Publisher          rabbitmq.PublisherService
+	ServiceBus         rabbitmq.ServiceBusClient
+	Hub                dispatcher.Hub
+}
+
+// CreateHealthService creates the health service which probes the components used by the api type
+func CreateHealthService(args ArgsHealthServiceFactory) (common.HealthService, error) {
+	components := make([]health.Component, 0)
+
+	if args.LockServiceEnabled {
+		redisProbe, err := health.NewConnectionProbe(args.LockService)
+		if err != nil {
+			return nil, err
+		}
+		components = append(components, health.Component{
+			Name:  redisComponentName,
+			Probe: redisProbe,
+		})
+	}
+
+	switch args.APIType {
+	case common.MessageQueueAPIType:
+		components = append(components,
+			health.Component{
+				Name:  rabbitMQComponentName,
+				Probe: args.Publisher,
+			},
+			health.Component{
+				Name:  serviceBusComponentName,
+				Probe: args.ServiceBus,
+			},
+		)
+	case common.WSAPIType:
+		components = append(components, health.Component{
+			Name:                hubComponentName,
+			Probe:               args.Hub,
+			RequiredForLiveness: true,
+		})
+	default:
+		return nil, common.ErrInvalidAPIType
+	}
+
+	return health.NewHealthService(health.ArgsHealthService{
+		Config:     args.Config,
+		Components: components,
+	})
+}
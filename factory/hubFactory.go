@@ -2,28 +2,51 @@ package factory
 
 import (
 	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/disabled"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher/hub"
 	"github.com/multiversx/mx-chain-notifier-go/filters"
 )
 
+const defaultBlockHashesCacheSize = 100000
+
 // CreateHub creates a common hub component
-func CreateHub(apiType string) (dispatcher.Hub, error) {
+func CreateHub(
+	apiType string,
+	statusMetricsHandler common.StatusMetricsHandler,
+	blockTimestamps dispatcher.BlockTimestampsHandler,
+) (dispatcher.Hub, error) {
 	switch apiType {
 	case common.MessageQueueAPIType:
 		return &disabled.Hub{}, nil
 	case common.WSAPIType:
-		return createHub()
+		return createHub(statusMetricsHandler, blockTimestamps)
 	default:
 		return nil, common.ErrInvalidAPIType
 	}
 }
 
-func createHub() (dispatcher.Hub, error) {
+func createHub(
+	statusMetricsHandler common.StatusMetricsHandler,
+	blockTimestamps dispatcher.BlockTimestampsHandler,
+) (dispatcher.Hub, error) {
 	args := hub.ArgsCommonHub{
-		Filter:             filters.NewDefaultFilter(),
-		SubscriptionMapper: dispatcher.NewSubscriptionMapper(),
+		Filter:               filters.NewDefaultFilter(),
+		SubscriptionMapper:   dispatcher.NewSubscriptionMapper(),
+		StatusMetricsHandler: statusMetricsHandler,
+		BlockTimestamps:      blockTimestamps,
 	}
 	return hub.NewCommonHub(args)
 }
+
+// CreateBlockTimestampsCache creates the cache with the timestamps of the recent blocks, used to
+// check the TTL of the native-auth tokens
+func CreateBlockTimestampsCache(nativeAuthConfig config.NativeAuthConfig) (dispatcher.BlockTimestampsHandler, error) {
+	cacheSize := nativeAuthConfig.BlockHashesCacheSize
+	if cacheSize == 0 {
+		cacheSize = defaultBlockHashesCacheSize
+	}
+
+	return dispatcher.NewBlockTimestampsCache(cacheSize)
+}
@@ -0,0 +1,25 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/disabled"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+)
+
+// CreateIngestionQueue creates the per shard queues between the events push endpoint and the processing pipeline
+func CreateIngestionQueue(
+	config config.IngestionQueueConfig,
+	statusMetricsHandler common.StatusMetricsHandler,
+) (process.IngestionQueue, error) {
+	if !config.Enabled {
+		return &disabled.IngestionQueue{}, nil
+	}
+
+	args := process.ArgsIngestionQueue{
+		Config:               config,
+		StatusMetricsHandler: statusMetricsHandler,
+	}
+
+	return process.NewIngestionQueue(args)
+}
@@ -0,0 +1,22 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/metrics"
+)
+
+// CreateObserversTracker creates the component which tracks the observers and shards activity
+func CreateObserversTracker(
+	config config.ObserversHealthConfig,
+	sourceStaleHandler metrics.SourceStaleHandler,
+) (common.ObserversTracker, error) {
+	args := metrics.ArgsObserversTracker{
+		StaleSourceWindow:  time.Second * time.Duration(config.StaleSourceWindowInSec),
+		SourceStaleHandler: sourceStaleHandler,
+	}
+
+	return metrics.NewObserversTracker(args)
+}
@@ -0,0 +1,36 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/disabled"
+	"github.com/multiversx/mx-chain-notifier-go/outport"
+)
+
+// CreateObserversConnector creates the websocket connections to the outport driver of the observers
+func CreateObserversConnector(
+	config config.OutportWebSocketConfig,
+	facade outport.FacadeHandler,
+) (outport.ObserversConnector, error) {
+	if !config.Enabled {
+		return &disabled.ObserversConnector{}, nil
+	}
+
+	argsPayloadHandler := outport.ArgsPayloadHandler{
+		Facade:     facade,
+		Marshaller: &marshal.GogoProtoMarshalizer{},
+		Hasher:     blake2b.NewBlake2b(),
+	}
+	payloadHandler, err := outport.NewPayloadHandler(argsPayloadHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	argsObserversConnector := outport.ArgsObserversConnector{
+		Config:         config,
+		PayloadHandler: payloadHandler,
+	}
+
+	return outport.NewObserversConnector(argsObserversConnector)
+}
@@ -10,7 +10,10 @@ import (
 
 var log = logger.GetOrCreate("factory")
 
-const addrPubKeyConverterLength = 32
+const (
+	addrPubKeyConverterLength = 32
+	addrPubKeyConverterHrp    = "erd"
+)
 
 // ArgsEventsHandlerFactory defines the arguments needed for events handler creation
 type ArgsEventsHandlerFactory struct {
@@ -60,7 +63,7 @@ func getPublisher(
 
 // CreateEventsInterceptor will create the events interceptor
 func CreateEventsInterceptor() (process.EventsInterceptor, error) {
-	pubKeyConverter, err := pubkeyConverter.NewBech32PubkeyConverter(addrPubKeyConverterLength, log)
+	pubKeyConverter, err := pubkeyConverter.NewBech32PubkeyConverter(addrPubKeyConverterLength, addrPubKeyConverterHrp)
 	if err != nil {
 		return nil, err
 	}
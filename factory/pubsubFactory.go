@@ -1,17 +1,46 @@
 package factory
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/disabled"
 	"github.com/multiversx/mx-chain-notifier-go/rabbitmq"
 )
 
+// CreateServiceBusClient creates the azure service bus client used by the publisher
+func CreateServiceBusClient(
+	apiType string,
+	config config.RabbitMQConfig,
+	statusMetricsHandler common.StatusMetricsHandler,
+) (rabbitmq.ServiceBusClient, error) {
+	switch apiType {
+	case common.MessageQueueAPIType:
+		serviceBusArgs := rabbitmq.ArgsServiceBusClient{
+			ConnectionString:     config.AzureCredentials,
+			SendRetries:          config.ServiceBusSendRetries,
+			RetryDelay:           time.Millisecond * time.Duration(config.ServiceBusRetryDelayMs),
+			StatusMetricsHandler: statusMetricsHandler,
+		}
+		return rabbitmq.NewServiceBusClient(serviceBusArgs)
+	case common.WSAPIType:
+		return &disabled.ServiceBusClient{}, nil
+	default:
+		return nil, common.ErrInvalidAPIType
+	}
+}
+
 // CreatePublisher creates publisher component
-func CreatePublisher(apiType string, config config.GeneralConfig) (rabbitmq.PublisherService, error) {
+func CreatePublisher(
+	apiType string,
+	config config.GeneralConfig,
+	serviceBusClient rabbitmq.ServiceBusClient,
+	statusMetricsHandler common.StatusMetricsHandler,
+) (rabbitmq.PublisherService, error) {
 	switch apiType {
 	case common.MessageQueueAPIType:
-		return createRabbitMqPublisher(config.RabbitMQ)
+		return createRabbitMqPublisher(config.RabbitMQ, serviceBusClient, statusMetricsHandler)
 	case common.WSAPIType:
 		return &disabled.Publisher{}, nil
 	default:
@@ -19,15 +48,21 @@ func CreatePublisher(apiType string, config config.GeneralConfig) (rabbitmq.Publ
 	}
 }
 
-func createRabbitMqPublisher(config config.RabbitMQConfig) (rabbitmq.PublisherService, error) {
-	rabbitClient, err := rabbitmq.NewRabbitMQClient(config.Url)
+func createRabbitMqPublisher(
+	config config.RabbitMQConfig,
+	serviceBusClient rabbitmq.ServiceBusClient,
+	statusMetricsHandler common.StatusMetricsHandler,
+) (rabbitmq.PublisherService, error) {
+	rabbitClient, err := rabbitmq.NewRabbitMQClient(config)
 	if err != nil {
 		return nil, err
 	}
 
 	rabbitMqPublisherArgs := rabbitmq.ArgsRabbitMqPublisher{
-		Client: rabbitClient,
-		Config: config,
+		Client:               rabbitClient,
+		ServiceBus:           serviceBusClient,
+		StatusMetricsHandler: statusMetricsHandler,
+		Config:               config,
 	}
 	rabbitPublisher, err := rabbitmq.NewRabbitMqPublisher(rabbitMqPublisherArgs)
 	if err != nil {
@@ -0,0 +1,33 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/disabled"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+)
+
+// CreateObserversQuorum creates the observers quorum component based on config. The quorum can be enabled
+// only with per-observer authentication, so that each vote is bound to a configured observer
+func CreateObserversQuorum(
+	apiConfig config.ConnectorApiConfig,
+	locker process.LockService,
+	ingestionQueue process.IngestionQueue,
+	statusMetricsHandler common.StatusMetricsHandler,
+) (process.ObserversQuorum, error) {
+	if !apiConfig.Quorum.Enabled {
+		return &disabled.ObserversQuorum{}, nil
+	}
+	if len(apiConfig.ObserversAuth.Observers) == 0 {
+		return nil, common.ErrQuorumWithoutObserversAuth
+	}
+
+	args := process.ArgsObserversQuorum{
+		Config:               apiConfig.Quorum,
+		Locker:               locker,
+		IngestionQueue:       ingestionQueue,
+		StatusMetricsHandler: statusMetricsHandler,
+	}
+
+	return process.NewObserversQuorum(args)
+}
@@ -0,0 +1,56 @@
+package factory
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/disabled"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/multiversx/mx-chain-notifier-go/redis"
+)
+
+// CreateTxStatusTracker creates the tx status tracker component based on config
+func CreateTxStatusTracker(
+	config config.TxStatusTrackerConfig,
+	redisConfig config.RedisConfig,
+	txStatusHandler process.TxStatusHandler,
+) (process.TxStatusTracker, error) {
+	if !config.Enabled {
+		return &disabled.TxStatusTracker{}, nil
+	}
+
+	timeout := time.Second * time.Duration(config.TimeoutInSec)
+	storer, err := createTxStatusStorer(config.UseRedis, redisConfig, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	args := process.ArgsTxStatusTracker{
+		Storer:          storer,
+		TxStatusHandler: txStatusHandler,
+		Timeout:         timeout,
+	}
+
+	return process.NewTxStatusTracker(args)
+}
+
+func createTxStatusStorer(useRedis bool, redisConfig config.RedisConfig, timeout time.Duration) (process.TxStatusStorer, error) {
+	// the final status claims are kept longer than the timeout, so that the blocks which arrive later
+	// from the other shards do not handle the transactions again
+	ttl := 2 * timeout
+	if !useRedis {
+		return process.NewTxStatusMemoryStorer(ttl), nil
+	}
+
+	redisClient, err := createRedisClient(redisConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	args := redis.ArgsTxStatusStorer{
+		Client: redisClient,
+		TTL:    ttl,
+	}
+
+	return redis.NewTxStatusStorer(args)
+}
@@ -1,7 +1,10 @@
 package factory
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
 	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/disabled"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher/ws"
@@ -13,26 +16,89 @@ const (
 )
 
 // CreateWSHandler creates websocket handler component based on api type
-func CreateWSHandler(apiType string, hub dispatcher.Hub) (dispatcher.WSHandler, error) {
+func CreateWSHandler(
+	apiType string,
+	hub dispatcher.Hub,
+	statusMetricsHandler common.StatusMetricsHandler,
+	subscribersAuthConfig config.SubscribersAuthConfig,
+	blockTimestamps dispatcher.BlockTimestampsHandler,
+) (dispatcher.WSHandler, error) {
 	switch apiType {
 	case common.MessageQueueAPIType:
 		return &disabled.WSHandler{}, nil
 	case common.WSAPIType:
-		return createWSHandler(hub)
+		return createWSHandler(hub, statusMetricsHandler, subscribersAuthConfig, blockTimestamps)
 	default:
 		return nil, common.ErrInvalidAPIType
 	}
 }
 
-func createWSHandler(hub dispatcher.Hub) (dispatcher.WSHandler, error) {
+func createWSHandler(
+	hub dispatcher.Hub,
+	statusMetricsHandler common.StatusMetricsHandler,
+	subscribersAuthConfig config.SubscribersAuthConfig,
+	blockTimestamps dispatcher.BlockTimestampsHandler,
+) (dispatcher.WSHandler, error) {
 	upgrader, err := ws.NewWSUpgraderWrapper(readBufferSize, writeBufferSize)
 	if err != nil {
 		return nil, err
 	}
 
+	authenticator, err := createSubscribersAuthenticator(statusMetricsHandler, subscribersAuthConfig, blockTimestamps)
+	if err != nil {
+		return nil, err
+	}
+
 	args := ws.ArgsWebSocketProcessor{
-		Hub:      hub,
-		Upgrader: upgrader,
+		Hub:                  hub,
+		Upgrader:             upgrader,
+		StatusMetricsHandler: statusMetricsHandler,
+		Authenticator:        authenticator,
 	}
 	return ws.NewWebSocketProcessor(args)
 }
+
+func createSubscribersAuthenticator(
+	statusMetricsHandler common.StatusMetricsHandler,
+	subscribersAuthConfig config.SubscribersAuthConfig,
+	blockTimestamps dispatcher.BlockTimestampsHandler,
+) (dispatcher.SubscribersAuthenticator, error) {
+	if !subscribersAuthConfig.Enabled {
+		log.Warn("websocket subscribers authentication is disabled, anyone can subscribe to events")
+		return &disabled.SubscribersAuthenticator{}, nil
+	}
+
+	nativeAuthVerifier, err := createNativeAuthVerifier(subscribersAuthConfig.NativeAuth, blockTimestamps)
+	if err != nil {
+		return nil, err
+	}
+
+	args := ws.ArgsSubscribersAuthenticator{
+		Config:               subscribersAuthConfig,
+		StatusMetricsHandler: statusMetricsHandler,
+		NativeAuthVerifier:   nativeAuthVerifier,
+	}
+	return ws.NewSubscribersAuthenticator(args)
+}
+
+func createNativeAuthVerifier(
+	nativeAuthConfig config.NativeAuthConfig,
+	blockTimestamps dispatcher.BlockTimestampsHandler,
+) (dispatcher.NativeAuthVerifier, error) {
+	if !nativeAuthConfig.Enabled {
+		return &disabled.NativeAuthVerifier{}, nil
+	}
+
+	pubKeyConverter, err := pubkeyConverter.NewBech32PubkeyConverter(addrPubKeyConverterLength, addrPubKeyConverterHrp)
+	if err != nil {
+		return nil, err
+	}
+
+	args := ws.ArgsNativeAuthVerifier{
+		Config:          nativeAuthConfig,
+		BlockTimestamps: blockTimestamps,
+		PubKeyConverter: pubKeyConverter,
+		Hasher:          keccak.NewKeccak(),
+	}
+	return ws.NewNativeAuthVerifier(args)
+}
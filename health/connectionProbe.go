@@ -0,0 +1,36 @@
+package health
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+type connectionProbe struct {
+	checker ConnectionChecker
+}
+
+// NewConnectionProbe creates a health probe which fails when the provided component has no connection
+func NewConnectionProbe(checker ConnectionChecker) (*connectionProbe, error) {
+	if check.IfNil(checker) {
+		return nil, ErrNilConnectionChecker
+	}
+
+	return &connectionProbe{
+		checker: checker,
+	}, nil
+}
+
+// CheckHealth returns an error if the component has no connection
+func (cp *connectionProbe) CheckHealth(ctx context.Context) error {
+	if !cp.checker.HasConnection(ctx) {
+		return ErrNoConnection
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (cp *connectionProbe) IsInterfaceNil() bool {
+	return cp == nil
+}
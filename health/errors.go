@@ -0,0 +1,21 @@
+package health
+
+import "errors"
+
+// ErrNilProbe signals that a nil health probe was provided
+var ErrNilProbe = errors.New("nil health probe")
+
+// ErrEmptyComponentName signals that a health component without name was provided
+var ErrEmptyComponentName = errors.New("empty component name")
+
+// ErrDuplicatedComponentName signals that multiple health components with the same name were provided
+var ErrDuplicatedComponentName = errors.New("duplicated component name")
+
+// ErrNilConnectionChecker signals that a nil connection checker was provided
+var ErrNilConnectionChecker = errors.New("nil connection checker")
+
+// ErrNoConnection signals that the component has no connection to its service
+var ErrNoConnection = errors.New("no connection")
+
+// ErrProbeTimeout signals that the health probe did not finish in time
+var ErrProbeTimeout = errors.New("health probe timeout")
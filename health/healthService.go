@@ -0,0 +1,228 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+var log = logger.GetOrCreate("health")
+
+const (
+	defaultProbeTimeout  = time.Second
+	defaultCacheDuration = 2 * time.Second
+)
+
+// Component defines a notifier dependency whose health is checked
+type Component struct {
+	Name  string
+	Probe Probe
+	// RequiredForLiveness marks the components which have to be healthy for the process to be considered alive.
+	// All the components have to be healthy for the process to be considered ready
+	RequiredForLiveness bool
+}
+
+// ArgsHealthService defines the arguments needed for the health service creation
+type ArgsHealthService struct {
+	Config     config.HealthCheckConfig
+	Components []Component
+}
+
+type componentState struct {
+	Component
+
+	mutResult sync.Mutex
+	result    data.ComponentHealth
+	expiresAt time.Time
+}
+
+type healthService struct {
+	probeTimeout  time.Duration
+	cacheDuration time.Duration
+	components    []*componentState
+}
+
+// NewHealthService creates a component which probes the provided components and caches the results
+func NewHealthService(args ArgsHealthService) (*healthService, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	hs := &healthService{
+		probeTimeout:  defaultProbeTimeout,
+		cacheDuration: defaultCacheDuration,
+		components:    make([]*componentState, 0, len(args.Components)),
+	}
+	if args.Config.ProbeTimeoutInMs > 0 {
+		hs.probeTimeout = time.Millisecond * time.Duration(args.Config.ProbeTimeoutInMs)
+	}
+	if args.Config.CacheDurationInMs > 0 {
+		hs.cacheDuration = time.Millisecond * time.Duration(args.Config.CacheDurationInMs)
+	}
+
+	for _, component := range args.Components {
+		hs.components = append(hs.components, &componentState{
+			Component: component,
+		})
+	}
+
+	return hs, nil
+}
+
+func checkArgs(args ArgsHealthService) error {
+	names := make(map[string]struct{})
+	for _, component := range args.Components {
+		if component.Name == "" {
+			return ErrEmptyComponentName
+		}
+		if check.IfNil(component.Probe) {
+			return fmt.Errorf("%w for component %s", ErrNilProbe, component.Name)
+		}
+
+		_, exists := names[component.Name]
+		if exists {
+			return fmt.Errorf("%w: %s", ErrDuplicatedComponentName, component.Name)
+		}
+		names[component.Name] = struct{}{}
+	}
+
+	return nil
+}
+
+// GetHealth returns the liveness status. The status is down only if a component required for
+// liveness is unhealthy, and degraded if any other component is unhealthy
+func (hs *healthService) GetHealth() data.HealthStatus {
+	results := hs.checkComponents()
+
+	status := common.HealthStatusUp
+	for _, component := range hs.components {
+		if results[component.Name].Status == common.HealthStatusUp {
+			continue
+		}
+		if component.RequiredForLiveness {
+			status = common.HealthStatusDown
+			break
+		}
+		status = common.HealthStatusDegraded
+	}
+
+	return data.HealthStatus{
+		Status:     status,
+		Components: results,
+	}
+}
+
+// GetReadiness returns the readiness status. The status is down if any component is unhealthy
+func (hs *healthService) GetReadiness() data.HealthStatus {
+	results := hs.checkComponents()
+
+	status := common.HealthStatusUp
+	for _, result := range results {
+		if result.Status != common.HealthStatusUp {
+			status = common.HealthStatusDown
+			break
+		}
+	}
+
+	return data.HealthStatus{
+		Status:     status,
+		Components: results,
+	}
+}
+
+func (hs *healthService) checkComponents() map[string]data.ComponentHealth {
+	results := make(map[string]data.ComponentHealth, len(hs.components))
+	mutResults := sync.Mutex{}
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(hs.components))
+	for _, component := range hs.components {
+		go func(component *componentState) {
+			defer wg.Done()
+
+			result := hs.checkComponent(component)
+
+			mutResults.Lock()
+			results[component.Name] = result
+			mutResults.Unlock()
+		}(component)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// checkComponent returns the cached result if it did not expire, otherwise probes the component.
+// Concurrent requests wait for the same probe instead of probing the component again
+func (hs *healthService) checkComponent(component *componentState) data.ComponentHealth {
+	component.mutResult.Lock()
+	defer component.mutResult.Unlock()
+
+	now := time.Now()
+	if now.Before(component.expiresAt) {
+		return component.result
+	}
+
+	err := hs.probe(component.Probe)
+	result := data.ComponentHealth{
+		Status:     common.HealthStatusUp,
+		CheckedAt:  now.Unix(),
+		DurationMs: time.Since(now).Milliseconds(),
+	}
+	if err != nil {
+		result.Status = common.HealthStatusDown
+		result.Error = err.Error()
+	}
+
+	hs.logStatusChange(component, result)
+
+	component.result = result
+	component.expiresAt = time.Now().Add(hs.cacheDuration)
+
+	return result
+}
+
+func (hs *healthService) probe(probe Probe) error {
+	ctx, cancel := context.WithTimeout(context.Background(), hs.probeTimeout)
+	defer cancel()
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- probe.CheckHealth(ctx)
+	}()
+
+	select {
+	case err := <-errChan:
+		return err
+	case <-ctx.Done():
+		return ErrProbeTimeout
+	}
+}
+
+func (hs *healthService) logStatusChange(component *componentState, result data.ComponentHealth) {
+	previousStatus := component.result.Status
+	if previousStatus == result.Status {
+		return
+	}
+
+	if result.Status != common.HealthStatusUp {
+		log.Warn("component is unhealthy", "component", component.Name, "error", result.Error)
+		return
+	}
+	if previousStatus != "" {
+		log.Info("component is healthy again", "component", component.Name)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hs *healthService) IsInterfaceNil() bool {
+	return hs == nil
+}
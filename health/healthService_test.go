@@ -0,0 +1,202 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/health"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockHealthServiceArgs() health.ArgsHealthService {
+	return health.ArgsHealthService{
+		Config: config.HealthCheckConfig{
+			ProbeTimeoutInMs:  50,
+			CacheDurationInMs: 100,
+		},
+		Components: []health.Component{
+			{
+				Name:                "hub",
+				Probe:               &mocks.HealthProbeStub{},
+				RequiredForLiveness: true,
+			},
+			{
+				Name:  "redis",
+				Probe: &mocks.HealthProbeStub{},
+			},
+		},
+	}
+}
+
+func TestNewHealthService(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty component name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHealthServiceArgs()
+		args.Components[0].Name = ""
+
+		hs, err := health.NewHealthService(args)
+		require.True(t, check.IfNil(hs))
+		require.Equal(t, health.ErrEmptyComponentName, err)
+	})
+
+	t.Run("nil probe should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHealthServiceArgs()
+		args.Components[1].Probe = nil
+
+		hs, err := health.NewHealthService(args)
+		require.True(t, check.IfNil(hs))
+		require.True(t, errors.Is(err, health.ErrNilProbe))
+	})
+
+	t.Run("duplicated component name should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHealthServiceArgs()
+		args.Components[1].Name = args.Components[0].Name
+
+		hs, err := health.NewHealthService(args)
+		require.True(t, check.IfNil(hs))
+		require.True(t, errors.Is(err, health.ErrDuplicatedComponentName))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hs, err := health.NewHealthService(createMockHealthServiceArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(hs))
+	})
+}
+
+func TestHealthService_GetHealthAndReadiness(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all components healthy", func(t *testing.T) {
+		t.Parallel()
+
+		hs, _ := health.NewHealthService(createMockHealthServiceArgs())
+
+		status := hs.GetHealth()
+		assert.Equal(t, common.HealthStatusUp, status.Status)
+		assert.Equal(t, 2, len(status.Components))
+		assert.Equal(t, common.HealthStatusUp, status.Components["redis"].Status)
+
+		assert.Equal(t, common.HealthStatusUp, hs.GetReadiness().Status)
+	})
+
+	t.Run("component not required for liveness is unhealthy", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHealthServiceArgs()
+		args.Components[1].Probe = &mocks.HealthProbeStub{
+			CheckHealthCalled: func(ctx context.Context) error {
+				return errors.New("no connection")
+			},
+		}
+		hs, _ := health.NewHealthService(args)
+
+		status := hs.GetHealth()
+		assert.Equal(t, common.HealthStatusDegraded, status.Status)
+		assert.Equal(t, common.HealthStatusDown, status.Components["redis"].Status)
+		assert.Equal(t, "no connection", status.Components["redis"].Error)
+		assert.Equal(t, common.HealthStatusUp, status.Components["hub"].Status)
+
+		readiness := hs.GetReadiness()
+		assert.Equal(t, common.HealthStatusDown, readiness.Status)
+	})
+
+	t.Run("component required for liveness is unhealthy", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHealthServiceArgs()
+		args.Components[0].Probe = &mocks.HealthProbeStub{
+			CheckHealthCalled: func(ctx context.Context) error {
+				return errors.New("not responding")
+			},
+		}
+		hs, _ := health.NewHealthService(args)
+
+		assert.Equal(t, common.HealthStatusDown, hs.GetHealth().Status)
+		assert.Equal(t, common.HealthStatusDown, hs.GetReadiness().Status)
+	})
+
+	t.Run("probe exceeding the timeout should be unhealthy", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockHealthServiceArgs()
+		args.Components[1].Probe = &mocks.HealthProbeStub{
+			CheckHealthCalled: func(ctx context.Context) error {
+				time.Sleep(time.Second)
+				return nil
+			},
+		}
+		hs, _ := health.NewHealthService(args)
+
+		start := time.Now()
+		readiness := hs.GetReadiness()
+		assert.Less(t, time.Since(start), 500*time.Millisecond)
+		assert.Equal(t, common.HealthStatusDown, readiness.Status)
+		assert.Equal(t, health.ErrProbeTimeout.Error(), readiness.Components["redis"].Error)
+	})
+}
+
+func TestHealthService_CachedResults(t *testing.T) {
+	t.Parallel()
+
+	numProbes := uint32(0)
+	isHealthy := uint32(1)
+	args := createMockHealthServiceArgs()
+	args.Components[1].Probe = &mocks.HealthProbeStub{
+		CheckHealthCalled: func(ctx context.Context) error {
+			atomic.AddUint32(&numProbes, 1)
+			if atomic.LoadUint32(&isHealthy) == 0 {
+				return errors.New("no connection")
+			}
+			return nil
+		},
+	}
+	hs, _ := health.NewHealthService(args)
+
+	assert.Equal(t, common.HealthStatusUp, hs.GetReadiness().Status)
+	atomic.StoreUint32(&isHealthy, 0)
+	assert.Equal(t, common.HealthStatusUp, hs.GetReadiness().Status)
+	assert.Equal(t, common.HealthStatusUp, hs.GetHealth().Status)
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numProbes))
+
+	time.Sleep(150 * time.Millisecond)
+	assert.Equal(t, common.HealthStatusDown, hs.GetReadiness().Status)
+	assert.Equal(t, uint32(2), atomic.LoadUint32(&numProbes))
+}
+
+func TestConnectionProbe(t *testing.T) {
+	t.Parallel()
+
+	probe, err := health.NewConnectionProbe(nil)
+	require.True(t, check.IfNil(probe))
+	require.Equal(t, health.ErrNilConnectionChecker, err)
+
+	hasConnection := true
+	probe, err = health.NewConnectionProbe(&mocks.LockerStub{
+		HasConnectionCalled: func(ctx context.Context) bool {
+			return hasConnection
+		},
+	})
+	require.Nil(t, err)
+	require.Nil(t, probe.CheckHealth(context.Background()))
+
+	hasConnection = false
+	require.Equal(t, health.ErrNoConnection, probe.CheckHealth(context.Background()))
+}
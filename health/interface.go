@@ -0,0 +1,15 @@
+package health
+
+import "context"
+
+// Probe defines the behaviour of a component whose health can be checked
+type Probe interface {
+	CheckHealth(ctx context.Context) error
+	IsInterfaceNil() bool
+}
+
+// ConnectionChecker defines the behaviour of a component which can tell if its connection is alive
+type ConnectionChecker interface {
+	HasConnection(ctx context.Context) bool
+	IsInterfaceNil() bool
+}
@@ -36,17 +36,24 @@ func NewTestNotifierWithWS(cfg config.GeneralConfig) (*testNotifier, error) {
 		return nil, err
 	}
 
+	statusMetricsHandler := metrics.NewStatusMetrics()
+
+	blockTimestamps, err := dispatcher.NewBlockTimestampsCache(100)
+	if err != nil {
+		return nil, err
+	}
+
 	args := hub.ArgsCommonHub{
-		Filter:             filters.NewDefaultFilter(),
-		SubscriptionMapper: dispatcher.NewSubscriptionMapper(),
+		Filter:               filters.NewDefaultFilter(),
+		SubscriptionMapper:   dispatcher.NewSubscriptionMapper(),
+		StatusMetricsHandler: statusMetricsHandler,
+		BlockTimestamps:      blockTimestamps,
 	}
 	publisher, err := hub.NewCommonHub(args)
 	if err != nil {
 		return nil, err
 	}
 
-	statusMetricsHandler := metrics.NewStatusMetrics()
-
 	argsEventsHandler := process.ArgsEventsHandler{
 		Config:               cfg.ConnectorApi,
 		Locker:               locker,
@@ -63,8 +70,10 @@ func NewTestNotifierWithWS(cfg config.GeneralConfig) (*testNotifier, error) {
 		return nil, err
 	}
 	wsHandlerArgs := ws.ArgsWebSocketProcessor{
-		Hub:      publisher,
-		Upgrader: upgrader,
+		Hub:                  publisher,
+		Upgrader:             upgrader,
+		StatusMetricsHandler: statusMetricsHandler,
+		Authenticator:        &disabled.SubscribersAuthenticator{},
 	}
 	wsHandler, err := ws.NewWebSocketProcessor(wsHandlerArgs)
 	if err != nil {
@@ -79,12 +88,25 @@ func NewTestNotifierWithWS(cfg config.GeneralConfig) (*testNotifier, error) {
 		return nil, err
 	}
 
+	observersTracker, err := metrics.NewObserversTracker(metrics.ArgsObserversTracker{
+		SourceStaleHandler: eventsHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	facadeArgs := facade.ArgsNotifierFacade{
 		EventsHandler:        eventsHandler,
 		APIConfig:            cfg.ConnectorApi,
 		WSHandler:            wsHandler,
 		EventsInterceptor:    eventsInterceptor,
 		StatusMetricsHandler: statusMetricsHandler,
+		ObserversQuorum:      &disabled.ObserversQuorum{},
+		ObserversTracker:     observersTracker,
+		HealthService:        &mocks.HealthServiceStub{},
+		Hub:                  publisher,
+		IngestionQueue:       &disabled.IngestionQueue{},
+		TxStatusTracker:      &disabled.TxStatusTracker{},
 	}
 	facade, err := facade.NewNotifierFacade(facadeArgs)
 	if err != nil {
@@ -116,8 +138,10 @@ func NewTestNotifierWithRabbitMq(cfg config.GeneralConfig) (*testNotifier, error
 
 	rabbitmqMock := mocks.NewRabbitClientMock()
 	publisherArgs := rabbitmq.ArgsRabbitMqPublisher{
-		Client: rabbitmqMock,
-		Config: cfg.RabbitMQ,
+		Client:               rabbitmqMock,
+		ServiceBus:           &mocks.ServiceBusClientStub{},
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+		Config:               cfg.RabbitMQ,
 	}
 	publisher, err := rabbitmq.NewRabbitMqPublisher(publisherArgs)
 	if err != nil {
@@ -144,12 +168,25 @@ func NewTestNotifierWithRabbitMq(cfg config.GeneralConfig) (*testNotifier, error
 	}
 
 	wsHandler := &disabled.WSHandler{}
+	observersTracker, err := metrics.NewObserversTracker(metrics.ArgsObserversTracker{
+		SourceStaleHandler: eventsHandler,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	facadeArgs := facade.ArgsNotifierFacade{
 		EventsHandler:        eventsHandler,
 		APIConfig:            cfg.ConnectorApi,
 		WSHandler:            wsHandler,
 		EventsInterceptor:    eventsInterceptor,
 		StatusMetricsHandler: statusMetricsHandler,
+		ObserversQuorum:      &disabled.ObserversQuorum{},
+		ObserversTracker:     observersTracker,
+		HealthService:        &mocks.HealthServiceStub{},
+		Hub:                  &disabled.Hub{},
+		IngestionQueue:       &disabled.IngestionQueue{},
+		TxStatusTracker:      &disabled.TxStatusTracker{},
 	}
 	facade, err := facade.NewNotifierFacade(facadeArgs)
 	if err != nil {
@@ -204,10 +241,46 @@ func GetDefaultConfigs() *config.Configs {
 					Name: "blockscrs",
 					Type: "fanout",
 				},
+				BlockRewardsExchange: config.RabbitMQExchangeConfig{
+					Name: "blockrewards",
+					Type: "fanout",
+				},
+				BlockReceiptsExchange: config.RabbitMQExchangeConfig{
+					Name: "blockreceipts",
+					Type: "fanout",
+				},
+				BlockInvalidTxsExchange: config.RabbitMQExchangeConfig{
+					Name: "blockinvalidtxs",
+					Type: "fanout",
+				},
+				AlteredAccountsExchange: config.RabbitMQExchangeConfig{
+					Name: "alteredaccounts",
+					Type: "fanout",
+				},
+				BlockHeaderExchange: config.RabbitMQExchangeConfig{
+					Name: "blockheader",
+					Type: "fanout",
+				},
+				EpochStartExchange: config.RabbitMQExchangeConfig{
+					Name: "epochstart",
+					Type: "fanout",
+				},
+				TxBundlesExchange: config.RabbitMQExchangeConfig{
+					Name: "txbundles",
+					Type: "fanout",
+				},
+				TxStatusExchange: config.RabbitMQExchangeConfig{
+					Name: "txstatus",
+					Type: "fanout",
+				},
 				BlockEventsExchange: config.RabbitMQExchangeConfig{
 					Name: "blockevents",
 					Type: "fanout",
 				},
+				SourceStaleExchange: config.RabbitMQExchangeConfig{
+					Name: "sourcestale",
+					Type: "fanout",
+				},
 			},
 		},
 		Flags: config.FlagsConfig{
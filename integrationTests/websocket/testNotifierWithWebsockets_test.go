@@ -137,8 +137,8 @@ func TestNotifierWithWebsockets_BlockEvents(t *testing.T) {
 		ShardID:   1,
 		TimeStamp: 1234,
 		Events:    events,
-		Txs:       make(map[string]*data.NotifierTransaction),
-		Scrs:      make(map[string]*data.NotifierSmartContractResult),
+		Txs:       make([]*data.NotifierTransaction, 0),
+		Scrs:      make([]*data.NotifierSmartContractResult, 0),
 	}
 
 	saveBlockData := data.ArgsSaveBlockData{
@@ -343,9 +343,12 @@ func TestNotifierWithWebsockets_TxsEvents(t *testing.T) {
 		ArgsSaveBlockData: saveBlockData,
 	}
 
-	expTxs := map[string]*transaction.Transaction{
-		"hash1": {
-			Nonce: 1,
+	expTxs := []*data.NotifierTransaction{
+		{
+			Hash: "hash1",
+			Transaction: &transaction.Transaction{
+				Nonce: 1,
+			},
 		},
 	}
 	expBlockTxs := &data.BlockTxs{
@@ -425,9 +428,12 @@ func TestNotifierWithWebsockets_ScrsEvents(t *testing.T) {
 		ArgsSaveBlockData: saveBlockData,
 	}
 
-	expScrs := map[string]*smartContractResult.SmartContractResult{
-		"hash2": {
-			Nonce: 2,
+	expScrs := []*data.NotifierSmartContractResult{
+		{
+			Hash: "hash2",
+			SmartContractResult: &smartContractResult.SmartContractResult{
+				Nonce: 2,
+			},
 		},
 	}
 	expBlockScrs := &data.BlockScrs{
@@ -527,9 +533,12 @@ func TestNotifierWithWebsockets_AllEvents(t *testing.T) {
 	}
 	blockHash := []byte("hash1")
 
-	expTxs := map[string]*transaction.Transaction{
-		"hash1": {
-			Nonce: 1,
+	expTxs := []*data.NotifierTransaction{
+		{
+			Hash: "hash1",
+			Transaction: &transaction.Transaction{
+				Nonce: 1,
+			},
 		},
 	}
 	blockTxs := &data.BlockTxs{
@@ -537,9 +546,12 @@ func TestNotifierWithWebsockets_AllEvents(t *testing.T) {
 		Txs:  expTxs,
 	}
 
-	expScrs := map[string]*smartContractResult.SmartContractResult{
-		"hash2": {
-			Nonce: 2,
+	expScrs := []*data.NotifierSmartContractResult{
+		{
+			Hash: "hash2",
+			SmartContractResult: &smartContractResult.SmartContractResult{
+				Nonce: 2,
+			},
 		},
 	}
 	blockScrs := &data.BlockScrs{
@@ -547,27 +559,13 @@ func TestNotifierWithWebsockets_AllEvents(t *testing.T) {
 		Scrs: expScrs,
 	}
 
-	expTxsWithOrder := map[string]*data.NotifierTransaction{
-		"hash1": {
-			Transaction: &transaction.Transaction{
-				Nonce: 1,
-			},
-		},
-	}
-	expScrsWithOrder := map[string]*data.NotifierSmartContractResult{
-		"hash2": {
-			SmartContractResult: &smartContractResult.SmartContractResult{
-				Nonce: 2,
-			},
-		},
-	}
 	expBlockEvents := data.BlockEventsWithOrder{
 		Hash:      hex.EncodeToString(blockHash),
 		ShardID:   1,
 		TimeStamp: 1234,
 		Events:    events,
-		Txs:       expTxsWithOrder,
-		Scrs:      expScrsWithOrder,
+		Txs:       expTxs,
+		Scrs:      expScrs,
 	}
 
 	saveBlockData := data.ArgsSaveBlockData{
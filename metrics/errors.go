@@ -0,0 +1,6 @@
+package metrics
+
+import "errors"
+
+// ErrNilSourceStaleHandler signals that a nil source stale handler was provided
+var ErrNilSourceStaleHandler = errors.New("nil source stale handler")
@@ -0,0 +1,9 @@
+package metrics
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// SourceStaleHandler defines the behaviour of a component which handles the source stale events
+type SourceStaleHandler interface {
+	HandleSourceStale(event data.SourceStaleEvent)
+	IsInterfaceNil() bool
+}
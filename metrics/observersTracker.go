@@ -0,0 +1,316 @@
+package metrics
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+var log = logger.GetOrCreate("metrics")
+
+const (
+	rateWindow = time.Minute
+
+	observerLabel = "observer"
+	shardLabel    = "shard"
+
+	observerLastPushPromMetric  = "observer_last_push_timestamp"
+	observerLastNoncePromMetric = "observer_last_nonce"
+	observerPushRatePromMetric  = "observer_push_rate"
+	observerErrorRatePromMetric = "observer_error_rate"
+	shardLastPushPromMetric     = "shard_last_push_timestamp"
+	shardLastNoncePromMetric    = "shard_last_nonce"
+	shardPushRatePromMetric     = "shard_push_rate"
+	shardIsStalePromMetric      = "shard_is_stale"
+)
+
+// ArgsObserversTracker defines the arguments needed for an observers tracker component
+type ArgsObserversTracker struct {
+	StaleSourceWindow  time.Duration
+	SourceStaleHandler SourceStaleHandler
+}
+
+type observerStats struct {
+	lastShardID uint32
+	lastPush    time.Time
+	lastNonce   uint64
+	numPushes   uint64
+	numErrors   uint64
+	pushes      []time.Time
+	errors      []time.Time
+}
+
+type shardStats struct {
+	lastPush  time.Time
+	lastNonce uint64
+	numPushes uint64
+	pushes    []time.Time
+	isStale   bool
+}
+
+type observersTracker struct {
+	staleSourceWindow  time.Duration
+	sourceStaleHandler SourceStaleHandler
+
+	mut       sync.Mutex
+	observers map[string]*observerStats
+	shards    map[uint32]*shardStats
+
+	cancelFunc func()
+}
+
+// NewObserversTracker creates a component which tracks the pushes of each observer and shard.
+// If a stale source window is provided, a source stale event is fired for each shard
+// from which no block arrived within the window
+func NewObserversTracker(args ArgsObserversTracker) (*observersTracker, error) {
+	if check.IfNil(args.SourceStaleHandler) {
+		return nil, ErrNilSourceStaleHandler
+	}
+
+	ot := &observersTracker{
+		staleSourceWindow:  args.StaleSourceWindow,
+		sourceStaleHandler: args.SourceStaleHandler,
+		observers:          make(map[string]*observerStats),
+		shards:             make(map[uint32]*shardStats),
+		cancelFunc:         func() {},
+	}
+
+	if args.StaleSourceWindow > 0 {
+		var ctx context.Context
+		ctx, ot.cancelFunc = context.WithCancel(context.Background())
+		go ot.runWatchdog(ctx)
+	}
+
+	return ot, nil
+}
+
+// RecordPush records a block pushed by the provided observer
+func (ot *observersTracker) RecordPush(observerID string, shardID uint32, nonce uint64) {
+	now := time.Now()
+
+	ot.mut.Lock()
+	defer ot.mut.Unlock()
+
+	observer := ot.getOrCreateObserver(observerID)
+	observer.lastShardID = shardID
+	observer.lastPush = now
+	observer.lastNonce = nonce
+	observer.numPushes++
+	observer.pushes = append(pruneOlderThan(observer.pushes, now), now)
+
+	shard, ok := ot.shards[shardID]
+	if !ok {
+		shard = &shardStats{}
+		ot.shards[shardID] = shard
+	}
+	if shard.isStale {
+		log.Info("blocks received again for shard", "shard", shardID, "observer", observerID, "nonce", nonce)
+		shard.isStale = false
+	}
+	shard.lastPush = now
+	if nonce > shard.lastNonce {
+		shard.lastNonce = nonce
+	}
+	shard.numPushes++
+	shard.pushes = append(pruneOlderThan(shard.pushes, now), now)
+}
+
+// RecordError records a failed push from the provided observer
+func (ot *observersTracker) RecordError(observerID string) {
+	now := time.Now()
+
+	ot.mut.Lock()
+	defer ot.mut.Unlock()
+
+	observer := ot.getOrCreateObserver(observerID)
+	observer.numErrors++
+	observer.errors = append(pruneOlderThan(observer.errors, now), now)
+}
+
+func (ot *observersTracker) getOrCreateObserver(observerID string) *observerStats {
+	observer, ok := ot.observers[observerID]
+	if !ok {
+		observer = &observerStats{}
+		ot.observers[observerID] = observer
+	}
+
+	return observer
+}
+
+// GetObserversHealth returns the push statistics of all the observers and shards, sorted by ID.
+// The rates are computed over the last minute, as pushes (or errors) per minute
+func (ot *observersTracker) GetObserversHealth() data.ObserversHealth {
+	now := time.Now()
+
+	ot.mut.Lock()
+	defer ot.mut.Unlock()
+
+	health := data.ObserversHealth{
+		Observers: make([]data.ObserverHealth, 0, len(ot.observers)),
+		Shards:    make([]data.ShardHealth, 0, len(ot.shards)),
+	}
+
+	for observerID, observer := range ot.observers {
+		observer.pushes = pruneOlderThan(observer.pushes, now)
+		observer.errors = pruneOlderThan(observer.errors, now)
+
+		health.Observers = append(health.Observers, data.ObserverHealth{
+			ObserverID:        observerID,
+			LastShardID:       observer.lastShardID,
+			LastPushTimestamp: unixOrZero(observer.lastPush),
+			LastNonce:         observer.lastNonce,
+			NumPushes:         observer.numPushes,
+			NumErrors:         observer.numErrors,
+			PushRate:          float64(len(observer.pushes)),
+			ErrorRate:         float64(len(observer.errors)),
+		})
+	}
+
+	for shardID, shard := range ot.shards {
+		shard.pushes = pruneOlderThan(shard.pushes, now)
+
+		health.Shards = append(health.Shards, data.ShardHealth{
+			ShardID:           shardID,
+			LastPushTimestamp: unixOrZero(shard.lastPush),
+			LastNonce:         shard.lastNonce,
+			NumPushes:         shard.numPushes,
+			PushRate:          float64(len(shard.pushes)),
+			IsStale:           shard.isStale,
+		})
+	}
+
+	sort.Slice(health.Observers, func(i, j int) bool {
+		return health.Observers[i].ObserverID < health.Observers[j].ObserverID
+	})
+	sort.Slice(health.Shards, func(i, j int) bool {
+		return health.Shards[i].ShardID < health.Shards[j].ShardID
+	})
+
+	return health
+}
+
+// GetMetricsForPrometheus returns the observers and shards statistics as prometheus gauges
+func (ot *observersTracker) GetMetricsForPrometheus() string {
+	health := ot.GetObserversHealth()
+	if len(health.Observers) == 0 && len(health.Shards) == 0 {
+		return ""
+	}
+
+	observersLastPush := make(map[string]float64)
+	observersLastNonce := make(map[string]float64)
+	observersPushRate := make(map[string]float64)
+	observersErrorRate := make(map[string]float64)
+	for _, observer := range health.Observers {
+		observersLastPush[observer.ObserverID] = float64(observer.LastPushTimestamp)
+		observersLastNonce[observer.ObserverID] = float64(observer.LastNonce)
+		observersPushRate[observer.ObserverID] = observer.PushRate
+		observersErrorRate[observer.ObserverID] = observer.ErrorRate
+	}
+
+	shardsLastPush := make(map[string]float64)
+	shardsLastNonce := make(map[string]float64)
+	shardsPushRate := make(map[string]float64)
+	shardsIsStale := make(map[string]float64)
+	for _, shard := range health.Shards {
+		shardID := strconv.FormatUint(uint64(shard.ShardID), 10)
+		shardsLastPush[shardID] = float64(shard.LastPushTimestamp)
+		shardsLastNonce[shardID] = float64(shard.LastNonce)
+		shardsPushRate[shardID] = shard.PushRate
+		shardsIsStale[shardID] = 0
+		if shard.IsStale {
+			shardsIsStale[shardID] = 1
+		}
+	}
+
+	stringBuilder := strings.Builder{}
+	stringBuilder.WriteString(gaugeMetric(observerLastPushPromMetric, observerLabel, observersLastPush))
+	stringBuilder.WriteString(gaugeMetric(observerLastNoncePromMetric, observerLabel, observersLastNonce))
+	stringBuilder.WriteString(gaugeMetric(observerPushRatePromMetric, observerLabel, observersPushRate))
+	stringBuilder.WriteString(gaugeMetric(observerErrorRatePromMetric, observerLabel, observersErrorRate))
+	stringBuilder.WriteString(gaugeMetric(shardLastPushPromMetric, shardLabel, shardsLastPush))
+	stringBuilder.WriteString(gaugeMetric(shardLastNoncePromMetric, shardLabel, shardsLastNonce))
+	stringBuilder.WriteString(gaugeMetric(shardPushRatePromMetric, shardLabel, shardsPushRate))
+	stringBuilder.WriteString(gaugeMetric(shardIsStalePromMetric, shardLabel, shardsIsStale))
+
+	return stringBuilder.String()
+}
+
+func (ot *observersTracker) runWatchdog(ctx context.Context) {
+	ticker := time.NewTicker(ot.staleSourceWindow / 4)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("observers tracker watchdog is stopping...")
+			return
+		case <-ticker.C:
+			ot.checkStaleSources()
+		}
+	}
+}
+
+func (ot *observersTracker) checkStaleSources() {
+	now := time.Now()
+	staleEvents := make([]data.SourceStaleEvent, 0)
+
+	ot.mut.Lock()
+	for shardID, shard := range ot.shards {
+		staleFor := now.Sub(shard.lastPush)
+		if shard.isStale || staleFor < ot.staleSourceWindow {
+			continue
+		}
+
+		// the event is fired only once, until blocks are received again for the shard
+		shard.isStale = true
+		staleEvents = append(staleEvents, data.SourceStaleEvent{
+			ShardID:           shardID,
+			LastPushTimestamp: shard.lastPush.Unix(),
+			LastNonce:         shard.lastNonce,
+			StaleForSeconds:   uint64(staleFor.Seconds()),
+		})
+	}
+	ot.mut.Unlock()
+
+	for _, event := range staleEvents {
+		ot.sourceStaleHandler.HandleSourceStale(event)
+	}
+}
+
+func pruneOlderThan(timestamps []time.Time, now time.Time) []time.Time {
+	limit := now.Add(-rateWindow)
+
+	idx := 0
+	for idx < len(timestamps) && timestamps[idx].Before(limit) {
+		idx++
+	}
+
+	return timestamps[idx:]
+}
+
+func unixOrZero(timestamp time.Time) int64 {
+	if timestamp.IsZero() {
+		return 0
+	}
+
+	return timestamp.Unix()
+}
+
+// Close stops the stale sources watchdog
+func (ot *observersTracker) Close() error {
+	ot.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ot *observersTracker) IsInterfaceNil() bool {
+	return ot == nil
+}
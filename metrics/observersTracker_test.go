@@ -0,0 +1,160 @@
+package metrics_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/metrics"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sourceStaleEventsHandler struct {
+	mocks.EventsHandlerStub
+	mut    sync.Mutex
+	events []data.SourceStaleEvent
+}
+
+func newSourceStaleEventsHandler() *sourceStaleEventsHandler {
+	handler := &sourceStaleEventsHandler{
+		events: make([]data.SourceStaleEvent, 0),
+	}
+	handler.HandleSourceStaleCalled = func(event data.SourceStaleEvent) {
+		handler.mut.Lock()
+		handler.events = append(handler.events, event)
+		handler.mut.Unlock()
+	}
+
+	return handler
+}
+
+func (handler *sourceStaleEventsHandler) getEvents() []data.SourceStaleEvent {
+	handler.mut.Lock()
+	defer handler.mut.Unlock()
+
+	return append([]data.SourceStaleEvent{}, handler.events...)
+}
+
+func TestNewObserversTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil source stale handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		ot, err := metrics.NewObserversTracker(metrics.ArgsObserversTracker{})
+		require.True(t, check.IfNil(ot))
+		require.Equal(t, metrics.ErrNilSourceStaleHandler, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ot, err := metrics.NewObserversTracker(metrics.ArgsObserversTracker{
+			StaleSourceWindow:  time.Minute,
+			SourceStaleHandler: &mocks.EventsHandlerStub{},
+		})
+		require.Nil(t, err)
+		require.False(t, check.IfNil(ot))
+		require.Nil(t, ot.Close())
+	})
+}
+
+func TestObserversTracker_GetObserversHealth(t *testing.T) {
+	t.Parallel()
+
+	ot, _ := metrics.NewObserversTracker(metrics.ArgsObserversTracker{
+		SourceStaleHandler: &mocks.EventsHandlerStub{},
+	})
+
+	ot.RecordPush("observer-1", 1, 10)
+	ot.RecordPush("observer-0", 1, 11)
+	ot.RecordPush("observer-0", 0, 5)
+	ot.RecordError("observer-0")
+	ot.RecordError("observer-2")
+
+	health := ot.GetObserversHealth()
+	require.Equal(t, 3, len(health.Observers))
+	require.Equal(t, 2, len(health.Shards))
+
+	observer := health.Observers[0]
+	assert.Equal(t, "observer-0", observer.ObserverID)
+	assert.Equal(t, uint32(0), observer.LastShardID)
+	assert.Equal(t, uint64(5), observer.LastNonce)
+	assert.Equal(t, uint64(2), observer.NumPushes)
+	assert.Equal(t, uint64(1), observer.NumErrors)
+	assert.Equal(t, float64(2), observer.PushRate)
+	assert.Equal(t, float64(1), observer.ErrorRate)
+	assert.NotZero(t, observer.LastPushTimestamp)
+
+	observer = health.Observers[2]
+	assert.Equal(t, "observer-2", observer.ObserverID)
+	assert.Equal(t, uint64(0), observer.NumPushes)
+	assert.Equal(t, int64(0), observer.LastPushTimestamp)
+
+	shard := health.Shards[1]
+	assert.Equal(t, uint32(1), shard.ShardID)
+	assert.Equal(t, uint64(11), shard.LastNonce)
+	assert.Equal(t, uint64(2), shard.NumPushes)
+	assert.Equal(t, float64(2), shard.PushRate)
+	assert.False(t, shard.IsStale)
+}
+
+func TestObserversTracker_GetMetricsForPrometheus(t *testing.T) {
+	t.Parallel()
+
+	ot, _ := metrics.NewObserversTracker(metrics.ArgsObserversTracker{
+		SourceStaleHandler: &mocks.EventsHandlerStub{},
+	})
+	require.Empty(t, ot.GetMetricsForPrometheus())
+
+	ot.RecordPush("observer-0", 1, 10)
+	ot.RecordPush("observer-1", 1, 10)
+	ot.RecordError("observer-1")
+
+	promMetrics := ot.GetMetricsForPrometheus()
+	assert.Contains(t, promMetrics, "# TYPE observer_last_nonce gauge")
+	assert.Contains(t, promMetrics, `observer_last_nonce{observer="observer-0"} 10`)
+	assert.Contains(t, promMetrics, `observer_error_rate{observer="observer-1"} 1`)
+	assert.Contains(t, promMetrics, `shard_push_rate{shard="1"} 2`)
+	assert.Contains(t, promMetrics, `shard_is_stale{shard="1"} 0`)
+}
+
+func TestObserversTracker_StaleSourceWatchdog(t *testing.T) {
+	t.Parallel()
+
+	handler := newSourceStaleEventsHandler()
+	ot, _ := metrics.NewObserversTracker(metrics.ArgsObserversTracker{
+		StaleSourceWindow:  100 * time.Millisecond,
+		SourceStaleHandler: handler,
+	})
+	defer func() {
+		_ = ot.Close()
+	}()
+
+	ot.RecordPush("observer-0", 1, 10)
+
+	require.Eventually(t, func() bool {
+		return len(handler.getEvents()) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	event := handler.getEvents()[0]
+	assert.Equal(t, uint32(1), event.ShardID)
+	assert.Equal(t, uint64(10), event.LastNonce)
+	assert.True(t, ot.GetObserversHealth().Shards[0].IsStale)
+
+	// the event is fired only once per stale period
+	time.Sleep(300 * time.Millisecond)
+	require.Equal(t, 1, len(handler.getEvents()))
+
+	ot.RecordPush("observer-0", 1, 11)
+	assert.False(t, ot.GetObserversHealth().Shards[0].IsStale)
+
+	require.Eventually(t, func() bool {
+		return len(handler.getEvents()) == 2
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, uint64(11), handler.getEvents()[1].LastNonce)
+}
@@ -2,34 +2,13 @@ package metrics
 
 import (
 	"bytes"
+	"sort"
 
 	dto "github.com/prometheus/client_model/go"
 	"github.com/prometheus/common/expfmt"
 	"google.golang.org/protobuf/proto"
 )
 
-func requestsCounterMetric(metricName, endpoint string, count uint64) string {
-	metricFamily := &dto.MetricFamily{
-		Name: proto.String(metricName),
-		Type: dto.MetricType_COUNTER.Enum(),
-		Metric: []*dto.Metric{
-			{
-				Label: []*dto.LabelPair{
-					{
-						Name:  proto.String("operation"),
-						Value: proto.String(endpoint),
-					},
-				},
-				Counter: &dto.Counter{
-					Value: proto.Float64(float64(count)),
-				},
-			},
-		},
-	}
-
-	return promMetricAsString(metricFamily)
-}
-
 func promMetricAsString(metric *dto.MetricFamily) string {
 	out := bytes.NewBuffer(make([]byte, 0))
 	_, err := expfmt.MetricFamilyToText(out, metric)
@@ -39,3 +18,34 @@ func promMetricAsString(metric *dto.MetricFamily) string {
 
 	return out.String() + "\n"
 }
+
+func gaugeMetric(metricName, labelName string, values map[string]float64) string {
+	labels := make([]string, 0, len(values))
+	for label := range values {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	metrics := make([]*dto.Metric, 0, len(labels))
+	for _, label := range labels {
+		metrics = append(metrics, &dto.Metric{
+			Label: []*dto.LabelPair{
+				{
+					Name:  proto.String(labelName),
+					Value: proto.String(label),
+				},
+			},
+			Gauge: &dto.Gauge{
+				Value: proto.Float64(values[label]),
+			},
+		})
+	}
+
+	metricFamily := &dto.MetricFamily{
+		Name:   proto.String(metricName),
+		Type:   dto.MetricType_GAUGE.Enum(),
+		Metric: metrics,
+	}
+
+	return promMetricAsString(metricFamily)
+}
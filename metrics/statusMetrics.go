@@ -1,32 +1,147 @@
 package metrics
 
 import (
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
 const (
-	numRequestsPromMetric       = "num_requests"
-	totalResponseTimePromMetric = "total_response_time"
+	numRequestsPromMetric           = "num_requests"
+	totalResponseTimePromMetric     = "total_response_time"
+	numItemsPromMetric              = "num_items"
+	stageDurationPromMetric         = "stage_duration_seconds"
+	stageErrorsPromMetric           = "stage_errors_total"
+	sinkPublishDurationPromMetric   = "sink_publish_duration_seconds"
+	eventsPromMetric                = "events_total"
+	wsConnectionsPromMetric         = "ws_connections"
+	wsSubscriptionsPromMetric       = "ws_subscriptions"
+	subscriberConnectionsPromMetric = "ws_subscriber_connections"
+	subscriberMessagesPromMetric    = "ws_subscriber_messages_total"
+	subscriberRejectionsPromMetric  = "ws_subscriber_rejections_total"
+	ingestionQueueDepthPromMetric   = "ingestion_queue_depth"
+
+	operationLabel  = "operation"
+	stageLabel      = "stage"
+	sinkLabel       = "sink"
+	identifierLabel = "identifier"
+	subscriberLabel = "subscriber"
+	statusLabel     = "status"
+	reasonLabel     = "reason"
 )
 
+// latencyBuckets covers latencies from 0.5ms up to ~16s
+var latencyBuckets = prometheus.ExponentialBuckets(0.0005, 2, 16)
+
 type statusMetrics struct {
 	operationMetrics    map[string]*data.EndpointMetricsResponse
 	mutOperationMetrics sync.RWMutex
+
+	registry              *prometheus.Registry
+	numRequests           *prometheus.CounterVec
+	totalResponseTime     *prometheus.CounterVec
+	numItems              *prometheus.CounterVec
+	stageDuration         *prometheus.HistogramVec
+	stageErrors           *prometheus.CounterVec
+	sinkPublishDuration   *prometheus.HistogramVec
+	events                *prometheus.CounterVec
+	wsConnections         prometheus.Gauge
+	wsSubscriptions       prometheus.Gauge
+	subscriberConnections *prometheus.GaugeVec
+	subscriberMessages    *prometheus.CounterVec
+	subscriberRejections  *prometheus.CounterVec
+	ingestionQueueDepth   *prometheus.GaugeVec
 }
 
 // NewStatusMetrics will return an instance of the statusMetrics
 func NewStatusMetrics() *statusMetrics {
-	return &statusMetrics{
+	sm := &statusMetrics{
 		operationMetrics: make(map[string]*data.EndpointMetricsResponse),
+		registry:         prometheus.NewRegistry(),
+		numRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: numRequestsPromMetric,
+			Help: "Number of requests per operation",
+		}, []string{operationLabel}),
+		totalResponseTime: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: totalResponseTimePromMetric,
+			Help: "Total response time per operation, in milliseconds",
+		}, []string{operationLabel}),
+		numItems: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: numItemsPromMetric,
+			Help: "Number of items per operation",
+		}, []string{operationLabel}),
+		stageDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    stageDurationPromMetric,
+			Help:    "Duration of each pipeline stage, in seconds",
+			Buckets: latencyBuckets,
+		}, []string{stageLabel}),
+		stageErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: stageErrorsPromMetric,
+			Help: "Number of errors per pipeline stage or sink",
+		}, []string{stageLabel}),
+		sinkPublishDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    sinkPublishDurationPromMetric,
+			Help:    "Publish latency of each sink, in seconds",
+			Buckets: latencyBuckets,
+		}, []string{sinkLabel}),
+		events: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: eventsPromMetric,
+			Help: "Number of processed events per identifier and shard",
+		}, []string{identifierLabel, shardLabel}),
+		wsConnections: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: wsConnectionsPromMetric,
+			Help: "Number of connected websocket clients",
+		}),
+		wsSubscriptions: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: wsSubscriptionsPromMetric,
+			Help: "Number of active websocket subscriptions",
+		}),
+		subscriberConnections: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: subscriberConnectionsPromMetric,
+			Help: "Number of connected websocket clients per subscriber",
+		}, []string{subscriberLabel}),
+		subscriberMessages: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: subscriberMessagesPromMetric,
+			Help: "Number of websocket messages per subscriber, by status (sent or throttled)",
+		}, []string{subscriberLabel, statusLabel}),
+		subscriberRejections: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: subscriberRejectionsPromMetric,
+			Help: "Number of rejected websocket connections and subscriptions per subscriber, by reason",
+		}, []string{subscriberLabel, reasonLabel}),
+		ingestionQueueDepth: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: ingestionQueueDepthPromMetric,
+			Help: "Number of pushed blocks waiting to be processed per shard",
+		}, []string{shardLabel}),
 	}
+
+	sm.registry.MustRegister(
+		sm.numRequests,
+		sm.totalResponseTime,
+		sm.numItems,
+		sm.stageDuration,
+		sm.stageErrors,
+		sm.sinkPublishDuration,
+		sm.events,
+		sm.wsConnections,
+		sm.wsSubscriptions,
+		sm.subscriberConnections,
+		sm.subscriberMessages,
+		sm.subscriberRejections,
+		sm.ingestionQueueDepth,
+	)
+
+	return sm
 }
 
 // AddRequest will add the received data to the metrics map
 func (sm *statusMetrics) AddRequest(path string, duration time.Duration) {
+	sm.numRequests.WithLabelValues(path).Inc()
+	sm.totalResponseTime.WithLabelValues(path).Add(float64(duration) / float64(time.Millisecond))
+
 	sm.mutOperationMetrics.Lock()
 	defer sm.mutOperationMetrics.Unlock()
 
@@ -46,15 +161,68 @@ func (sm *statusMetrics) AddRequest(path string, duration time.Duration) {
 	currentData.TotalResponseTime += duration
 }
 
+// AddCounter will increase the counter of the provided operation with the given value
+func (sm *statusMetrics) AddCounter(operation string, value uint64) {
+	sm.numItems.WithLabelValues(operation).Add(float64(value))
+}
+
+// ObserveStage records the duration of the provided pipeline stage
+func (sm *statusMetrics) ObserveStage(stage string, duration time.Duration) {
+	sm.stageDuration.WithLabelValues(stage).Observe(duration.Seconds())
+}
+
+// AddStageError increments the errors counter of the provided pipeline stage or sink
+func (sm *statusMetrics) AddStageError(stage string) {
+	sm.stageErrors.WithLabelValues(stage).Inc()
+}
+
+// ObservePublish records the publish latency of the provided sink
+func (sm *statusMetrics) ObservePublish(sink string, duration time.Duration) {
+	sm.sinkPublishDuration.WithLabelValues(sink).Observe(duration.Seconds())
+}
+
+// AddEvents increases the counter of the events with the provided identifier, from the provided shard
+func (sm *statusMetrics) AddEvents(identifier string, shardID uint32, count uint64) {
+	shard := strconv.FormatUint(uint64(shardID), 10)
+	sm.events.WithLabelValues(identifier, shard).Add(float64(count))
+}
+
+// SetWSConnections sets the number of connected websocket clients
+func (sm *statusMetrics) SetWSConnections(count int) {
+	sm.wsConnections.Set(float64(count))
+}
+
+// SetWSSubscriptions sets the number of active websocket subscriptions
+func (sm *statusMetrics) SetWSSubscriptions(count int) {
+	sm.wsSubscriptions.Set(float64(count))
+}
+
+// SetSubscriberConnections sets the number of websocket clients connected with the credentials of the provided subscriber
+func (sm *statusMetrics) SetSubscriberConnections(subscriber string, count int) {
+	sm.subscriberConnections.WithLabelValues(subscriber).Set(float64(count))
+}
+
+// AddSubscriberMessage increments the counter of the websocket messages of the provided subscriber with the given status
+func (sm *statusMetrics) AddSubscriberMessage(subscriber string, status string) {
+	sm.subscriberMessages.WithLabelValues(subscriber, status).Inc()
+}
+
+// AddSubscriberRejection increments the counter of the rejections of the provided subscriber with the given reason
+func (sm *statusMetrics) AddSubscriberRejection(subscriber string, reason string) {
+	sm.subscriberRejections.WithLabelValues(subscriber, reason).Inc()
+}
+
+// SetIngestionQueueDepth sets the number of pushed blocks of the provided shard waiting to be processed
+func (sm *statusMetrics) SetIngestionQueueDepth(shardID uint32, depth int) {
+	shard := strconv.FormatUint(uint64(shardID), 10)
+	sm.ingestionQueueDepth.WithLabelValues(shard).Set(float64(depth))
+}
+
 // GetAll returns the metrics map
 func (sm *statusMetrics) GetAll() map[string]*data.EndpointMetricsResponse {
 	sm.mutOperationMetrics.RLock()
 	defer sm.mutOperationMetrics.RUnlock()
 
-	return sm.getAll()
-}
-
-func (sm *statusMetrics) getAll() map[string]*data.EndpointMetricsResponse {
 	newMap := make(map[string]*data.EndpointMetricsResponse)
 	for key, value := range sm.operationMetrics {
 		newMap[key] = value
@@ -65,16 +233,14 @@ func (sm *statusMetrics) getAll() map[string]*data.EndpointMetricsResponse {
 
 // GetMetricsForPrometheus returns the metrics in a prometheus format
 func (sm *statusMetrics) GetMetricsForPrometheus() string {
-	sm.mutOperationMetrics.RLock()
-	defer sm.mutOperationMetrics.RUnlock()
-
-	metricsMap := sm.getAll()
+	metricFamilies, err := sm.registry.Gather()
+	if err != nil {
+		log.Warn("failed to gather prometheus metrics", "error", err.Error())
+	}
 
 	stringBuilder := strings.Builder{}
-
-	for endpointPath, endpointData := range metricsMap {
-		stringBuilder.WriteString(requestsCounterMetric(numRequestsPromMetric, endpointPath, endpointData.NumRequests))
-		stringBuilder.WriteString(requestsCounterMetric(totalResponseTimePromMetric, endpointPath, uint64(endpointData.TotalResponseTime.Milliseconds())))
+	for _, metricFamily := range metricFamilies {
+		stringBuilder.WriteString(promMetricAsString(metricFamily))
 	}
 
 	return stringBuilder.String()
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/metrics"
 	"github.com/stretchr/testify/assert"
@@ -102,12 +103,22 @@ func TestStatusMetrics_GetMetricsForPrometheus(t *testing.T) {
 
 		res := sm.GetMetricsForPrometheus()
 
-		expectedString := `# TYPE num_requests counter
+		expectedString := `# HELP num_requests Number of requests per operation
+# TYPE num_requests counter
 num_requests{operation="block_events"} 3
 
+# HELP total_response_time Total response time per operation, in milliseconds
 # TYPE total_response_time counter
 total_response_time{operation="block_events"} 26
 
+# HELP ws_connections Number of connected websocket clients
+# TYPE ws_connections gauge
+ws_connections 0
+
+# HELP ws_subscriptions Number of active websocket subscriptions
+# TYPE ws_subscriptions gauge
+ws_subscriptions 0
+
 `
 
 		require.Equal(t, expectedString, res)
@@ -115,6 +126,85 @@ total_response_time{operation="block_events"} 26
 	})
 }
 
+func TestStatusMetrics_AddCounter(t *testing.T) {
+	t.Parallel()
+
+	sm := metrics.NewStatusMetrics()
+
+	sm.AddCounter("ServiceBus-batch_messages", 10)
+	sm.AddCounter("ServiceBus-batch_messages", 5)
+
+	res := sm.GetMetricsForPrometheus()
+
+	expectedString := `# HELP num_items Number of items per operation
+# TYPE num_items counter
+num_items{operation="ServiceBus-batch_messages"} 15
+
+# HELP ws_connections Number of connected websocket clients
+# TYPE ws_connections gauge
+ws_connections 0
+
+# HELP ws_subscriptions Number of active websocket subscriptions
+# TYPE ws_subscriptions gauge
+ws_subscriptions 0
+
+`
+	require.Equal(t, expectedString, res)
+	require.Len(t, sm.GetAll(), 0)
+}
+
+func TestStatusMetrics_PipelineMetrics(t *testing.T) {
+	t.Parallel()
+
+	sm := metrics.NewStatusMetrics()
+
+	sm.ObserveStage(common.UnmarshalStage, 2*time.Millisecond)
+	sm.ObserveStage(common.UnmarshalStage, 3*time.Second)
+	sm.AddStageError(common.DedupStage)
+	sm.ObservePublish(common.RabbitMQSink, 20*time.Millisecond)
+	sm.AddEvents("transfer", 1, 3)
+	sm.AddEvents("transfer", 1, 2)
+	sm.AddEvents("transfer", 2, 1)
+	sm.SetWSConnections(2)
+	sm.SetWSSubscriptions(5)
+	sm.SetIngestionQueueDepth(1, 4)
+
+	res := sm.GetMetricsForPrometheus()
+	assert.Contains(t, res, "# TYPE stage_duration_seconds histogram")
+	assert.Contains(t, res, `stage_duration_seconds_bucket{stage="unmarshal",le="0.002"} 1`)
+	assert.Contains(t, res, `stage_duration_seconds_bucket{stage="unmarshal",le="+Inf"} 2`)
+	assert.Contains(t, res, `stage_duration_seconds_count{stage="unmarshal"} 2`)
+	assert.Contains(t, res, `stage_errors_total{stage="dedup"} 1`)
+	assert.Contains(t, res, `sink_publish_duration_seconds_count{sink="rabbitmq"} 1`)
+	assert.Contains(t, res, `events_total{identifier="transfer",shard="1"} 5`)
+	assert.Contains(t, res, `events_total{identifier="transfer",shard="2"} 1`)
+	assert.Contains(t, res, "ws_connections 2")
+	assert.Contains(t, res, "ws_subscriptions 5")
+	assert.Contains(t, res, `ingestion_queue_depth{shard="1"} 4`)
+
+	// the pipeline metrics are exposed only in the prometheus format
+	require.Len(t, sm.GetAll(), 0)
+}
+
+func TestStatusMetrics_SubscriberMetrics(t *testing.T) {
+	t.Parallel()
+
+	sm := metrics.NewStatusMetrics()
+
+	sm.SetSubscriberConnections("free", 3)
+	sm.SetSubscriberConnections("free", 2)
+	sm.AddSubscriberMessage("free", "sent")
+	sm.AddSubscriberMessage("free", "sent")
+	sm.AddSubscriberMessage("free", "throttled")
+	sm.AddSubscriberRejection("free", "event_type_not_allowed")
+
+	res := sm.GetMetricsForPrometheus()
+	assert.Contains(t, res, `ws_subscriber_connections{subscriber="free"} 2`)
+	assert.Contains(t, res, `ws_subscriber_messages_total{status="sent",subscriber="free"} 2`)
+	assert.Contains(t, res, `ws_subscriber_messages_total{status="throttled",subscriber="free"} 1`)
+	assert.Contains(t, res, `ws_subscriber_rejections_total{reason="event_type_not_allowed",subscriber="free"} 1`)
+}
+
 func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 	t.Parallel()
 
@@ -131,13 +221,19 @@ func TestStatusMetrics_ConcurrentOperations(t *testing.T) {
 
 	for i := 0; i < numIterations; i++ {
 		go func(index int) {
-			switch index % 3 {
+			switch index % 6 {
 			case 0:
 				sm.AddRequest(fmt.Sprintf("op_%d", index%5), time.Hour*time.Duration(index))
 			case 1:
 				_ = sm.GetAll()
 			case 2:
 				_ = sm.GetMetricsForPrometheus()
+			case 3:
+				sm.AddCounter(fmt.Sprintf("op_%d", index%5), uint64(index))
+			case 4:
+				sm.ObserveStage(fmt.Sprintf("stage_%d", index%5), time.Millisecond*time.Duration(index))
+			case 5:
+				sm.AddEvents(fmt.Sprintf("id_%d", index%5), uint32(index%3), 1)
 			}
 
 			wg.Done()
@@ -0,0 +1,28 @@
+package mocks
+
+// BlockTimestampsHandlerStub -
+type BlockTimestampsHandlerStub struct {
+	AddCalled func(hash string, timestamp uint64)
+	GetCalled func(hash string) (uint64, bool)
+}
+
+// Add -
+func (bts *BlockTimestampsHandlerStub) Add(hash string, timestamp uint64) {
+	if bts.AddCalled != nil {
+		bts.AddCalled(hash, timestamp)
+	}
+}
+
+// Get -
+func (bts *BlockTimestampsHandlerStub) Get(hash string) (uint64, bool) {
+	if bts.GetCalled != nil {
+		return bts.GetCalled(hash)
+	}
+
+	return 0, false
+}
+
+// IsInterfaceNil -
+func (bts *BlockTimestampsHandlerStub) IsInterfaceNil() bool {
+	return bts == nil
+}
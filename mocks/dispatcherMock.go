@@ -1,6 +1,8 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
@@ -52,6 +54,59 @@ func (d *DispatcherMock) TxsEvent(event data.BlockTxs) {
 func (d *DispatcherMock) ScrsEvent(event data.BlockScrs) {
 }
 
+// RewardsEvent -
+func (d *DispatcherMock) RewardsEvent(event data.BlockRewards) {
+}
+
+// ReceiptsEvent -
+func (d *DispatcherMock) ReceiptsEvent(event data.BlockReceipts) {
+}
+
+// InvalidTxsEvent -
+func (d *DispatcherMock) InvalidTxsEvent(event data.BlockInvalidTxs) {
+}
+
+// AlteredAccountsEvent -
+func (d *DispatcherMock) AlteredAccountsEvent(event data.BlockAlteredAccounts) {
+}
+
+// BlockHeaderEvent -
+func (d *DispatcherMock) BlockHeaderEvent(event data.BlockHeader) {
+}
+
+// EpochStartEvent -
+func (d *DispatcherMock) EpochStartEvent(event data.EpochStart) {
+}
+
+// TxBundlesEvent -
+func (d *DispatcherMock) TxBundlesEvent(event data.BlockTxBundles) {
+}
+
+// TxStatusEvent -
+func (d *DispatcherMock) TxStatusEvent(event data.TxStatus) {
+}
+
+// SourceStaleEvent -
+func (d *DispatcherMock) SourceStaleEvent(event data.SourceStaleEvent) {
+}
+
+// GetInfo -
+func (d *DispatcherMock) GetInfo() data.DispatcherInfo {
+	return data.DispatcherInfo{
+		ID: d.id,
+	}
+}
+
+// Disconnect -
+func (d *DispatcherMock) Disconnect() error {
+	return nil
+}
+
+// Shutdown -
+func (d *DispatcherMock) Shutdown(_ context.Context) error {
+	return nil
+}
+
 // Subscribe -
 func (d *DispatcherMock) Subscribe(event data.SubscribeEvent) {
 	d.hub.Subscribe(event)
@@ -1,19 +1,33 @@
 package mocks
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 )
 
 // DispatcherStub implements dispatcher EventDispatcher interface
 type DispatcherStub struct {
-	GetIDCalled          func() uuid.UUID
-	PushEventsCalled     func(events []data.Event)
-	BlockEventsCalled    func(event data.BlockEventsWithOrder)
-	RevertEventCalled    func(event data.RevertBlock)
-	FinalizedEventCalled func(event data.FinalizedBlock)
-	TxsEventCalled       func(event data.BlockTxs)
-	ScrsEventCalled      func(event data.BlockScrs)
+	GetIDCalled                func() uuid.UUID
+	PushEventsCalled           func(events []data.Event)
+	BlockEventsCalled          func(event data.BlockEventsWithOrder)
+	RevertEventCalled          func(event data.RevertBlock)
+	FinalizedEventCalled       func(event data.FinalizedBlock)
+	TxsEventCalled             func(event data.BlockTxs)
+	ScrsEventCalled            func(event data.BlockScrs)
+	RewardsEventCalled         func(event data.BlockRewards)
+	ReceiptsEventCalled        func(event data.BlockReceipts)
+	InvalidTxsEventCalled      func(event data.BlockInvalidTxs)
+	AlteredAccountsEventCalled func(event data.BlockAlteredAccounts)
+	BlockHeaderEventCalled     func(event data.BlockHeader)
+	EpochStartEventCalled      func(event data.EpochStart)
+	TxBundlesEventCalled       func(event data.BlockTxBundles)
+	TxStatusEventCalled        func(event data.TxStatus)
+	SourceStaleEventCalled     func(event data.SourceStaleEvent)
+	GetInfoCalled              func() data.DispatcherInfo
+	DisconnectCalled           func() error
+	ShutdownCalled             func(ctx context.Context) error
 }
 
 // GetID -
@@ -66,3 +80,93 @@ func (d *DispatcherStub) ScrsEvent(event data.BlockScrs) {
 		d.ScrsEventCalled(event)
 	}
 }
+
+// RewardsEvent -
+func (d *DispatcherStub) RewardsEvent(event data.BlockRewards) {
+	if d.RewardsEventCalled != nil {
+		d.RewardsEventCalled(event)
+	}
+}
+
+// ReceiptsEvent -
+func (d *DispatcherStub) ReceiptsEvent(event data.BlockReceipts) {
+	if d.ReceiptsEventCalled != nil {
+		d.ReceiptsEventCalled(event)
+	}
+}
+
+// InvalidTxsEvent -
+func (d *DispatcherStub) InvalidTxsEvent(event data.BlockInvalidTxs) {
+	if d.InvalidTxsEventCalled != nil {
+		d.InvalidTxsEventCalled(event)
+	}
+}
+
+// AlteredAccountsEvent -
+func (d *DispatcherStub) AlteredAccountsEvent(event data.BlockAlteredAccounts) {
+	if d.AlteredAccountsEventCalled != nil {
+		d.AlteredAccountsEventCalled(event)
+	}
+}
+
+// BlockHeaderEvent -
+func (d *DispatcherStub) BlockHeaderEvent(event data.BlockHeader) {
+	if d.BlockHeaderEventCalled != nil {
+		d.BlockHeaderEventCalled(event)
+	}
+}
+
+// EpochStartEvent -
+func (d *DispatcherStub) EpochStartEvent(event data.EpochStart) {
+	if d.EpochStartEventCalled != nil {
+		d.EpochStartEventCalled(event)
+	}
+}
+
+// TxBundlesEvent -
+func (d *DispatcherStub) TxBundlesEvent(event data.BlockTxBundles) {
+	if d.TxBundlesEventCalled != nil {
+		d.TxBundlesEventCalled(event)
+	}
+}
+
+// TxStatusEvent -
+func (d *DispatcherStub) TxStatusEvent(event data.TxStatus) {
+	if d.TxStatusEventCalled != nil {
+		d.TxStatusEventCalled(event)
+	}
+}
+
+// SourceStaleEvent -
+func (d *DispatcherStub) SourceStaleEvent(event data.SourceStaleEvent) {
+	if d.SourceStaleEventCalled != nil {
+		d.SourceStaleEventCalled(event)
+	}
+}
+
+// GetInfo -
+func (d *DispatcherStub) GetInfo() data.DispatcherInfo {
+	if d.GetInfoCalled != nil {
+		return d.GetInfoCalled()
+	}
+
+	return data.DispatcherInfo{}
+}
+
+// Disconnect -
+func (d *DispatcherStub) Disconnect() error {
+	if d.DisconnectCalled != nil {
+		return d.DisconnectCalled()
+	}
+
+	return nil
+}
+
+// Shutdown -
+func (d *DispatcherStub) Shutdown(ctx context.Context) error {
+	if d.ShutdownCalled != nil {
+		return d.ShutdownCalled(ctx)
+	}
+
+	return nil
+}
@@ -9,7 +9,16 @@ type EventsHandlerStub struct {
 	HandleFinalizedEventsCalled      func(finalizedBlock data.FinalizedBlock)
 	HandleBlockTxsCalled             func(blockTxs data.BlockTxs)
 	HandleBlockScrsCalled            func(blockScrs data.BlockScrs)
+	HandleBlockRewardsCalled         func(blockRewards data.BlockRewards)
+	HandleBlockReceiptsCalled        func(blockReceipts data.BlockReceipts)
+	HandleBlockInvalidTxsCalled      func(blockInvalidTxs data.BlockInvalidTxs)
+	HandleAlteredAccountsCalled      func(blockAlteredAccounts data.BlockAlteredAccounts)
+	HandleBlockHeaderCalled          func(blockHeader data.BlockHeader)
+	HandleEpochStartCalled           func(epochStart data.EpochStart)
+	HandleTxBundlesCalled            func(blockTxBundles data.BlockTxBundles)
+	HandleTxStatusCalled             func(txStatus data.TxStatus)
 	HandleBlockEventsWithOrderCalled func(blockTxs data.BlockEventsWithOrder)
+	HandleSourceStaleCalled          func(event data.SourceStaleEvent)
 }
 
 // HandlePushEvents -
@@ -49,6 +58,62 @@ func (e *EventsHandlerStub) HandleBlockScrs(blockScrs data.BlockScrs) {
 	}
 }
 
+// HandleBlockRewards -
+func (e *EventsHandlerStub) HandleBlockRewards(blockRewards data.BlockRewards) {
+	if e.HandleBlockRewardsCalled != nil {
+		e.HandleBlockRewardsCalled(blockRewards)
+	}
+}
+
+// HandleBlockReceipts -
+func (e *EventsHandlerStub) HandleBlockReceipts(blockReceipts data.BlockReceipts) {
+	if e.HandleBlockReceiptsCalled != nil {
+		e.HandleBlockReceiptsCalled(blockReceipts)
+	}
+}
+
+// HandleBlockInvalidTxs -
+func (e *EventsHandlerStub) HandleBlockInvalidTxs(blockInvalidTxs data.BlockInvalidTxs) {
+	if e.HandleBlockInvalidTxsCalled != nil {
+		e.HandleBlockInvalidTxsCalled(blockInvalidTxs)
+	}
+}
+
+// HandleAlteredAccounts -
+func (e *EventsHandlerStub) HandleAlteredAccounts(blockAlteredAccounts data.BlockAlteredAccounts) {
+	if e.HandleAlteredAccountsCalled != nil {
+		e.HandleAlteredAccountsCalled(blockAlteredAccounts)
+	}
+}
+
+// HandleBlockHeader -
+func (e *EventsHandlerStub) HandleBlockHeader(blockHeader data.BlockHeader) {
+	if e.HandleBlockHeaderCalled != nil {
+		e.HandleBlockHeaderCalled(blockHeader)
+	}
+}
+
+// HandleEpochStart -
+func (e *EventsHandlerStub) HandleEpochStart(epochStart data.EpochStart) {
+	if e.HandleEpochStartCalled != nil {
+		e.HandleEpochStartCalled(epochStart)
+	}
+}
+
+// HandleTxBundles -
+func (e *EventsHandlerStub) HandleTxBundles(blockTxBundles data.BlockTxBundles) {
+	if e.HandleTxBundlesCalled != nil {
+		e.HandleTxBundlesCalled(blockTxBundles)
+	}
+}
+
+// HandleTxStatus -
+func (e *EventsHandlerStub) HandleTxStatus(txStatus data.TxStatus) {
+	if e.HandleTxStatusCalled != nil {
+		e.HandleTxStatusCalled(txStatus)
+	}
+}
+
 // HandleBlockEventsWithOrder -
 func (e *EventsHandlerStub) HandleBlockEventsWithOrder(blockTxs data.BlockEventsWithOrder) {
 	if e.HandleBlockEventsWithOrderCalled != nil {
@@ -56,6 +121,13 @@ func (e *EventsHandlerStub) HandleBlockEventsWithOrder(blockTxs data.BlockEvents
 	}
 }
 
+// HandleSourceStale -
+func (e *EventsHandlerStub) HandleSourceStale(event data.SourceStaleEvent) {
+	if e.HandleSourceStaleCalled != nil {
+		e.HandleSourceStaleCalled(event)
+	}
+}
+
 // IsInterfaceNil -
 func (e *EventsHandlerStub) IsInterfaceNil() bool {
 	return e == nil
@@ -1,32 +1,72 @@
 package mocks
 
 import (
+	"context"
 	"net/http"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 )
 
 // FacadeStub implements FacadeHandler interface
 type FacadeStub struct {
-	HandlePushEventsV2Called      func(events data.ArgsSaveBlockData) error
-	HandlePushEventsV1Called      func(eventsData data.SaveBlockData) error
-	HandleRevertEventsCalled      func(events data.RevertBlock)
-	HandleFinalizedEventsCalled   func(events data.FinalizedBlock)
-	ServeCalled                   func(w http.ResponseWriter, r *http.Request)
-	GetConnectorUserAndPassCalled func() (string, string)
-	GetMetricsCalled              func() map[string]*data.EndpointMetricsResponse
-	GetMetricsForPrometheusCalled func() string
+	HandlePushEventsV2Called            func(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error
+	EnqueuePushEventsV2Called           func(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error
+	IsIngestionQueueEnabledCalled       func() bool
+	HandlePushEventsV1Called            func(eventsData data.SaveBlockData) error
+	HandleRevertEventsCalled            func(events data.RevertBlock)
+	HandleFinalizedEventsCalled         func(events data.FinalizedBlock)
+	ServeCalled                         func(w http.ResponseWriter, r *http.Request)
+	GetConnectorUserAndPassCalled       func() (string, string)
+	IsObserverCertificateRequiredCalled func() bool
+	GetObserversAuthConfigCalled        func() config.ObserversAuthConfig
+	GetMaxBodySizeCalled                func() int64
+	AddObserverRequestCalled            func(observerID string)
+	ObserveStageCalled                  func(stage string, duration time.Duration)
+	AddStageErrorCalled                 func(stage string)
+	RecordObserverErrorCalled           func(observerID string)
+	BeginPushCalled                     func() bool
+	EndPushCalled                       func()
+	GetObserversHealthCalled            func() data.ObserversHealth
+	GetHealthCalled                     func() data.HealthStatus
+	GetReadinessCalled                  func() data.HealthStatus
+	GetMetricsCalled                    func() map[string]*data.EndpointMetricsResponse
+	GetMetricsForPrometheusCalled       func() string
+	GetAdminUserAndPassCalled           func() (string, string)
+	GetDispatchersCalled                func() []data.DispatcherInfo
+	DisconnectDispatcherCalled          func(dispatcherID uuid.UUID) error
+	GetSubscriptionsStatsCalled         func() data.SubscriptionsStats
 }
 
 // HandlePushEventsV2 -
-func (fs *FacadeStub) HandlePushEventsV2(events data.ArgsSaveBlockData) error {
+func (fs *FacadeStub) HandlePushEventsV2(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error {
 	if fs.HandlePushEventsV2Called != nil {
-		return fs.HandlePushEventsV2Called(events)
+		return fs.HandlePushEventsV2Called(ctx, events, observerID)
 	}
 
 	return nil
 }
 
+// EnqueuePushEventsV2 -
+func (fs *FacadeStub) EnqueuePushEventsV2(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error {
+	if fs.EnqueuePushEventsV2Called != nil {
+		return fs.EnqueuePushEventsV2Called(ctx, events, observerID)
+	}
+
+	return nil
+}
+
+// IsIngestionQueueEnabled -
+func (fs *FacadeStub) IsIngestionQueueEnabled() bool {
+	if fs.IsIngestionQueueEnabledCalled != nil {
+		return fs.IsIngestionQueueEnabledCalled()
+	}
+
+	return false
+}
+
 // HandlePushEventsV1 -
 func (fs *FacadeStub) HandlePushEventsV1(events data.SaveBlockData) error {
 	if fs.HandlePushEventsV1Called != nil {
@@ -66,6 +106,104 @@ func (fs *FacadeStub) GetConnectorUserAndPass() (string, string) {
 	return "", ""
 }
 
+// IsObserverCertificateRequired -
+func (fs *FacadeStub) IsObserverCertificateRequired() bool {
+	if fs.IsObserverCertificateRequiredCalled != nil {
+		return fs.IsObserverCertificateRequiredCalled()
+	}
+
+	return false
+}
+
+// GetMaxBodySize -
+func (fs *FacadeStub) GetMaxBodySize() int64 {
+	if fs.GetMaxBodySizeCalled != nil {
+		return fs.GetMaxBodySizeCalled()
+	}
+
+	return 0
+}
+
+// GetObserversAuthConfig -
+func (fs *FacadeStub) GetObserversAuthConfig() config.ObserversAuthConfig {
+	if fs.GetObserversAuthConfigCalled != nil {
+		return fs.GetObserversAuthConfigCalled()
+	}
+
+	return config.ObserversAuthConfig{}
+}
+
+// AddObserverRequest -
+func (fs *FacadeStub) AddObserverRequest(observerID string) {
+	if fs.AddObserverRequestCalled != nil {
+		fs.AddObserverRequestCalled(observerID)
+	}
+}
+
+// ObserveStage -
+func (fs *FacadeStub) ObserveStage(stage string, duration time.Duration) {
+	if fs.ObserveStageCalled != nil {
+		fs.ObserveStageCalled(stage, duration)
+	}
+}
+
+// AddStageError -
+func (fs *FacadeStub) AddStageError(stage string) {
+	if fs.AddStageErrorCalled != nil {
+		fs.AddStageErrorCalled(stage)
+	}
+}
+
+// RecordObserverError -
+func (fs *FacadeStub) RecordObserverError(observerID string) {
+	if fs.RecordObserverErrorCalled != nil {
+		fs.RecordObserverErrorCalled(observerID)
+	}
+}
+
+// BeginPush -
+func (fs *FacadeStub) BeginPush() bool {
+	if fs.BeginPushCalled != nil {
+		return fs.BeginPushCalled()
+	}
+
+	return true
+}
+
+// EndPush -
+func (fs *FacadeStub) EndPush() {
+	if fs.EndPushCalled != nil {
+		fs.EndPushCalled()
+	}
+}
+
+// GetObserversHealth -
+func (fs *FacadeStub) GetObserversHealth() data.ObserversHealth {
+	if fs.GetObserversHealthCalled != nil {
+		return fs.GetObserversHealthCalled()
+	}
+
+	return data.ObserversHealth{}
+}
+
+// GetHealth -
+func (fs *FacadeStub) GetHealth() data.HealthStatus {
+	if fs.GetHealthCalled != nil {
+		return fs.GetHealthCalled()
+	}
+
+	return data.HealthStatus{}
+}
+
+// GetReadiness -
+func (fs *FacadeStub) GetReadiness() data.HealthStatus {
+	if fs.GetReadinessCalled != nil {
+		return fs.GetReadinessCalled()
+	}
+
+	return data.HealthStatus{}
+}
+
 // GetMetrics -
 func (fs *FacadeStub) GetMetrics() map[string]*data.EndpointMetricsResponse {
 	if fs.GetMetricsCalled != nil {
@@ -84,6 +222,42 @@ func (fs *FacadeStub) GetMetricsForPrometheus() string {
 	return ""
 }
 
+// GetAdminUserAndPass -
+func (fs *FacadeStub) GetAdminUserAndPass() (string, string) {
+	if fs.GetAdminUserAndPassCalled != nil {
+		return fs.GetAdminUserAndPassCalled()
+	}
+
+	return "", ""
+}
+
+// GetDispatchers -
+func (fs *FacadeStub) GetDispatchers() []data.DispatcherInfo {
+	if fs.GetDispatchersCalled != nil {
+		return fs.GetDispatchersCalled()
+	}
+
+	return nil
+}
+
+// DisconnectDispatcher -
+func (fs *FacadeStub) DisconnectDispatcher(dispatcherID uuid.UUID) error {
+	if fs.DisconnectDispatcherCalled != nil {
+		return fs.DisconnectDispatcherCalled(dispatcherID)
+	}
+
+	return nil
+}
+
+// GetSubscriptionsStats -
+func (fs *FacadeStub) GetSubscriptionsStats() data.SubscriptionsStats {
+	if fs.GetSubscriptionsStatsCalled != nil {
+		return fs.GetSubscriptionsStatsCalled()
+	}
+
+	return data.SubscriptionsStats{}
+}
+
 // IsInterfaceNil -
 func (fs *FacadeStub) IsInterfaceNil() bool {
 	return fs == nil
@@ -0,0 +1,22 @@
+package mocks
+
+import "context"
+
+// HealthProbeStub implements Probe interface
+type HealthProbeStub struct {
+	CheckHealthCalled func(ctx context.Context) error
+}
+
+// CheckHealth -
+func (hps *HealthProbeStub) CheckHealth(ctx context.Context) error {
+	if hps.CheckHealthCalled != nil {
+		return hps.CheckHealthCalled(ctx)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (hps *HealthProbeStub) IsInterfaceNil() bool {
+	return hps == nil
+}
@@ -0,0 +1,32 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// HealthServiceStub implements HealthService interface
+type HealthServiceStub struct {
+	GetHealthCalled    func() data.HealthStatus
+	GetReadinessCalled func() data.HealthStatus
+}
+
+// GetHealth -
+func (hss *HealthServiceStub) GetHealth() data.HealthStatus {
+	if hss.GetHealthCalled != nil {
+		return hss.GetHealthCalled()
+	}
+
+	return data.HealthStatus{}
+}
+
+// GetReadiness -
+func (hss *HealthServiceStub) GetReadiness() data.HealthStatus {
+	if hss.GetReadinessCalled != nil {
+		return hss.GetReadinessCalled()
+	}
+
+	return data.HealthStatus{}
+}
+
+// IsInterfaceNil -
+func (hss *HealthServiceStub) IsInterfaceNil() bool {
+	return hss == nil
+}
@@ -1,6 +1,9 @@
 package mocks
 
 import (
+	"context"
+
+	"github.com/google/uuid"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
 )
@@ -13,10 +16,24 @@ type HubStub struct {
 	BroadcastFinalizedCalled            func(event data.FinalizedBlock)
 	BroadcastTxsCalled                  func(event data.BlockTxs)
 	BroadcastScrsCalled                 func(event data.BlockScrs)
+	BroadcastRewardsCalled              func(event data.BlockRewards)
+	BroadcastReceiptsCalled             func(event data.BlockReceipts)
+	BroadcastInvalidTxsCalled           func(event data.BlockInvalidTxs)
+	BroadcastAlteredAccountsCalled      func(event data.BlockAlteredAccounts)
+	BroadcastBlockHeaderCalled          func(event data.BlockHeader)
+	BroadcastEpochStartCalled           func(event data.EpochStart)
+	BroadcastTxBundlesCalled            func(event data.BlockTxBundles)
+	BroadcastTxStatusCalled             func(event data.TxStatus)
 	BroadcastBlockEventsWithOrderCalled func(event data.BlockEventsWithOrder)
+	CheckHealthCalled                   func(ctx context.Context) error
+	BroadcastSourceStaleCalled          func(event data.SourceStaleEvent)
 	RegisterEventCalled                 func(event dispatcher.EventDispatcher)
 	UnregisterEventCalled               func(event dispatcher.EventDispatcher)
 	SubscribeCalled                     func(event data.SubscribeEvent)
+	GetDispatchersCalled                func() []data.DispatcherInfo
+	DisconnectDispatcherCalled          func(dispatcherID uuid.UUID) error
+	GetSubscriptionsStatsCalled         func() data.SubscriptionsStats
+	DrainCalled                         func(ctx context.Context) error
 	CloseCalled                         func() error
 }
 
@@ -62,6 +79,78 @@ func (h *HubStub) BroadcastScrs(event data.BlockScrs) {
 	}
 }
 
+// BroadcastRewards -
+func (h *HubStub) BroadcastRewards(event data.BlockRewards) {
+	if h.BroadcastRewardsCalled != nil {
+		h.BroadcastRewardsCalled(event)
+	}
+}
+
+// BroadcastReceipts -
+func (h *HubStub) BroadcastReceipts(event data.BlockReceipts) {
+	if h.BroadcastReceiptsCalled != nil {
+		h.BroadcastReceiptsCalled(event)
+	}
+}
+
+// BroadcastInvalidTxs -
+func (h *HubStub) BroadcastInvalidTxs(event data.BlockInvalidTxs) {
+	if h.BroadcastInvalidTxsCalled != nil {
+		h.BroadcastInvalidTxsCalled(event)
+	}
+}
+
+// BroadcastAlteredAccounts -
+func (h *HubStub) BroadcastAlteredAccounts(event data.BlockAlteredAccounts) {
+	if h.BroadcastAlteredAccountsCalled != nil {
+		h.BroadcastAlteredAccountsCalled(event)
+	}
+}
+
+// BroadcastBlockHeader -
+func (h *HubStub) BroadcastBlockHeader(event data.BlockHeader) {
+	if h.BroadcastBlockHeaderCalled != nil {
+		h.BroadcastBlockHeaderCalled(event)
+	}
+}
+
+// BroadcastEpochStart -
+func (h *HubStub) BroadcastEpochStart(event data.EpochStart) {
+	if h.BroadcastEpochStartCalled != nil {
+		h.BroadcastEpochStartCalled(event)
+	}
+}
+
+// BroadcastTxBundles -
+func (h *HubStub) BroadcastTxBundles(event data.BlockTxBundles) {
+	if h.BroadcastTxBundlesCalled != nil {
+		h.BroadcastTxBundlesCalled(event)
+	}
+}
+
+// BroadcastTxStatus -
+func (h *HubStub) BroadcastTxStatus(event data.TxStatus) {
+	if h.BroadcastTxStatusCalled != nil {
+		h.BroadcastTxStatusCalled(event)
+	}
+}
+
+// CheckHealth -
+func (h *HubStub) CheckHealth(ctx context.Context) error {
+	if h.CheckHealthCalled != nil {
+		return h.CheckHealthCalled(ctx)
+	}
+
+	return nil
+}
+
+// BroadcastSourceStale -
+func (h *HubStub) BroadcastSourceStale(event data.SourceStaleEvent) {
+	if h.BroadcastSourceStaleCalled != nil {
+		h.BroadcastSourceStaleCalled(event)
+	}
+}
+
 // BroadcastBlockEventsWithOrder -
 func (h *HubStub) BroadcastBlockEventsWithOrder(event data.BlockEventsWithOrder) {
 	if h.BroadcastBlockEventsWithOrderCalled != nil {
@@ -90,6 +179,42 @@ func (h *HubStub) Subscribe(event data.SubscribeEvent) {
 	}
 }
 
+// GetDispatchers -
+func (h *HubStub) GetDispatchers() []data.DispatcherInfo {
+	if h.GetDispatchersCalled != nil {
+		return h.GetDispatchersCalled()
+	}
+
+	return nil
+}
+
+// DisconnectDispatcher -
+func (h *HubStub) DisconnectDispatcher(dispatcherID uuid.UUID) error {
+	if h.DisconnectDispatcherCalled != nil {
+		return h.DisconnectDispatcherCalled(dispatcherID)
+	}
+
+	return nil
+}
+
+// GetSubscriptionsStats -
+func (h *HubStub) GetSubscriptionsStats() data.SubscriptionsStats {
+	if h.GetSubscriptionsStatsCalled != nil {
+		return h.GetSubscriptionsStatsCalled()
+	}
+
+	return data.SubscriptionsStats{}
+}
+
+// Drain -
+func (h *HubStub) Drain(ctx context.Context) error {
+	if h.DrainCalled != nil {
+		return h.DrainCalled(ctx)
+	}
+
+	return nil
+}
+
 // Close -
 func (h *HubStub) Close() error {
 	return nil
@@ -0,0 +1,42 @@
+package mocks
+
+import "context"
+
+// IngestionQueueStub -
+type IngestionQueueStub struct {
+	EnqueueCalled func(shardID uint32, processHandler func()) error
+	DrainCalled   func(ctx context.Context) error
+	CloseCalled   func() error
+}
+
+// Enqueue -
+func (iqs *IngestionQueueStub) Enqueue(shardID uint32, processHandler func()) error {
+	if iqs.EnqueueCalled != nil {
+		return iqs.EnqueueCalled(shardID, processHandler)
+	}
+
+	return nil
+}
+
+// Drain -
+func (iqs *IngestionQueueStub) Drain(ctx context.Context) error {
+	if iqs.DrainCalled != nil {
+		return iqs.DrainCalled(ctx)
+	}
+
+	return nil
+}
+
+// Close -
+func (iqs *IngestionQueueStub) Close() error {
+	if iqs.CloseCalled != nil {
+		return iqs.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (iqs *IngestionQueueStub) IsInterfaceNil() bool {
+	return iqs == nil
+}
@@ -5,6 +5,7 @@ import "context"
 // LockerStub implements LockService interface
 type LockerStub struct {
 	IsEventProcessedCalled func(ctx context.Context, blockHash string) (bool, error)
+	AddToSetCalled         func(ctx context.Context, key string, member string) (int64, error)
 	HasConnectionCalled    func(ctx context.Context) bool
 }
 
@@ -17,6 +18,15 @@ func (ls *LockerStub) IsEventProcessed(ctx context.Context, blockHash string) (b
 	return false, nil
 }
 
+// AddToSet -
+func (ls *LockerStub) AddToSet(ctx context.Context, key string, member string) (int64, error) {
+	if ls.AddToSetCalled != nil {
+		return ls.AddToSetCalled(ctx, key, member)
+	}
+
+	return 0, nil
+}
+
 // HasConnection -
 func (ls *LockerStub) HasConnection(ctx context.Context) bool {
 	if ls.HasConnectionCalled != nil {
@@ -0,0 +1,20 @@
+package mocks
+
+// NativeAuthVerifierStub -
+type NativeAuthVerifierStub struct {
+	VerifyCalled func(token string) (string, error)
+}
+
+// Verify -
+func (nav *NativeAuthVerifierStub) Verify(token string) (string, error) {
+	if nav.VerifyCalled != nil {
+		return nav.VerifyCalled(token)
+	}
+
+	return "", nil
+}
+
+// IsInterfaceNil -
+func (nav *NativeAuthVerifierStub) IsInterfaceNil() bool {
+	return nav == nil
+}
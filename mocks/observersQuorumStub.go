@@ -0,0 +1,33 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// ObserversQuorumStub implements ObserversQuorum interface
+type ObserversQuorumStub struct {
+	ProcessBlockCalled func(vote data.ObserverBlockVote, publishHandler func())
+	CloseCalled        func() error
+}
+
+// ProcessBlock -
+func (oqs *ObserversQuorumStub) ProcessBlock(vote data.ObserverBlockVote, publishHandler func()) {
+	if oqs.ProcessBlockCalled != nil {
+		oqs.ProcessBlockCalled(vote, publishHandler)
+		return
+	}
+
+	publishHandler()
+}
+
+// Close -
+func (oqs *ObserversQuorumStub) Close() error {
+	if oqs.CloseCalled != nil {
+		return oqs.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (oqs *ObserversQuorumStub) IsInterfaceNil() bool {
+	return oqs == nil
+}
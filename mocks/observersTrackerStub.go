@@ -0,0 +1,58 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// ObserversTrackerStub implements ObserversTracker interface
+type ObserversTrackerStub struct {
+	RecordPushCalled              func(observerID string, shardID uint32, nonce uint64)
+	RecordErrorCalled             func(observerID string)
+	GetObserversHealthCalled      func() data.ObserversHealth
+	GetMetricsForPrometheusCalled func() string
+	CloseCalled                   func() error
+}
+
+// RecordPush -
+func (ots *ObserversTrackerStub) RecordPush(observerID string, shardID uint32, nonce uint64) {
+	if ots.RecordPushCalled != nil {
+		ots.RecordPushCalled(observerID, shardID, nonce)
+	}
+}
+
+// RecordError -
+func (ots *ObserversTrackerStub) RecordError(observerID string) {
+	if ots.RecordErrorCalled != nil {
+		ots.RecordErrorCalled(observerID)
+	}
+}
+
+// GetObserversHealth -
+func (ots *ObserversTrackerStub) GetObserversHealth() data.ObserversHealth {
+	if ots.GetObserversHealthCalled != nil {
+		return ots.GetObserversHealthCalled()
+	}
+
+	return data.ObserversHealth{}
+}
+
+// GetMetricsForPrometheus -
+func (ots *ObserversTrackerStub) GetMetricsForPrometheus() string {
+	if ots.GetMetricsForPrometheusCalled != nil {
+		return ots.GetMetricsForPrometheusCalled()
+	}
+
+	return ""
+}
+
+// Close -
+func (ots *ObserversTrackerStub) Close() error {
+	if ots.CloseCalled != nil {
+		return ots.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (ots *ObserversTrackerStub) IsInterfaceNil() bool {
+	return ots == nil
+}
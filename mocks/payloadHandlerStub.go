@@ -0,0 +1,22 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// PayloadHandlerStub implements PayloadHandler interface
+type PayloadHandlerStub struct {
+	ProcessPayloadCalled func(operation data.OperationType, payload []byte, observerID string) error
+}
+
+// ProcessPayload -
+func (phs *PayloadHandlerStub) ProcessPayload(operation data.OperationType, payload []byte, observerID string) error {
+	if phs.ProcessPayloadCalled != nil {
+		return phs.ProcessPayloadCalled(operation, payload, observerID)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (phs *PayloadHandlerStub) IsInterfaceNil() bool {
+	return phs == nil
+}
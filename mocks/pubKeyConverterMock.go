@@ -2,6 +2,8 @@ package mocks
 
 import (
 	"encoding/hex"
+
+	"github.com/multiversx/mx-chain-core-go/core"
 )
 
 // PubkeyConverterMock -
@@ -22,10 +24,25 @@ func (pcm *PubkeyConverterMock) Decode(humanReadable string) ([]byte, error) {
 }
 
 // Encode -
-func (pcm *PubkeyConverterMock) Encode(pkBytes []byte) string {
+func (pcm *PubkeyConverterMock) Encode(pkBytes []byte) (string, error) {
+	return hex.EncodeToString(pkBytes), nil
+}
+
+// SilentEncode -
+func (pcm *PubkeyConverterMock) SilentEncode(pkBytes []byte, _ core.Logger) string {
 	return hex.EncodeToString(pkBytes)
 }
 
+// EncodeSlice -
+func (pcm *PubkeyConverterMock) EncodeSlice(pkBytesSlice [][]byte) ([]string, error) {
+	encodedSlice := make([]string, 0, len(pkBytesSlice))
+	for _, pkBytes := range pkBytesSlice {
+		encodedSlice = append(encodedSlice, hex.EncodeToString(pkBytes))
+	}
+
+	return encodedSlice, nil
+}
+
 // Len -
 func (pcm *PubkeyConverterMock) Len() int {
 	return pcm.len
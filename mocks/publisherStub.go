@@ -1,6 +1,10 @@
 package mocks
 
-import "github.com/multiversx/mx-chain-notifier-go/data"
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
 
 // PublisherStub implements PublisherService interface
 type PublisherStub struct {
@@ -10,7 +14,18 @@ type PublisherStub struct {
 	BroadcastFinalizedCalled            func(event data.FinalizedBlock)
 	BroadcastTxsCalled                  func(event data.BlockTxs)
 	BroadcastScrsCalled                 func(event data.BlockScrs)
+	BroadcastRewardsCalled              func(event data.BlockRewards)
+	BroadcastReceiptsCalled             func(event data.BlockReceipts)
+	BroadcastInvalidTxsCalled           func(event data.BlockInvalidTxs)
+	BroadcastAlteredAccountsCalled      func(event data.BlockAlteredAccounts)
+	BroadcastBlockHeaderCalled          func(event data.BlockHeader)
+	BroadcastEpochStartCalled           func(event data.EpochStart)
+	BroadcastTxBundlesCalled            func(event data.BlockTxBundles)
+	BroadcastTxStatusCalled             func(event data.TxStatus)
 	BroadcastBlockEventsWithOrderCalled func(event data.BlockEventsWithOrder)
+	CheckHealthCalled                   func(ctx context.Context) error
+	BroadcastSourceStaleCalled          func(event data.SourceStaleEvent)
+	DrainCalled                         func(ctx context.Context) error
 }
 
 // Run -
@@ -55,6 +70,78 @@ func (ps *PublisherStub) BroadcastScrs(event data.BlockScrs) {
 	}
 }
 
+// BroadcastRewards -
+func (ps *PublisherStub) BroadcastRewards(event data.BlockRewards) {
+	if ps.BroadcastRewardsCalled != nil {
+		ps.BroadcastRewardsCalled(event)
+	}
+}
+
+// BroadcastReceipts -
+func (ps *PublisherStub) BroadcastReceipts(event data.BlockReceipts) {
+	if ps.BroadcastReceiptsCalled != nil {
+		ps.BroadcastReceiptsCalled(event)
+	}
+}
+
+// BroadcastInvalidTxs -
+func (ps *PublisherStub) BroadcastInvalidTxs(event data.BlockInvalidTxs) {
+	if ps.BroadcastInvalidTxsCalled != nil {
+		ps.BroadcastInvalidTxsCalled(event)
+	}
+}
+
+// BroadcastAlteredAccounts -
+func (ps *PublisherStub) BroadcastAlteredAccounts(event data.BlockAlteredAccounts) {
+	if ps.BroadcastAlteredAccountsCalled != nil {
+		ps.BroadcastAlteredAccountsCalled(event)
+	}
+}
+
+// BroadcastBlockHeader -
+func (ps *PublisherStub) BroadcastBlockHeader(event data.BlockHeader) {
+	if ps.BroadcastBlockHeaderCalled != nil {
+		ps.BroadcastBlockHeaderCalled(event)
+	}
+}
+
+// BroadcastEpochStart -
+func (ps *PublisherStub) BroadcastEpochStart(event data.EpochStart) {
+	if ps.BroadcastEpochStartCalled != nil {
+		ps.BroadcastEpochStartCalled(event)
+	}
+}
+
+// BroadcastTxBundles -
+func (ps *PublisherStub) BroadcastTxBundles(event data.BlockTxBundles) {
+	if ps.BroadcastTxBundlesCalled != nil {
+		ps.BroadcastTxBundlesCalled(event)
+	}
+}
+
+// BroadcastTxStatus -
+func (ps *PublisherStub) BroadcastTxStatus(event data.TxStatus) {
+	if ps.BroadcastTxStatusCalled != nil {
+		ps.BroadcastTxStatusCalled(event)
+	}
+}
+
+// CheckHealth -
+func (ps *PublisherStub) CheckHealth(ctx context.Context) error {
+	if ps.CheckHealthCalled != nil {
+		return ps.CheckHealthCalled(ctx)
+	}
+
+	return nil
+}
+
+// BroadcastSourceStale -
+func (ps *PublisherStub) BroadcastSourceStale(event data.SourceStaleEvent) {
+	if ps.BroadcastSourceStaleCalled != nil {
+		ps.BroadcastSourceStaleCalled(event)
+	}
+}
+
 // BroadcastBlockEventsWithOrder -
 func (ps *PublisherStub) BroadcastBlockEventsWithOrder(event data.BlockEventsWithOrder) {
 	if ps.BroadcastBlockEventsWithOrderCalled != nil {
@@ -62,6 +149,15 @@ func (ps *PublisherStub) BroadcastBlockEventsWithOrder(event data.BlockEventsWit
 	}
 }
 
+// Drain -
+func (ps *PublisherStub) Drain(ctx context.Context) error {
+	if ps.DrainCalled != nil {
+		return ps.DrainCalled(ctx)
+	}
+
+	return nil
+}
+
 // IsInterfaceNil -
 func (ps *PublisherStub) IsInterfaceNil() bool {
 	return ps == nil
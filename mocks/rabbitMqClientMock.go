@@ -60,6 +60,11 @@ func (rc *RabbitClientMock) GetEntries() map[string]amqp.Publishing {
 	return rc.events
 }
 
+// IsConnected -
+func (rc *RabbitClientMock) IsConnected() bool {
+	return true
+}
+
 // Close -
 func (rc *RabbitClientMock) Close() {
 }
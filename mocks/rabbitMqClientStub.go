@@ -10,6 +10,7 @@ type RabbitClientStub struct {
 	CloseErrChanCalled    func() chan *amqp.Error
 	ReconnectCalled       func()
 	ReopenChannelCalled   func()
+	IsConnectedCalled     func() bool
 	CloseCalled           func()
 }
 
@@ -59,6 +60,14 @@ func (rc *RabbitClientStub) ReopenChannel() {
 	}
 }
 
+// IsConnected -
+func (rc *RabbitClientStub) IsConnected() bool {
+	if rc.IsConnectedCalled != nil {
+		return rc.IsConnectedCalled()
+	}
+	return true
+}
+
 // Close -
 func (rc *RabbitClientStub) Close() {
 	if rc.CloseCalled != nil {
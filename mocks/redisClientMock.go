@@ -2,20 +2,27 @@ package mocks
 
 import (
 	"context"
+	"sort"
 	"sync"
 	"time"
 )
 
 // RedisClientMock -
 type RedisClientMock struct {
-	mut     sync.Mutex
-	entries map[string]bool
+	mut        sync.Mutex
+	entries    map[string]bool
+	sets       map[string]map[string]struct{}
+	values     map[string]string
+	sortedSets map[string]map[string]float64
 }
 
 // NewRedisClientMock -
 func NewRedisClientMock() *RedisClientMock {
 	return &RedisClientMock{
-		entries: make(map[string]bool),
+		entries:    make(map[string]bool),
+		sets:       make(map[string]map[string]struct{}),
+		values:     make(map[string]string),
+		sortedSets: make(map[string]map[string]float64),
 	}
 }
 
@@ -48,6 +55,108 @@ func (rc *RedisClientMock) GetEntries() map[string]bool {
 	return rc.entries
 }
 
+// AddToSet -
+func (rc *RedisClientMock) AddToSet(_ context.Context, key string, member string, _ time.Duration) (int64, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+
+	set, ok := rc.sets[key]
+	if !ok {
+		set = make(map[string]struct{})
+		rc.sets[key] = set
+	}
+	set[member] = struct{}{}
+
+	return int64(len(set)), nil
+}
+
+// SetValueIfNotExists -
+func (rc *RedisClientMock) SetValueIfNotExists(_ context.Context, key string, value string, _ time.Duration) (bool, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+
+	if rc.values == nil {
+		rc.values = make(map[string]string)
+	}
+	if _, exists := rc.values[key]; exists {
+		return false, nil
+	}
+	rc.values[key] = value
+
+	return true, nil
+}
+
+// GetValue -
+func (rc *RedisClientMock) GetValue(_ context.Context, key string) (string, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+
+	return rc.values[key], nil
+}
+
+// DeleteKey -
+func (rc *RedisClientMock) DeleteKey(_ context.Context, key string) error {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+
+	delete(rc.values, key)
+	delete(rc.sortedSets, key)
+
+	return nil
+}
+
+// AddToSortedSet -
+func (rc *RedisClientMock) AddToSortedSet(_ context.Context, key string, member string, score float64, _ time.Duration) error {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+
+	if rc.sortedSets == nil {
+		rc.sortedSets = make(map[string]map[string]float64)
+	}
+	sortedSet, ok := rc.sortedSets[key]
+	if !ok {
+		sortedSet = make(map[string]float64)
+		rc.sortedSets[key] = sortedSet
+	}
+	if _, exists := sortedSet[member]; !exists {
+		sortedSet[member] = score
+	}
+
+	return nil
+}
+
+// GetSortedSetMembersByMaxScore -
+func (rc *RedisClientMock) GetSortedSetMembersByMaxScore(_ context.Context, key string, maxScore float64, count int64) ([]string, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+
+	members := make([]string, 0)
+	for member, score := range rc.sortedSets[key] {
+		if score <= maxScore {
+			members = append(members, member)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool {
+		return rc.sortedSets[key][members[i]] < rc.sortedSets[key][members[j]]
+	})
+	if int64(len(members)) > count {
+		members = members[:count]
+	}
+
+	return members, nil
+}
+
+// RemoveFromSortedSet -
+func (rc *RedisClientMock) RemoveFromSortedSet(_ context.Context, key string, member string) (bool, error) {
+	rc.mut.Lock()
+	defer rc.mut.Unlock()
+
+	_, exists := rc.sortedSets[key][member]
+	delete(rc.sortedSets[key], member)
+
+	return exists, nil
+}
+
 // Ping -
 func (rc *RedisClientMock) Ping(_ context.Context) (string, error) {
 	return "PONG", nil
@@ -7,9 +7,16 @@ import (
 
 // RedisClientStub -
 type RedisClientStub struct {
-	SetEntryCalled    func(key string, value bool, ttl time.Duration) (bool, error)
-	PingCalled        func() (string, error)
-	IsConnectedCalled func() bool
+	SetEntryCalled                      func(key string, value bool, ttl time.Duration) (bool, error)
+	AddToSetCalled                      func(key string, member string, ttl time.Duration) (int64, error)
+	SetValueIfNotExistsCalled           func(key string, value string, ttl time.Duration) (bool, error)
+	GetValueCalled                      func(key string) (string, error)
+	DeleteKeyCalled                     func(key string) error
+	AddToSortedSetCalled                func(key string, member string, score float64, ttl time.Duration) error
+	GetSortedSetMembersByMaxScoreCalled func(key string, maxScore float64, count int64) ([]string, error)
+	RemoveFromSortedSetCalled           func(key string, member string) (bool, error)
+	PingCalled                          func() (string, error)
+	IsConnectedCalled                   func() bool
 }
 
 // SetEntry -
@@ -21,6 +28,69 @@ func (rc *RedisClientStub) SetEntry(_ context.Context, key string, value bool, t
 	return false, nil
 }
 
+// AddToSet -
+func (rc *RedisClientStub) AddToSet(_ context.Context, key string, member string, ttl time.Duration) (int64, error) {
+	if rc.AddToSetCalled != nil {
+		return rc.AddToSetCalled(key, member, ttl)
+	}
+
+	return 0, nil
+}
+
+// SetValueIfNotExists -
+func (rc *RedisClientStub) SetValueIfNotExists(_ context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	if rc.SetValueIfNotExistsCalled != nil {
+		return rc.SetValueIfNotExistsCalled(key, value, ttl)
+	}
+
+	return false, nil
+}
+
+// GetValue -
+func (rc *RedisClientStub) GetValue(_ context.Context, key string) (string, error) {
+	if rc.GetValueCalled != nil {
+		return rc.GetValueCalled(key)
+	}
+
+	return "", nil
+}
+
+// DeleteKey -
+func (rc *RedisClientStub) DeleteKey(_ context.Context, key string) error {
+	if rc.DeleteKeyCalled != nil {
+		return rc.DeleteKeyCalled(key)
+	}
+
+	return nil
+}
+
+// AddToSortedSet -
+func (rc *RedisClientStub) AddToSortedSet(_ context.Context, key string, member string, score float64, ttl time.Duration) error {
+	if rc.AddToSortedSetCalled != nil {
+		return rc.AddToSortedSetCalled(key, member, score, ttl)
+	}
+
+	return nil
+}
+
+// GetSortedSetMembersByMaxScore -
+func (rc *RedisClientStub) GetSortedSetMembersByMaxScore(_ context.Context, key string, maxScore float64, count int64) ([]string, error) {
+	if rc.GetSortedSetMembersByMaxScoreCalled != nil {
+		return rc.GetSortedSetMembersByMaxScoreCalled(key, maxScore, count)
+	}
+
+	return nil, nil
+}
+
+// RemoveFromSortedSet -
+func (rc *RedisClientStub) RemoveFromSortedSet(_ context.Context, key string, member string) (bool, error) {
+	if rc.RemoveFromSortedSetCalled != nil {
+		return rc.RemoveFromSortedSetCalled(key, member)
+	}
+
+	return false, nil
+}
+
 // Ping -
 func (rc *RedisClientStub) Ping(_ context.Context) (string, error) {
 	if rc.PingCalled != nil {
@@ -0,0 +1,46 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+)
+
+// ServiceBusClientStub -
+type ServiceBusClientStub struct {
+	SendMessagesCalled func(ctx context.Context, topic string, messages []*azservicebus.Message) error
+	CheckHealthCalled  func(ctx context.Context) error
+	CloseCalled        func() error
+}
+
+// SendMessages -
+func (sbc *ServiceBusClientStub) SendMessages(ctx context.Context, topic string, messages []*azservicebus.Message) error {
+	if sbc.SendMessagesCalled != nil {
+		return sbc.SendMessagesCalled(ctx, topic, messages)
+	}
+
+	return nil
+}
+
+// CheckHealth -
+func (sbc *ServiceBusClientStub) CheckHealth(ctx context.Context) error {
+	if sbc.CheckHealthCalled != nil {
+		return sbc.CheckHealthCalled(ctx)
+	}
+
+	return nil
+}
+
+// Close -
+func (sbc *ServiceBusClientStub) Close() error {
+	if sbc.CloseCalled != nil {
+		return sbc.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (sbc *ServiceBusClientStub) IsInterfaceNil() bool {
+	return sbc == nil
+}
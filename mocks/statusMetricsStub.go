@@ -8,9 +8,20 @@ import (
 
 // StatusMetricsStub -
 type StatusMetricsStub struct {
-	AddRequestCalled              func(path string, duration time.Duration)
-	GetAllCalled                  func() map[string]*data.EndpointMetricsResponse
-	GetMetricsForPrometheusCalled func() string
+	AddRequestCalled               func(path string, duration time.Duration)
+	AddCounterCalled               func(operation string, value uint64)
+	ObserveStageCalled             func(stage string, duration time.Duration)
+	AddStageErrorCalled            func(stage string)
+	ObservePublishCalled           func(sink string, duration time.Duration)
+	AddEventsCalled                func(identifier string, shardID uint32, count uint64)
+	SetWSConnectionsCalled         func(count int)
+	SetWSSubscriptionsCalled       func(count int)
+	SetSubscriberConnectionsCalled func(subscriber string, count int)
+	AddSubscriberMessageCalled     func(subscriber string, status string)
+	AddSubscriberRejectionCalled   func(subscriber string, reason string)
+	SetIngestionQueueDepthCalled   func(shardID uint32, depth int)
+	GetAllCalled                   func() map[string]*data.EndpointMetricsResponse
+	GetMetricsForPrometheusCalled  func() string
 }
 
 // AddRequest -
@@ -20,6 +31,83 @@ func (s *StatusMetricsStub) AddRequest(path string, duration time.Duration) {
 	}
 }
 
+// AddCounter -
+func (s *StatusMetricsStub) AddCounter(operation string, value uint64) {
+	if s.AddCounterCalled != nil {
+		s.AddCounterCalled(operation, value)
+	}
+}
+
+// ObserveStage -
+func (s *StatusMetricsStub) ObserveStage(stage string, duration time.Duration) {
+	if s.ObserveStageCalled != nil {
+		s.ObserveStageCalled(stage, duration)
+	}
+}
+
+// AddStageError -
+func (s *StatusMetricsStub) AddStageError(stage string) {
+	if s.AddStageErrorCalled != nil {
+		s.AddStageErrorCalled(stage)
+	}
+}
+
+// ObservePublish -
+func (s *StatusMetricsStub) ObservePublish(sink string, duration time.Duration) {
+	if s.ObservePublishCalled != nil {
+		s.ObservePublishCalled(sink, duration)
+	}
+}
+
+// AddEvents -
+func (s *StatusMetricsStub) AddEvents(identifier string, shardID uint32, count uint64) {
+	if s.AddEventsCalled != nil {
+		s.AddEventsCalled(identifier, shardID, count)
+	}
+}
+
+// SetWSConnections -
+func (s *StatusMetricsStub) SetWSConnections(count int) {
+	if s.SetWSConnectionsCalled != nil {
+		s.SetWSConnectionsCalled(count)
+	}
+}
+
+// SetWSSubscriptions -
+func (s *StatusMetricsStub) SetWSSubscriptions(count int) {
+	if s.SetWSSubscriptionsCalled != nil {
+		s.SetWSSubscriptionsCalled(count)
+	}
+}
+
+// SetSubscriberConnections -
+func (s *StatusMetricsStub) SetSubscriberConnections(subscriber string, count int) {
+	if s.SetSubscriberConnectionsCalled != nil {
+		s.SetSubscriberConnectionsCalled(subscriber, count)
+	}
+}
+
+// AddSubscriberMessage -
+func (s *StatusMetricsStub) AddSubscriberMessage(subscriber string, status string) {
+	if s.AddSubscriberMessageCalled != nil {
+		s.AddSubscriberMessageCalled(subscriber, status)
+	}
+}
+
+// AddSubscriberRejection -
+func (s *StatusMetricsStub) AddSubscriberRejection(subscriber string, reason string) {
+	if s.AddSubscriberRejectionCalled != nil {
+		s.AddSubscriberRejectionCalled(subscriber, reason)
+	}
+}
+
+// SetIngestionQueueDepth -
+func (s *StatusMetricsStub) SetIngestionQueueDepth(shardID uint32, depth int) {
+	if s.SetIngestionQueueDepthCalled != nil {
+		s.SetIngestionQueueDepthCalled(shardID, depth)
+	}
+}
+
 // GetAll -
 func (s *StatusMetricsStub) GetAll() map[string]*data.EndpointMetricsResponse {
 	if s.GetAllCalled != nil {
@@ -0,0 +1,34 @@
+package mocks
+
+import (
+	"net/http"
+
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+// SubscribersAuthenticatorStub implements dispatcher.SubscribersAuthenticator interface
+type SubscribersAuthenticatorStub struct {
+	AuthenticateCalled      func(r *http.Request) (data.SubscriberQuota, error)
+	ReleaseConnectionCalled func(quota data.SubscriberQuota)
+}
+
+// Authenticate -
+func (sas *SubscribersAuthenticatorStub) Authenticate(r *http.Request) (data.SubscriberQuota, error) {
+	if sas.AuthenticateCalled != nil {
+		return sas.AuthenticateCalled(r)
+	}
+
+	return data.SubscriberQuota{}, nil
+}
+
+// ReleaseConnection -
+func (sas *SubscribersAuthenticatorStub) ReleaseConnection(quota data.SubscriberQuota) {
+	if sas.ReleaseConnectionCalled != nil {
+		sas.ReleaseConnectionCalled(quota)
+	}
+}
+
+// IsInterfaceNil -
+func (sas *SubscribersAuthenticatorStub) IsInterfaceNil() bool {
+	return sas == nil
+}
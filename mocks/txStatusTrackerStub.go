@@ -0,0 +1,30 @@
+package mocks
+
+import "github.com/multiversx/mx-chain-notifier-go/data"
+
+// TxStatusTrackerStub -
+type TxStatusTrackerStub struct {
+	ProcessTxBundlesCalled func(blockTxBundles data.BlockTxBundles)
+	CloseCalled            func() error
+}
+
+// ProcessTxBundles -
+func (tsts *TxStatusTrackerStub) ProcessTxBundles(blockTxBundles data.BlockTxBundles) {
+	if tsts.ProcessTxBundlesCalled != nil {
+		tsts.ProcessTxBundlesCalled(blockTxBundles)
+	}
+}
+
+// Close -
+func (tsts *TxStatusTrackerStub) Close() error {
+	if tsts.CloseCalled != nil {
+		return tsts.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (tsts *TxStatusTrackerStub) IsInterfaceNil() bool {
+	return tsts == nil
+}
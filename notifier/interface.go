@@ -0,0 +1,9 @@
+package notifier
+
+import "context"
+
+// PushesDrainer defines the behaviour of a component which stops accepting the pushes from observers
+// and waits for the in-flight ones to be handled
+type PushesDrainer interface {
+	Drain(ctx context.Context) error
+}
@@ -1,22 +1,33 @@
 package notifier
 
 import (
+	"context"
+	"fmt"
+	"io"
 	"os"
 	"os/signal"
+	"syscall"
+	"time"
 
 	logger "github.com/multiversx/mx-chain-logger-go"
 	"github.com/multiversx/mx-chain-notifier-go/api/gin"
 	"github.com/multiversx/mx-chain-notifier-go/api/shared"
+	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/dispatcher"
 	"github.com/multiversx/mx-chain-notifier-go/facade"
 	"github.com/multiversx/mx-chain-notifier-go/factory"
 	"github.com/multiversx/mx-chain-notifier-go/metrics"
+	"github.com/multiversx/mx-chain-notifier-go/outport"
+	"github.com/multiversx/mx-chain-notifier-go/process"
 	"github.com/multiversx/mx-chain-notifier-go/rabbitmq"
+	"github.com/multiversx/mx-chain-notifier-go/tracing"
 )
 
 var log = logger.GetOrCreate("notifierRunner")
 
+const defaultDrainTimeoutInSec = 30
+
 type notifierRunner struct {
 	configs config.Configs
 }
@@ -34,27 +45,54 @@ func NewNotifierRunner(cfgs *config.Configs) (*notifierRunner, error) {
 
 // Start will trigger the notifier service
 func (nr *notifierRunner) Start() error {
-	lockService, err := factory.CreateLockService(nr.configs.GeneralConfig.ConnectorApi.CheckDuplicates, nr.configs.GeneralConfig.Redis)
+	tracerProvider, err := tracing.NewTracerProvider(nr.configs.GeneralConfig.Tracing)
 	if err != nil {
 		return err
 	}
 
-	publisher, err := factory.CreatePublisher(nr.configs.Flags.APIType, nr.configs.GeneralConfig)
+	apiConfig := nr.configs.GeneralConfig.ConnectorApi
+	lockServiceEnabled := apiConfig.CheckDuplicates || apiConfig.Quorum.Enabled
+	lockService, err := factory.CreateLockService(lockServiceEnabled, nr.configs.GeneralConfig.Redis)
 	if err != nil {
 		return err
 	}
 
-	hub, err := factory.CreateHub(nr.configs.Flags.APIType)
+	statusMetricsHandler := metrics.NewStatusMetrics()
+
+	ingestionQueue, err := factory.CreateIngestionQueue(apiConfig.IngestionQueue, statusMetricsHandler)
 	if err != nil {
 		return err
 	}
 
-	wsHandler, err := factory.CreateWSHandler(nr.configs.Flags.APIType, hub)
+	observersQuorum, err := factory.CreateObserversQuorum(apiConfig, lockService, ingestionQueue, statusMetricsHandler)
 	if err != nil {
 		return err
 	}
 
-	statusMetricsHandler := metrics.NewStatusMetrics()
+	serviceBusClient, err := factory.CreateServiceBusClient(nr.configs.Flags.APIType, nr.configs.GeneralConfig.RabbitMQ, statusMetricsHandler)
+	if err != nil {
+		return err
+	}
+
+	publisher, err := factory.CreatePublisher(nr.configs.Flags.APIType, nr.configs.GeneralConfig, serviceBusClient, statusMetricsHandler)
+	if err != nil {
+		return err
+	}
+
+	blockTimestamps, err := factory.CreateBlockTimestampsCache(apiConfig.SubscribersAuth.NativeAuth)
+	if err != nil {
+		return err
+	}
+
+	hub, err := factory.CreateHub(nr.configs.Flags.APIType, statusMetricsHandler, blockTimestamps)
+	if err != nil {
+		return err
+	}
+
+	wsHandler, err := factory.CreateWSHandler(nr.configs.Flags.APIType, hub, statusMetricsHandler, apiConfig.SubscribersAuth, blockTimestamps)
+	if err != nil {
+		return err
+	}
 
 	argsEventsHandler := factory.ArgsEventsHandlerFactory{
 		APIConfig:            nr.configs.GeneralConfig.ConnectorApi,
@@ -69,23 +107,58 @@ func (nr *notifierRunner) Start() error {
 		return err
 	}
 
+	observersTracker, err := factory.CreateObserversTracker(apiConfig.ObserversHealth, eventsHandler)
+	if err != nil {
+		return err
+	}
+
+	txStatusTracker, err := factory.CreateTxStatusTracker(apiConfig.TxStatusTracker, nr.configs.GeneralConfig.Redis, eventsHandler)
+	if err != nil {
+		return err
+	}
+
 	eventsInterceptor, err := factory.CreateEventsInterceptor()
 	if err != nil {
 		return err
 	}
 
+	argsHealthService := factory.ArgsHealthServiceFactory{
+		Config:             nr.configs.GeneralConfig.HealthCheck,
+		APIType:            nr.configs.Flags.APIType,
+		LockServiceEnabled: lockServiceEnabled,
+		LockService:        lockService,
+		Publisher:          publisher,
+		ServiceBus:         serviceBusClient,
+		Hub:                hub,
+	}
+	healthService, err := factory.CreateHealthService(argsHealthService)
+	if err != nil {
+		return err
+	}
+
 	facadeArgs := facade.ArgsNotifierFacade{
 		EventsHandler:        eventsHandler,
 		APIConfig:            nr.configs.GeneralConfig.ConnectorApi,
 		WSHandler:            wsHandler,
 		EventsInterceptor:    eventsInterceptor,
 		StatusMetricsHandler: statusMetricsHandler,
+		ObserversQuorum:      observersQuorum,
+		ObserversTracker:     observersTracker,
+		HealthService:        healthService,
+		Hub:                  hub,
+		IngestionQueue:       ingestionQueue,
+		TxStatusTracker:      txStatusTracker,
 	}
 	facade, err := facade.NewNotifierFacade(facadeArgs)
 	if err != nil {
 		return err
 	}
 
+	observersConnector, err := factory.CreateObserversConnector(apiConfig.OutportWebSocket, facade)
+	if err != nil {
+		return err
+	}
+
 	webServerArgs := gin.ArgsWebServerHandler{
 		Facade:  facade,
 		Configs: nr.configs,
@@ -102,7 +175,10 @@ func (nr *notifierRunner) Start() error {
 		return err
 	}
 
-	err = waitForGracefulShutdown(webServer, publisher, hub)
+	observersConnector.Start()
+
+	drainTimeout := getDrainTimeout(nr.configs.GeneralConfig.Shutdown)
+	err = waitForGracefulShutdown(drainTimeout, facade, webServer, observersConnector, ingestionQueue, observersQuorum, observersTracker, txStatusTracker, publisher, hub, tracerProvider)
 	if err != nil {
 		return err
 	}
@@ -116,16 +192,76 @@ func startHandlers(hub dispatcher.Hub, publisher rabbitmq.PublisherService) {
 	publisher.Run()
 }
 
+func getDrainTimeout(cfg config.ShutdownConfig) time.Duration {
+	if cfg.DrainTimeoutInSec == 0 {
+		return defaultDrainTimeoutInSec * time.Second
+	}
+
+	return time.Duration(cfg.DrainTimeoutInSec) * time.Second
+}
+
+// waitForGracefulShutdown waits for an interrupt or terminate signal, then drains the in-flight data
+// in the pipeline order: pushes from observers (HTTP and outport websocket) and queued blocks, publisher and hub. The components are closed afterwards,
+// even if the drain deadline was reached, in which case ErrUndeliveredData is returned
 func waitForGracefulShutdown(
+	drainTimeout time.Duration,
+	pushesDrainer PushesDrainer,
 	server shared.WebServerHandler,
+	observersConnector outport.ObserversConnector,
+	ingestionQueue process.IngestionQueue,
+	observersQuorum process.ObserversQuorum,
+	observersTracker common.ObserversTracker,
+	txStatusTracker process.TxStatusTracker,
 	publisher rabbitmq.PublisherService,
 	hub dispatcher.Hub,
+	tracerProvider io.Closer,
 ) error {
 	quit := make(chan os.Signal, 1)
-	signal.Notify(quit, os.Interrupt, os.Kill)
-	<-quit
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	sig := <-quit
+
+	log.Info("shutting down, draining in-flight data", "signal", sig.String(), "timeout", drainTimeout)
+
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
 
-	err := server.Close()
+	numDrainErrors := 0
+	logDrainErr := func(component string, err error) {
+		if err != nil {
+			log.Error("failed to drain", "component", component, "err", err.Error())
+			numDrainErrors++
+		}
+	}
+
+	logDrainErr("observers pushes", pushesDrainer.Drain(ctx))
+
+	err := observersConnector.Close()
+	if err != nil {
+		return err
+	}
+
+	err = ingestionQueue.Close()
+	if err != nil {
+		return err
+	}
+
+	// the blocks still waiting for quorum are published before the publisher is drained
+	logDrainErr("observers quorum", observersQuorum.Close())
+
+	err = observersTracker.Close()
+	if err != nil {
+		return err
+	}
+
+	err = txStatusTracker.Close()
+	if err != nil {
+		return err
+	}
+
+	logDrainErr("publisher", publisher.Drain(ctx))
+	logDrainErr("hub", hub.Drain(ctx))
+
+	err = server.Close()
 	if err != nil {
 		return err
 	}
@@ -140,5 +276,14 @@ func waitForGracefulShutdown(
 		return err
 	}
 
+	err = tracerProvider.Close()
+	if err != nil {
+		return err
+	}
+
+	if numDrainErrors > 0 {
+		return fmt.Errorf("%w: %d components not drained before the deadline", common.ErrUndeliveredData, numDrainErrors)
+	}
+
 	return nil
 }
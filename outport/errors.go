@@ -0,0 +1,24 @@
+package outport
+
+import "errors"
+
+// ErrNilFacadeHandler signals that a nil facade handler has been provided
+var ErrNilFacadeHandler = errors.New("nil facade handler")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilHasher signals that a nil hasher has been provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilPayloadHandler signals that a nil payload handler has been provided
+var ErrNilPayloadHandler = errors.New("nil payload handler")
+
+// ErrInvalidObserverConfig signals that an invalid outport observer config has been provided
+var ErrInvalidObserverConfig = errors.New("invalid outport observer config")
+
+// ErrNilBlockHeader signals that an operation without block header has been received
+var ErrNilBlockHeader = errors.New("nil block header")
+
+// ErrInvalidPayload signals that a message of the websocket outport driver could not be decoded
+var ErrInvalidPayload = errors.New("invalid outport driver payload")
@@ -0,0 +1,36 @@
+package outport
+
+import (
+	"context"
+	"time"
+
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+// FacadeHandler defines the facade actions needed to handle the outport operations received from observers
+type FacadeHandler interface {
+	HandlePushEventsV2(ctx context.Context, events data.ArgsSaveBlockData, observerID string) error
+	HandleRevertEvents(revertBlock data.RevertBlock)
+	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock)
+	AddObserverRequest(observerID string)
+	ObserveStage(stage string, duration time.Duration)
+	AddStageError(stage string)
+	RecordObserverError(observerID string)
+	BeginPush() bool
+	EndPush()
+	IsInterfaceNil() bool
+}
+
+// PayloadHandler defines the behaviour of a component which handles the outport operations received from observers
+type PayloadHandler interface {
+	ProcessPayload(operation data.OperationType, payload []byte, observerID string) error
+	IsInterfaceNil() bool
+}
+
+// ObserversConnector defines the behaviour of a component which receives the outport data of the observers
+// over websocket connections
+type ObserversConnector interface {
+	Start()
+	Close() error
+	IsInterfaceNil() bool
+}
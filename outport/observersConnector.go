@@ -0,0 +1,271 @@
+package outport
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-notifier-go/common/tlsclient"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+const (
+	defaultRetryDurationInMs = 5000
+	writeWait                = 10 * time.Second
+
+	wsScheme  = "ws"
+	wssScheme = "wss"
+)
+
+// ArgsObserversConnector defines the arguments needed to create an observers connector
+type ArgsObserversConnector struct {
+	Config         config.OutportWebSocketConfig
+	PayloadHandler PayloadHandler
+}
+
+type uint64ByteSliceConverter interface {
+	ToByteSlice(uint64) []byte
+}
+
+type observersConnector struct {
+	observers       []config.OutportObserverConfig
+	retryDuration   time.Duration
+	payloadHandler  PayloadHandler
+	uint64Converter uint64ByteSliceConverter
+	scheme          string
+	dialer          *websocket.Dialer
+
+	ctx        context.Context
+	cancelFunc func()
+	wg         sync.WaitGroup
+
+	mutConns sync.Mutex
+	conns    map[string]*websocket.Conn
+}
+
+// NewObserversConnector creates a component which connects, as a websocket client, to the outport driver
+// of each configured observer. Each received message is acknowledged only after it was handled. With TLS
+// enabled, the observers are connected over wss, presenting the client certificate, if configured
+func NewObserversConnector(args ArgsObserversConnector) (*observersConnector, error) {
+	err := checkObserversConnectorArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme, dialer, err := createDialer(args.Config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	retryDurationInMs := args.Config.RetryDurationInMs
+	if retryDurationInMs == 0 {
+		retryDurationInMs = defaultRetryDurationInMs
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &observersConnector{
+		observers:       args.Config.Observers,
+		retryDuration:   time.Duration(retryDurationInMs) * time.Millisecond,
+		payloadHandler:  args.PayloadHandler,
+		uint64Converter: uint64ByteSlice.NewBigEndianConverter(),
+		scheme:          scheme,
+		dialer:          dialer,
+		ctx:             ctx,
+		cancelFunc:      cancel,
+		conns:           make(map[string]*websocket.Conn),
+	}, nil
+}
+
+func checkObserversConnectorArgs(args ArgsObserversConnector) error {
+	if check.IfNil(args.PayloadHandler) {
+		return ErrNilPayloadHandler
+	}
+
+	observerIDs := make(map[string]struct{}, len(args.Config.Observers))
+	for _, observer := range args.Config.Observers {
+		if observer.ID == "" || observer.URL == "" {
+			return fmt.Errorf("%w: empty ID or URL", ErrInvalidObserverConfig)
+		}
+
+		_, exists := observerIDs[observer.ID]
+		if exists {
+			return fmt.Errorf("%w: duplicated ID %s", ErrInvalidObserverConfig, observer.ID)
+		}
+		observerIDs[observer.ID] = struct{}{}
+	}
+
+	return nil
+}
+
+func createDialer(cfg config.ClientTLSConfig) (string, *websocket.Dialer, error) {
+	if !cfg.Enabled {
+		return wsScheme, websocket.DefaultDialer, nil
+	}
+
+	tlsConfig, err := tlsclient.CreateTLSClientConfig(cfg)
+	if err != nil {
+		return "", nil, err
+	}
+	if cfg.InsecureSkipVerify {
+		log.Warn("observers outport TLS certificate verification is disabled")
+	}
+
+	dialer := *websocket.DefaultDialer
+	dialer.TLSClientConfig = tlsConfig
+
+	return wssScheme, &dialer, nil
+}
+
+// Start connects to all the configured observers
+func (oc *observersConnector) Start() {
+	for _, observer := range oc.observers {
+		oc.wg.Add(1)
+		go oc.connectObserver(observer)
+	}
+}
+
+// connectObserver keeps the connection with an observer open, reconnecting after the retry duration
+// until the component is closed
+func (oc *observersConnector) connectObserver(observer config.OutportObserverConfig) {
+	defer oc.wg.Done()
+
+	observerURL := getObserverURL(oc.scheme, observer.URL)
+	for {
+		err := oc.connectAndListen(observer.ID, observerURL)
+		if oc.ctx.Err() != nil {
+			return
+		}
+
+		log.Warn("outport connection with observer lost, will retry",
+			"observer", observer.ID,
+			"url", observerURL,
+			"err", err.Error(),
+			"retry duration", oc.retryDuration,
+		)
+
+		select {
+		case <-oc.ctx.Done():
+			return
+		case <-time.After(oc.retryDuration):
+		}
+	}
+}
+
+func getObserverURL(scheme string, address string) string {
+	observerURL := url.URL{
+		Scheme: scheme,
+		Host:   address,
+		Path:   data.OutportDriverWSRoute,
+	}
+
+	return observerURL.String()
+}
+
+func (oc *observersConnector) connectAndListen(observerID string, observerURL string) error {
+	conn, _, err := oc.dialer.DialContext(oc.ctx, observerURL, nil)
+	if err != nil {
+		return err
+	}
+
+	err = oc.addConn(observerID, conn)
+	if err != nil {
+		return err
+	}
+	defer oc.removeConn(observerID)
+
+	log.Info("connected to observer outport", "observer", observerID, "url", observerURL)
+
+	for {
+		messageType, message, err := conn.ReadMessage()
+		if err != nil {
+			return err
+		}
+		if messageType != websocket.BinaryMessage {
+			log.Warn("received outport message is not binary message", "observer", observerID, "message type", messageType)
+			continue
+		}
+
+		// a message which could not be handled is not acknowledged, the connection is reset instead
+		err = oc.handleMessage(conn, message, observerID)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (oc *observersConnector) handleMessage(conn *websocket.Conn, message []byte, observerID string) error {
+	extracted, err := extractPayloadData(message)
+	if err != nil {
+		return err
+	}
+
+	err = oc.payloadHandler.ProcessPayload(extracted.operationType, extracted.payload, observerID)
+	if err != nil {
+		return err
+	}
+
+	if !extracted.withAcknowledge {
+		return nil
+	}
+
+	err = conn.SetWriteDeadline(time.Now().Add(writeWait))
+	if err != nil {
+		return err
+	}
+
+	return conn.WriteMessage(websocket.BinaryMessage, oc.uint64Converter.ToByteSlice(extracted.counter))
+}
+
+func (oc *observersConnector) addConn(observerID string, conn *websocket.Conn) error {
+	oc.mutConns.Lock()
+	defer oc.mutConns.Unlock()
+
+	if oc.ctx.Err() != nil {
+		_ = conn.Close()
+		return oc.ctx.Err()
+	}
+
+	oc.conns[observerID] = conn
+
+	return nil
+}
+
+func (oc *observersConnector) removeConn(observerID string) {
+	oc.mutConns.Lock()
+	defer oc.mutConns.Unlock()
+
+	conn, ok := oc.conns[observerID]
+	if !ok {
+		return
+	}
+
+	_ = conn.Close()
+	delete(oc.conns, observerID)
+}
+
+// Close closes the connections with the observers and waits for the handled messages to finish
+func (oc *observersConnector) Close() error {
+	oc.cancelFunc()
+
+	oc.mutConns.Lock()
+	for _, conn := range oc.conns {
+		_ = conn.Close()
+	}
+	oc.mutConns.Unlock()
+
+	oc.wg.Wait()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (oc *observersConnector) IsInterfaceNil() bool {
+	return oc == nil
+}
@@ -0,0 +1,324 @@
+package outport_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/outport"
+	"github.com/stretchr/testify/require"
+)
+
+const testTimeout = 5 * time.Second
+
+// createOutportMessage builds a message the same way as the outport driver of the observers does
+func createOutportMessage(counter uint64, operation data.OperationType, payload []byte) []byte {
+	converter := uint64ByteSlice.NewBigEndianConverter()
+
+	message := []byte{1}
+	message = append(message, converter.ToByteSlice(counter)...)
+	message = append(message, converter.ToByteSlice(uint64(operation))[4:]...)
+	message = append(message, converter.ToByteSlice(uint64(len(payload)))[4:]...)
+
+	return append(message, payload...)
+}
+
+// createObserverServer starts a server which acts as the outport driver of an observer, providing
+// the connections opened by the notifier
+func createObserverServer(t *testing.T) (*httptest.Server, chan *websocket.Conn) {
+	conns := make(chan *websocket.Conn, 10)
+	upgrader := websocket.Upgrader{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(data.OutportDriverWSRoute, func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.Nil(t, err)
+		conns <- conn
+	})
+
+	return httptest.NewServer(mux), conns
+}
+
+// writeTestCertificate writes a self signed client certificate and its key to the provided directory
+func writeTestCertificate(t *testing.T, dir string) (*x509.Certificate, string, string) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "notifier"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	certBytes, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.Nil(t, err)
+	cert, err := x509.ParseCertificate(certBytes)
+	require.Nil(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(privateKey)
+	require.Nil(t, err)
+
+	certFile := filepath.Join(dir, "cert.pem")
+	keyFile := filepath.Join(dir, "key.pem")
+	err = ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certBytes}), 0600)
+	require.Nil(t, err)
+	err = ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyBytes}), 0600)
+	require.Nil(t, err)
+
+	return cert, certFile, keyFile
+}
+
+func createMockObserversConnectorArgs(observerURL string) outport.ArgsObserversConnector {
+	return outport.ArgsObserversConnector{
+		Config: config.OutportWebSocketConfig{
+			Enabled:           true,
+			RetryDurationInMs: 10,
+			Observers: []config.OutportObserverConfig{
+				{ID: "observer-0", URL: strings.TrimPrefix(observerURL, "http://")},
+			},
+		},
+		PayloadHandler: &mocks.PayloadHandlerStub{},
+	}
+}
+
+func waitForConn(t *testing.T, conns chan *websocket.Conn) *websocket.Conn {
+	select {
+	case conn := <-conns:
+		return conn
+	case <-time.After(testTimeout):
+		require.Fail(t, "timeout waiting for the notifier to connect")
+		return nil
+	}
+}
+
+func TestNewObserversConnector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil payload handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversConnectorArgs("localhost:22111")
+		args.PayloadHandler = nil
+
+		oc, err := outport.NewObserversConnector(args)
+		require.True(t, check.IfNil(oc))
+		require.Equal(t, outport.ErrNilPayloadHandler, err)
+	})
+
+	t.Run("empty observer URL should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversConnectorArgs("")
+
+		oc, err := outport.NewObserversConnector(args)
+		require.True(t, check.IfNil(oc))
+		require.True(t, errors.Is(err, outport.ErrInvalidObserverConfig))
+	})
+
+	t.Run("duplicated observer ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversConnectorArgs("localhost:22111")
+		args.Config.Observers = append(args.Config.Observers, config.OutportObserverConfig{ID: "observer-0", URL: "localhost:22112"})
+
+		oc, err := outport.NewObserversConnector(args)
+		require.True(t, check.IfNil(oc))
+		require.True(t, errors.Is(err, outport.ErrInvalidObserverConfig))
+	})
+
+	t.Run("TLS with only the client certificate should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, certFile, _ := writeTestCertificate(t, t.TempDir())
+		args := createMockObserversConnectorArgs("localhost:22111")
+		args.Config.TLS = config.ClientTLSConfig{
+			Enabled:  true,
+			CertFile: certFile,
+		}
+
+		oc, err := outport.NewObserversConnector(args)
+		require.True(t, check.IfNil(oc))
+		require.True(t, errors.Is(err, common.ErrInvalidTLSConfig))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		oc, err := outport.NewObserversConnector(createMockObserversConnectorArgs("localhost:22111"))
+		require.Nil(t, err)
+		require.False(t, check.IfNil(oc))
+	})
+}
+
+func TestObserversConnector_HandleMessages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should acknowledge the handled messages", func(t *testing.T) {
+		t.Parallel()
+
+		server, conns := createObserverServer(t)
+		defer server.Close()
+
+		handledPayloads := make(chan []byte, 1)
+		args := createMockObserversConnectorArgs(server.URL)
+		args.PayloadHandler = &mocks.PayloadHandlerStub{
+			ProcessPayloadCalled: func(operation data.OperationType, payload []byte, observerID string) error {
+				require.Equal(t, data.OperationFinalizedBlock, operation)
+				require.Equal(t, "observer-0", observerID)
+				handledPayloads <- payload
+				return nil
+			},
+		}
+		oc, _ := outport.NewObserversConnector(args)
+		oc.Start()
+		defer func() {
+			_ = oc.Close()
+		}()
+
+		conn := waitForConn(t, conns)
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		err := conn.WriteMessage(websocket.BinaryMessage, createOutportMessage(7, data.OperationFinalizedBlock, []byte("payload")))
+		require.Nil(t, err)
+
+		_ = conn.SetReadDeadline(time.Now().Add(testTimeout))
+		messageType, ack, err := conn.ReadMessage()
+		require.Nil(t, err)
+		require.Equal(t, websocket.BinaryMessage, messageType)
+		require.Equal(t, uint64ByteSlice.NewBigEndianConverter().ToByteSlice(7), ack)
+		require.Equal(t, []byte("payload"), <-handledPayloads)
+	})
+
+	t.Run("failed message should not be acknowledged and should reconnect", func(t *testing.T) {
+		t.Parallel()
+
+		server, conns := createObserverServer(t)
+		defer server.Close()
+
+		args := createMockObserversConnectorArgs(server.URL)
+		args.PayloadHandler = &mocks.PayloadHandlerStub{
+			ProcessPayloadCalled: func(_ data.OperationType, _ []byte, _ string) error {
+				return errors.New("expected error")
+			},
+		}
+		oc, _ := outport.NewObserversConnector(args)
+		oc.Start()
+		defer func() {
+			_ = oc.Close()
+		}()
+
+		conn := waitForConn(t, conns)
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		err := conn.WriteMessage(websocket.BinaryMessage, createOutportMessage(1, data.OperationSaveBlock, []byte("payload")))
+		require.Nil(t, err)
+
+		_ = conn.SetReadDeadline(time.Now().Add(testTimeout))
+		_, _, err = conn.ReadMessage()
+		require.NotNil(t, err)
+
+		reconnectedConn := waitForConn(t, conns)
+		_ = reconnectedConn.Close()
+	})
+}
+
+func TestObserversConnector_MutualTLS(t *testing.T) {
+	t.Parallel()
+
+	clientCert, certFile, keyFile := writeTestCertificate(t, t.TempDir())
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+
+	conns := make(chan *websocket.Conn, 10)
+	upgrader := websocket.Upgrader{}
+	mux := http.NewServeMux()
+	mux.HandleFunc(data.OutportDriverWSRoute, func(w http.ResponseWriter, r *http.Request) {
+		require.Len(t, r.TLS.PeerCertificates, 1)
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.Nil(t, err)
+		conns <- conn
+	})
+	server := httptest.NewUnstartedServer(mux)
+	server.TLS = &tls.Config{
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	serverCAFile := filepath.Join(t.TempDir(), "ca.pem")
+	err := ioutil.WriteFile(serverCAFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600)
+	require.Nil(t, err)
+
+	args := createMockObserversConnectorArgs(strings.TrimPrefix(server.URL, "https://"))
+	args.Config.TLS = config.ClientTLSConfig{
+		Enabled:    true,
+		CACertFile: serverCAFile,
+		CertFile:   certFile,
+		KeyFile:    keyFile,
+		ServerName: "example.com",
+	}
+	oc, err := outport.NewObserversConnector(args)
+	require.Nil(t, err)
+	oc.Start()
+	defer func() {
+		_ = oc.Close()
+	}()
+
+	conn := waitForConn(t, conns)
+	_ = conn.Close()
+}
+
+func TestObserversConnector_Close(t *testing.T) {
+	t.Parallel()
+
+	server, conns := createObserverServer(t)
+	defer server.Close()
+
+	oc, _ := outport.NewObserversConnector(createMockObserversConnectorArgs(server.URL))
+	oc.Start()
+
+	conn := waitForConn(t, conns)
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	err := oc.Close()
+	require.Nil(t, err)
+
+	_ = conn.SetReadDeadline(time.Now().Add(testTimeout))
+	_, _, err = conn.ReadMessage()
+	require.NotNil(t, err)
+
+	select {
+	case <-conns:
+		require.Fail(t, "should not have reconnected")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
@@ -0,0 +1,154 @@
+package outport
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	outportCore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/decoders"
+	"github.com/multiversx/mx-chain-notifier-go/tracing"
+)
+
+var log = logger.GetOrCreate("outport")
+
+// ArgsPayloadHandler defines the arguments needed to create a payload handler
+type ArgsPayloadHandler struct {
+	Facade FacadeHandler
+	// Marshaller and Hasher are used to compute the hash of the reverted headers, the same way the observers do
+	Marshaller marshal.Marshalizer
+	Hasher     hashing.Hasher
+}
+
+type payloadHandler struct {
+	facade     FacadeHandler
+	marshaller marshal.Marshalizer
+	hasher     hashing.Hasher
+}
+
+// NewPayloadHandler creates a component which decodes the outport operations received from observers
+// and passes them to the same facade calls used by the HTTP events endpoints
+func NewPayloadHandler(args ArgsPayloadHandler) (*payloadHandler, error) {
+	if check.IfNil(args.Facade) {
+		return nil, ErrNilFacadeHandler
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &payloadHandler{
+		facade:     args.Facade,
+		marshaller: args.Marshaller,
+		hasher:     args.Hasher,
+	}, nil
+}
+
+// ProcessPayload handles the save block, revert and finalized operations. The other operations are
+// not used by the notifier and are ignored. An error is returned if the operation was not handled
+func (ph *payloadHandler) ProcessPayload(operation data.OperationType, payload []byte, observerID string) error {
+	var operationHandler func(payload []byte, observerID string) error
+	switch operation {
+	case data.OperationSaveBlock:
+		operationHandler = ph.saveBlock
+	case data.OperationRevertIndexedBlock:
+		operationHandler = ph.revertBlock
+	case data.OperationFinalizedBlock:
+		operationHandler = ph.finalizedBlock
+	default:
+		log.Trace("ignored outport operation", "operation", operation.String(), "observer", observerID)
+		return nil
+	}
+
+	if !ph.facade.BeginPush() {
+		return common.ErrNotifierDraining
+	}
+	defer ph.facade.EndPush()
+
+	ph.facade.AddObserverRequest(observerID)
+
+	err := operationHandler(payload, observerID)
+	if err != nil {
+		ph.facade.RecordObserverError(observerID)
+		return fmt.Errorf("%w while handling operation %s", err, operation.String())
+	}
+
+	return nil
+}
+
+func (ph *payloadHandler) saveBlock(payload []byte, observerID string) error {
+	ctx, span := tracing.StartSpan(context.Background(), "outport.saveBlock", "")
+	defer span.End()
+
+	startTime := time.Now()
+	saveBlockData, err := decoders.DecodeBlockData(payload, "")
+	ph.facade.ObserveStage(common.UnmarshalStage, time.Since(startTime))
+	if err != nil {
+		tracing.RecordError(span, err)
+		ph.facade.AddStageError(common.UnmarshalStage)
+		return err
+	}
+	tracing.SetBlockHash(span, hex.EncodeToString(saveBlockData.HeaderHash))
+
+	err = ph.facade.HandlePushEventsV2(ctx, *saveBlockData, observerID)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return err
+	}
+
+	return nil
+}
+
+func (ph *payloadHandler) revertBlock(payload []byte, _ string) error {
+	header, err := decoders.UnmarshallHeader(payload)
+	if err != nil {
+		return err
+	}
+	if check.IfNil(header) {
+		return ErrNilBlockHeader
+	}
+
+	headerHash, err := core.CalculateHash(ph.marshaller, ph.hasher, header)
+	if err != nil {
+		return err
+	}
+
+	ph.facade.HandleRevertEvents(data.RevertBlock{
+		Hash:  hex.EncodeToString(headerHash),
+		Nonce: header.GetNonce(),
+		Round: header.GetRound(),
+		Epoch: header.GetEpoch(),
+	})
+
+	return nil
+}
+
+func (ph *payloadHandler) finalizedBlock(payload []byte, _ string) error {
+	var finalizedBlock outportCore.FinalizedBlock
+	err := json.Unmarshal(payload, &finalizedBlock)
+	if err != nil {
+		return err
+	}
+
+	ph.facade.HandleFinalizedEvents(data.FinalizedBlock{
+		Hash: hex.EncodeToString(finalizedBlock.HeaderHash),
+	})
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ph *payloadHandler) IsInterfaceNil() bool {
+	return ph == nil
+}
@@ -0,0 +1,259 @@
+package outport_test
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportCore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/outport"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockPayloadHandlerArgs() outport.ArgsPayloadHandler {
+	return outport.ArgsPayloadHandler{
+		Facade:     &mocks.FacadeStub{},
+		Marshaller: &marshal.GogoProtoMarshalizer{},
+		Hasher:     blake2b.NewBlake2b(),
+	}
+}
+
+func createSaveBlockPayload(t *testing.T, headerHash []byte) []byte {
+	saveBlock := data.ArgsSaveBlock{
+		HeaderType: core.ShardHeaderV2,
+		ArgsSaveBlockData: data.ArgsSaveBlockData{
+			HeaderHash: headerHash,
+			Body:       &block.Body{},
+			Header: &block.HeaderV2{
+				Header: &block.Header{
+					Nonce:   1,
+					ShardID: 1,
+				},
+			},
+			TransactionsPool: &data.TransactionsPool{},
+		},
+	}
+
+	payload, err := json.Marshal(saveBlock)
+	require.Nil(t, err)
+
+	return payload
+}
+
+func TestNewPayloadHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil facade should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPayloadHandlerArgs()
+		args.Facade = nil
+
+		ph, err := outport.NewPayloadHandler(args)
+		require.True(t, check.IfNil(ph))
+		require.Equal(t, outport.ErrNilFacadeHandler, err)
+	})
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPayloadHandlerArgs()
+		args.Marshaller = nil
+
+		ph, err := outport.NewPayloadHandler(args)
+		require.True(t, check.IfNil(ph))
+		require.Equal(t, outport.ErrNilMarshaller, err)
+	})
+
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPayloadHandlerArgs()
+		args.Hasher = nil
+
+		ph, err := outport.NewPayloadHandler(args)
+		require.True(t, check.IfNil(ph))
+		require.Equal(t, outport.ErrNilHasher, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		ph, err := outport.NewPayloadHandler(createMockPayloadHandlerArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(ph))
+	})
+}
+
+func TestPayloadHandler_ProcessPayload(t *testing.T) {
+	t.Parallel()
+
+	t.Run("save block should push events", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockPayloadHandlerArgs()
+		args.Facade = &mocks.FacadeStub{
+			HandlePushEventsV2Called: func(_ context.Context, events data.ArgsSaveBlockData, observerID string) error {
+				wasCalled = true
+				require.Equal(t, []byte("hash1"), events.HeaderHash)
+				require.Equal(t, uint64(1), events.Header.GetNonce())
+				require.Equal(t, "observer-0", observerID)
+				return nil
+			},
+		}
+		ph, _ := outport.NewPayloadHandler(args)
+
+		err := ph.ProcessPayload(data.OperationSaveBlock, createSaveBlockPayload(t, []byte("hash1")), "observer-0")
+		require.Nil(t, err)
+		require.True(t, wasCalled)
+	})
+
+	t.Run("save block failure should error and record observer error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		recordedObserverID := ""
+		args := createMockPayloadHandlerArgs()
+		args.Facade = &mocks.FacadeStub{
+			HandlePushEventsV2Called: func(_ context.Context, _ data.ArgsSaveBlockData, _ string) error {
+				return expectedErr
+			},
+			RecordObserverErrorCalled: func(observerID string) {
+				recordedObserverID = observerID
+			},
+		}
+		ph, _ := outport.NewPayloadHandler(args)
+
+		err := ph.ProcessPayload(data.OperationSaveBlock, createSaveBlockPayload(t, []byte("hash1")), "observer-0")
+		require.True(t, errors.Is(err, expectedErr))
+		require.Equal(t, "observer-0", recordedObserverID)
+	})
+
+	t.Run("invalid save block payload should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPayloadHandlerArgs()
+		args.Facade = &mocks.FacadeStub{
+			HandlePushEventsV2Called: func(_ context.Context, _ data.ArgsSaveBlockData, _ string) error {
+				require.Fail(t, "should not have been called")
+				return nil
+			},
+		}
+		ph, _ := outport.NewPayloadHandler(args)
+
+		err := ph.ProcessPayload(data.OperationSaveBlock, []byte("invalid"), "observer-0")
+		require.NotNil(t, err)
+	})
+
+	t.Run("revert should compute the header hash", func(t *testing.T) {
+		t.Parallel()
+
+		header := &block.Header{
+			Nonce: 10,
+			Round: 11,
+			Epoch: 2,
+		}
+		marshaller := &marshal.GogoProtoMarshalizer{}
+		expectedHash, _ := core.CalculateHash(marshaller, blake2b.NewBlake2b(), header)
+
+		var revertBlock data.RevertBlock
+		args := createMockPayloadHandlerArgs()
+		args.Facade = &mocks.FacadeStub{
+			HandleRevertEventsCalled: func(events data.RevertBlock) {
+				revertBlock = events
+			},
+		}
+		ph, _ := outport.NewPayloadHandler(args)
+
+		payload, _ := json.Marshal(data.ArgsSaveBlock{
+			HeaderType: core.ShardHeaderV1,
+			ArgsSaveBlockData: data.ArgsSaveBlockData{
+				Header: header,
+				Body:   &block.Body{},
+			},
+		})
+		err := ph.ProcessPayload(data.OperationRevertIndexedBlock, payload, "observer-0")
+		require.Nil(t, err)
+		require.Equal(t, data.RevertBlock{
+			Hash:  hex.EncodeToString(expectedHash),
+			Nonce: 10,
+			Round: 11,
+			Epoch: 2,
+		}, revertBlock)
+	})
+
+	t.Run("revert without header should error", func(t *testing.T) {
+		t.Parallel()
+
+		ph, _ := outport.NewPayloadHandler(createMockPayloadHandlerArgs())
+
+		payload, _ := json.Marshal(data.ArgsSaveBlock{
+			HeaderType: core.ShardHeaderV1,
+		})
+		err := ph.ProcessPayload(data.OperationRevertIndexedBlock, payload, "observer-0")
+		require.True(t, errors.Is(err, outport.ErrNilBlockHeader))
+	})
+
+	t.Run("finalized should use the hex encoded header hash", func(t *testing.T) {
+		t.Parallel()
+
+		var finalizedBlock data.FinalizedBlock
+		args := createMockPayloadHandlerArgs()
+		args.Facade = &mocks.FacadeStub{
+			HandleFinalizedEventsCalled: func(events data.FinalizedBlock) {
+				finalizedBlock = events
+			},
+		}
+		ph, _ := outport.NewPayloadHandler(args)
+
+		payload, _ := json.Marshal(outportCore.FinalizedBlock{HeaderHash: []byte("hash1")})
+		err := ph.ProcessPayload(data.OperationFinalizedBlock, payload, "observer-0")
+		require.Nil(t, err)
+		require.Equal(t, hex.EncodeToString([]byte("hash1")), finalizedBlock.Hash)
+	})
+
+	t.Run("other operations should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPayloadHandlerArgs()
+		args.Facade = &mocks.FacadeStub{
+			AddObserverRequestCalled: func(_ string) {
+				require.Fail(t, "should not have been called")
+			},
+		}
+		ph, _ := outport.NewPayloadHandler(args)
+
+		err := ph.ProcessPayload(data.OperationSaveRoundsInfo, []byte("{}"), "observer-0")
+		require.Nil(t, err)
+	})
+
+	t.Run("draining notifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockPayloadHandlerArgs()
+		args.Facade = &mocks.FacadeStub{
+			BeginPushCalled: func() bool {
+				return false
+			},
+			HandleFinalizedEventsCalled: func(_ data.FinalizedBlock) {
+				require.Fail(t, "should not have been called")
+			},
+		}
+		ph, _ := outport.NewPayloadHandler(args)
+
+		payload, _ := json.Marshal(outportCore.FinalizedBlock{HeaderHash: []byte("hash1")})
+		err := ph.ProcessPayload(data.OperationFinalizedBlock, payload, "observer-0")
+		require.Equal(t, common.ErrNotifierDraining, err)
+	})
+}
@@ -0,0 +1,59 @@
+package outport
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+const (
+	withAcknowledgeNumBytes = 1
+	uint64NumBytes          = 8
+	uint32NumBytes          = 4
+
+	minPayloadNumBytes = withAcknowledgeNumBytes + uint64NumBytes + uint32NumBytes + uint32NumBytes
+)
+
+// payloadData holds the fields of a message sent by the websocket outport driver of an observer
+type payloadData struct {
+	withAcknowledge bool
+	counter         uint64
+	operationType   data.OperationType
+	payload         []byte
+}
+
+// extractPayloadData decodes a message of the websocket outport driver, which has the following form:
+// first byte - with acknowledge or not
+// next 8 bytes - counter (uint64 big endian)
+// next 4 bytes - operation type (uint32 big endian)
+// next 4 bytes - message length (uint32 big endian)
+// next X bytes - the operation payload
+func extractPayloadData(message []byte) (*payloadData, error) {
+	if len(message) < minPayloadNumBytes {
+		return nil, fmt.Errorf("%w: minimum required length is %d bytes, but only provided %d",
+			ErrInvalidPayload, minPayloadNumBytes, len(message))
+	}
+
+	extracted := &payloadData{
+		withAcknowledge: message[0] == byte(1),
+	}
+	message = message[withAcknowledgeNumBytes:]
+
+	extracted.counter = binary.BigEndian.Uint64(message[:uint64NumBytes])
+	message = message[uint64NumBytes:]
+
+	extracted.operationType = data.OperationType(uint8(binary.BigEndian.Uint32(message[:uint32NumBytes])))
+	message = message[uint32NumBytes:]
+
+	payloadLen := binary.BigEndian.Uint32(message[:uint32NumBytes])
+	message = message[uint32NumBytes:]
+	if uint64(payloadLen) != uint64(len(message)) {
+		return nil, fmt.Errorf("%w: message length is not equal to the actual payload, provided: %d, actual: %d",
+			ErrInvalidPayload, payloadLen, len(message))
+	}
+
+	extracted.payload = message
+
+	return extracted, nil
+}
@@ -25,3 +25,12 @@ var ErrNilBlockBody = errors.New("nil block body provided")
 
 // ErrNilBlockHeader signals that a nil block header has been provided
 var ErrNilBlockHeader = errors.New("nil block header provided")
+
+// ErrNilTxStatusStorer signals that a nil tx status storer has been provided
+var ErrNilTxStatusStorer = errors.New("nil tx status storer")
+
+// ErrNilTxStatusHandler signals that a nil tx status handler has been provided
+var ErrNilTxStatusHandler = errors.New("nil tx status handler")
+
+// ErrNilIngestionQueue signals that a nil ingestion queue has been provided
+var ErrNilIngestionQueue = errors.New("nil ingestion queue")
@@ -10,19 +10,28 @@ import (
 	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/tracing"
 )
 
 var log = logger.GetOrCreate("process")
 
 const (
-	setRetryDuration       = time.Millisecond * 500
-	reconnectRetryDuration = time.Second * 2
-	minRetries             = 1
-	revertKeyPrefix        = "revert_"
-	finalizedKeyPrefix     = "finalized_"
-	txsKeyPrefix           = "txs_"
-	txsWithOrderKeyPrefix  = "txsWithOrder_"
-	scrsKeyPrefix          = "scrs_"
+	setRetryDuration         = time.Millisecond * 500
+	reconnectRetryDuration   = time.Second * 2
+	minRetries               = 1
+	revertKeyPrefix          = "revert_"
+	finalizedKeyPrefix       = "finalized_"
+	txsKeyPrefix             = "txs_"
+	txsWithOrderKeyPrefix    = "txsWithOrder_"
+	scrsKeyPrefix            = "scrs_"
+	rewardsKeyPrefix         = "rewards_"
+	receiptsKeyPrefix        = "receipts_"
+	invalidTxsKeyPrefix      = "invalidTxs_"
+	alteredAccountsKeyPrefix = "alteredAccounts_"
+	blockHeaderKeyPrefix     = "blockHeader_"
+	epochStartKeyPrefix      = "epochStart_"
+	txBundlesKeyPrefix       = "txBundles_"
+	txStatusKeyPrefix        = "txStatus_"
 
 	rabbitmqMetricPrefix = "RabbitMQ"
 	redisMetricPrefix    = "Redis"
@@ -81,9 +90,12 @@ func (eh *eventsHandler) HandlePushEvents(events data.BlockEvents) error {
 		return common.ErrReceivedEmptyEvents
 	}
 
+	ctx, span := tracing.StartSpan(tracing.ExtractContext(context.Background(), events.TraceContext), "eventsHandler.HandlePushEvents", events.Hash)
+	defer span.End()
+
 	shouldProcessEvents := true
 	if eh.config.CheckDuplicates {
-		shouldProcessEvents = eh.tryCheckProcessedWithRetry(common.PushLogsAndEvents, events.Hash)
+		shouldProcessEvents = eh.tryCheckProcessedWithRetry(ctx, common.PushLogsAndEvents, events.Hash)
 	}
 
 	if !shouldProcessEvents {
@@ -107,12 +119,27 @@ func (eh *eventsHandler) HandlePushEvents(events data.BlockEvents) error {
 		)
 	}
 
+	eh.addEventsMetrics(events)
+
+	events.TraceContext = tracing.InjectContext(ctx)
+
 	t := time.Now()
 	eh.publisher.Broadcast(events)
 	eh.metricsHandler.AddRequest(getRabbitOpID(common.PushLogsAndEvents), time.Since(t))
 	return nil
 }
 
+func (eh *eventsHandler) addEventsMetrics(events data.BlockEvents) {
+	numEventsPerIdentifier := make(map[string]uint64)
+	for _, event := range events.Events {
+		numEventsPerIdentifier[event.Identifier]++
+	}
+
+	for identifier, numEvents := range numEventsPerIdentifier {
+		eh.metricsHandler.AddEvents(identifier, events.ShardID, numEvents)
+	}
+}
+
 // HandleRevertEvents will handle revents events received from observer
 func (eh *eventsHandler) HandleRevertEvents(revertBlock data.RevertBlock) {
 	if revertBlock.Hash == "" {
@@ -122,9 +149,12 @@ func (eh *eventsHandler) HandleRevertEvents(revertBlock data.RevertBlock) {
 		return
 	}
 
+	ctx, span := tracing.StartSpan(tracing.ExtractContext(context.Background(), revertBlock.TraceContext), "eventsHandler.HandleRevertEvents", revertBlock.Hash)
+	defer span.End()
+
 	shouldProcessRevert := true
 	if eh.config.CheckDuplicates {
-		shouldProcessRevert = eh.tryCheckProcessedWithRetry(common.RevertBlockEvents, revertBlock.Hash)
+		shouldProcessRevert = eh.tryCheckProcessedWithRetry(ctx, common.RevertBlockEvents, revertBlock.Hash)
 	}
 
 	if !shouldProcessRevert {
@@ -140,6 +170,8 @@ func (eh *eventsHandler) HandleRevertEvents(revertBlock data.RevertBlock) {
 		"will process", shouldProcessRevert,
 	)
 
+	revertBlock.TraceContext = tracing.InjectContext(ctx)
+
 	t := time.Now()
 	eh.publisher.BroadcastRevert(revertBlock)
 	eh.metricsHandler.AddRequest(getRabbitOpID(common.RevertBlockEvents), time.Since(t))
@@ -153,9 +185,13 @@ func (eh *eventsHandler) HandleFinalizedEvents(finalizedBlock data.FinalizedBloc
 		)
 		return
 	}
+
+	ctx, span := tracing.StartSpan(tracing.ExtractContext(context.Background(), finalizedBlock.TraceContext), "eventsHandler.HandleFinalizedEvents", finalizedBlock.Hash)
+	defer span.End()
+
 	shouldProcessFinalized := true
 	if eh.config.CheckDuplicates {
-		shouldProcessFinalized = eh.tryCheckProcessedWithRetry(common.FinalizedBlockEvents, finalizedBlock.Hash)
+		shouldProcessFinalized = eh.tryCheckProcessedWithRetry(ctx, common.FinalizedBlockEvents, finalizedBlock.Hash)
 	}
 
 	if !shouldProcessFinalized {
@@ -171,6 +207,8 @@ func (eh *eventsHandler) HandleFinalizedEvents(finalizedBlock data.FinalizedBloc
 		"will process", shouldProcessFinalized,
 	)
 
+	finalizedBlock.TraceContext = tracing.InjectContext(ctx)
+
 	t := time.Now()
 	eh.publisher.BroadcastFinalized(finalizedBlock)
 	eh.metricsHandler.AddRequest(getRabbitOpID(common.FinalizedBlockEvents), time.Since(t))
@@ -184,9 +222,13 @@ func (eh *eventsHandler) HandleBlockTxs(blockTxs data.BlockTxs) {
 		)
 		return
 	}
+
+	ctx, span := tracing.StartSpan(tracing.ExtractContext(context.Background(), blockTxs.TraceContext), "eventsHandler.HandleBlockTxs", blockTxs.Hash)
+	defer span.End()
+
 	shouldProcessTxs := true
 	if eh.config.CheckDuplicates {
-		shouldProcessTxs = eh.tryCheckProcessedWithRetry(common.BlockTxs, blockTxs.Hash)
+		shouldProcessTxs = eh.tryCheckProcessedWithRetry(ctx, common.BlockTxs, blockTxs.Hash)
 	}
 
 	if !shouldProcessTxs {
@@ -209,6 +251,8 @@ func (eh *eventsHandler) HandleBlockTxs(blockTxs data.BlockTxs) {
 		)
 	}
 
+	blockTxs.TraceContext = tracing.InjectContext(ctx)
+
 	t := time.Now()
 	eh.publisher.BroadcastTxs(blockTxs)
 	eh.metricsHandler.AddRequest(getRabbitOpID(common.BlockTxs), time.Since(t))
@@ -222,9 +266,13 @@ func (eh *eventsHandler) HandleBlockScrs(blockScrs data.BlockScrs) {
 		)
 		return
 	}
+
+	ctx, span := tracing.StartSpan(tracing.ExtractContext(context.Background(), blockScrs.TraceContext), "eventsHandler.HandleBlockScrs", blockScrs.Hash)
+	defer span.End()
+
 	shouldProcessScrs := true
 	if eh.config.CheckDuplicates {
-		shouldProcessScrs = eh.tryCheckProcessedWithRetry(common.BlockScrs, blockScrs.Hash)
+		shouldProcessScrs = eh.tryCheckProcessedWithRetry(ctx, common.BlockScrs, blockScrs.Hash)
 	}
 
 	if !shouldProcessScrs {
@@ -247,11 +295,340 @@ func (eh *eventsHandler) HandleBlockScrs(blockScrs data.BlockScrs) {
 		)
 	}
 
+	blockScrs.TraceContext = tracing.InjectContext(ctx)
+
 	t := time.Now()
 	eh.publisher.BroadcastScrs(blockScrs)
 	eh.metricsHandler.AddRequest(getRabbitOpID(common.BlockScrs), time.Since(t))
 }
 
+// HandleBlockRewards will handle rewards events received from observer
+func (eh *eventsHandler) HandleBlockRewards(blockRewards data.BlockRewards) {
+	if blockRewards.Hash == "" {
+		log.Warn("received empty hash", "event", common.BlockRewards,
+			"will process", false,
+		)
+		return
+	}
+
+	ctx, span := tracing.StartSpan(tracing.ExtractContext(context.Background(), blockRewards.TraceContext), "eventsHandler.HandleBlockRewards", blockRewards.Hash)
+	defer span.End()
+
+	shouldProcessRewards := true
+	if eh.config.CheckDuplicates {
+		shouldProcessRewards = eh.tryCheckProcessedWithRetry(ctx, common.BlockRewards, blockRewards.Hash)
+	}
+
+	if !shouldProcessRewards {
+		log.Info("received duplicated events", "event", common.BlockRewards,
+			"block hash", blockRewards.Hash,
+			"will process", false,
+		)
+		return
+	}
+
+	if len(blockRewards.Rewards) == 0 {
+		log.Warn("received empty events", "event", common.BlockRewards,
+			"block hash", blockRewards.Hash,
+			"will process", shouldProcessRewards,
+		)
+	} else {
+		log.Info("received", "event", common.BlockRewards,
+			"block hash", blockRewards.Hash,
+			"will process", shouldProcessRewards,
+		)
+	}
+
+	blockRewards.TraceContext = tracing.InjectContext(ctx)
+
+	t := time.Now()
+	eh.publisher.BroadcastRewards(blockRewards)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.BlockRewards), time.Since(t))
+}
+
+// HandleBlockReceipts will handle receipts events received from observer
+func (eh *eventsHandler) HandleBlockReceipts(blockReceipts data.BlockReceipts) {
+	if blockReceipts.Hash == "" {
+		log.Warn("received empty hash", "event", common.BlockReceipts,
+			"will process", false,
+		)
+		return
+	}
+
+	ctx, span := tracing.StartSpan(tracing.ExtractContext(context.Background(), blockReceipts.TraceContext), "eventsHandler.HandleBlockReceipts", blockReceipts.Hash)
+	defer span.End()
+
+	shouldProcessReceipts := true
+	if eh.config.CheckDuplicates {
+		shouldProcessReceipts = eh.tryCheckProcessedWithRetry(ctx, common.BlockReceipts, blockReceipts.Hash)
+	}
+
+	if !shouldProcessReceipts {
+		log.Info("received duplicated events", "event", common.BlockReceipts,
+			"block hash", blockReceipts.Hash,
+			"will process", false,
+		)
+		return
+	}
+
+	if len(blockReceipts.Receipts) == 0 {
+		log.Warn("received empty events", "event", common.BlockReceipts,
+			"block hash", blockReceipts.Hash,
+			"will process", shouldProcessReceipts,
+		)
+	} else {
+		log.Info("received", "event", common.BlockReceipts,
+			"block hash", blockReceipts.Hash,
+			"will process", shouldProcessReceipts,
+		)
+	}
+
+	blockReceipts.TraceContext = tracing.InjectContext(ctx)
+
+	t := time.Now()
+	eh.publisher.BroadcastReceipts(blockReceipts)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.BlockReceipts), time.Since(t))
+}
+
+// HandleBlockInvalidTxs will handle invalid txs events received from observer
+func (eh *eventsHandler) HandleBlockInvalidTxs(blockInvalidTxs data.BlockInvalidTxs) {
+	if blockInvalidTxs.Hash == "" {
+		log.Warn("received empty hash", "event", common.BlockInvalidTxs,
+			"will process", false,
+		)
+		return
+	}
+
+	ctx, span := tracing.StartSpan(tracing.ExtractContext(context.Background(), blockInvalidTxs.TraceContext), "eventsHandler.HandleBlockInvalidTxs", blockInvalidTxs.Hash)
+	defer span.End()
+
+	shouldProcessInvalidTxs := true
+	if eh.config.CheckDuplicates {
+		shouldProcessInvalidTxs = eh.tryCheckProcessedWithRetry(ctx, common.BlockInvalidTxs, blockInvalidTxs.Hash)
+	}
+
+	if !shouldProcessInvalidTxs {
+		log.Info("received duplicated events", "event", common.BlockInvalidTxs,
+			"block hash", blockInvalidTxs.Hash,
+			"will process", false,
+		)
+		return
+	}
+
+	if len(blockInvalidTxs.InvalidTxs) == 0 {
+		log.Warn("received empty events", "event", common.BlockInvalidTxs,
+			"block hash", blockInvalidTxs.Hash,
+			"will process", shouldProcessInvalidTxs,
+		)
+	} else {
+		log.Info("received", "event", common.BlockInvalidTxs,
+			"block hash", blockInvalidTxs.Hash,
+			"will process", shouldProcessInvalidTxs,
+		)
+	}
+
+	blockInvalidTxs.TraceContext = tracing.InjectContext(ctx)
+
+	t := time.Now()
+	eh.publisher.BroadcastInvalidTxs(blockInvalidTxs)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.BlockInvalidTxs), time.Since(t))
+}
+
+// HandleAlteredAccounts will handle altered accounts events received from observer
+func (eh *eventsHandler) HandleAlteredAccounts(blockAlteredAccounts data.BlockAlteredAccounts) {
+	if blockAlteredAccounts.Hash == "" {
+		log.Warn("received empty hash", "event", common.AlteredAccounts,
+			"will process", false,
+		)
+		return
+	}
+
+	ctx, span := tracing.StartSpan(tracing.ExtractContext(context.Background(), blockAlteredAccounts.TraceContext), "eventsHandler.HandleAlteredAccounts", blockAlteredAccounts.Hash)
+	defer span.End()
+
+	shouldProcessAlteredAccounts := true
+	if eh.config.CheckDuplicates {
+		shouldProcessAlteredAccounts = eh.tryCheckProcessedWithRetry(ctx, common.AlteredAccounts, blockAlteredAccounts.Hash)
+	}
+
+	if !shouldProcessAlteredAccounts {
+		log.Info("received duplicated events", "event", common.AlteredAccounts,
+			"block hash", blockAlteredAccounts.Hash,
+			"will process", false,
+		)
+		return
+	}
+
+	if len(blockAlteredAccounts.AlteredAccounts) == 0 {
+		log.Warn("received empty events", "event", common.AlteredAccounts,
+			"block hash", blockAlteredAccounts.Hash,
+			"will process", shouldProcessAlteredAccounts,
+		)
+	} else {
+		log.Info("received", "event", common.AlteredAccounts,
+			"block hash", blockAlteredAccounts.Hash,
+			"will process", shouldProcessAlteredAccounts,
+		)
+	}
+
+	blockAlteredAccounts.TraceContext = tracing.InjectContext(ctx)
+
+	t := time.Now()
+	eh.publisher.BroadcastAlteredAccounts(blockAlteredAccounts)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.AlteredAccounts), time.Since(t))
+}
+
+// HandleBlockHeader will handle block header events received from observer
+func (eh *eventsHandler) HandleBlockHeader(blockHeader data.BlockHeader) {
+	if blockHeader.Hash == "" {
+		log.Warn("received empty hash", "event", common.BlockHeader,
+			"will process", false,
+		)
+		return
+	}
+
+	ctx, span := tracing.StartSpan(tracing.ExtractContext(context.Background(), blockHeader.TraceContext), "eventsHandler.HandleBlockHeader", blockHeader.Hash)
+	defer span.End()
+
+	shouldProcessBlockHeader := true
+	if eh.config.CheckDuplicates {
+		shouldProcessBlockHeader = eh.tryCheckProcessedWithRetry(ctx, common.BlockHeader, blockHeader.Hash)
+	}
+
+	if !shouldProcessBlockHeader {
+		log.Info("received duplicated events", "event", common.BlockHeader,
+			"block hash", blockHeader.Hash,
+			"will process", false,
+		)
+		return
+	}
+
+	log.Info("received", "event", common.BlockHeader,
+		"block hash", blockHeader.Hash,
+		"will process", shouldProcessBlockHeader,
+	)
+
+	blockHeader.TraceContext = tracing.InjectContext(ctx)
+
+	t := time.Now()
+	eh.publisher.BroadcastBlockHeader(blockHeader)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.BlockHeader), time.Since(t))
+}
+
+// HandleEpochStart will handle epoch start events received from observer
+func (eh *eventsHandler) HandleEpochStart(epochStart data.EpochStart) {
+	if epochStart.Hash == "" {
+		log.Warn("received empty hash", "event", common.EpochStart,
+			"will process", false,
+		)
+		return
+	}
+
+	ctx, span := tracing.StartSpan(tracing.ExtractContext(context.Background(), epochStart.TraceContext), "eventsHandler.HandleEpochStart", epochStart.Hash)
+	defer span.End()
+
+	shouldProcessEpochStart := true
+	if eh.config.CheckDuplicates {
+		shouldProcessEpochStart = eh.tryCheckProcessedWithRetry(ctx, common.EpochStart, epochStart.Hash)
+	}
+
+	if !shouldProcessEpochStart {
+		log.Info("received duplicated events", "event", common.EpochStart,
+			"block hash", epochStart.Hash,
+			"will process", false,
+		)
+		return
+	}
+
+	log.Info("received", "event", common.EpochStart,
+		"block hash", epochStart.Hash,
+		"will process", shouldProcessEpochStart,
+	)
+
+	epochStart.TraceContext = tracing.InjectContext(ctx)
+
+	t := time.Now()
+	eh.publisher.BroadcastEpochStart(epochStart)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.EpochStart), time.Since(t))
+}
+
+// HandleTxBundles will handle tx bundles events received from observer
+func (eh *eventsHandler) HandleTxBundles(blockTxBundles data.BlockTxBundles) {
+	if blockTxBundles.Hash == "" {
+		log.Warn("received empty hash", "event", common.TxBundles,
+			"will process", false,
+		)
+		return
+	}
+
+	ctx, span := tracing.StartSpan(tracing.ExtractContext(context.Background(), blockTxBundles.TraceContext), "eventsHandler.HandleTxBundles", blockTxBundles.Hash)
+	defer span.End()
+
+	shouldProcessTxBundles := true
+	if eh.config.CheckDuplicates {
+		shouldProcessTxBundles = eh.tryCheckProcessedWithRetry(ctx, common.TxBundles, blockTxBundles.Hash)
+	}
+
+	if !shouldProcessTxBundles {
+		log.Info("received duplicated events", "event", common.TxBundles,
+			"block hash", blockTxBundles.Hash,
+			"will process", false,
+		)
+		return
+	}
+
+	log.Info("received", "event", common.TxBundles,
+		"block hash", blockTxBundles.Hash,
+		"will process", shouldProcessTxBundles,
+	)
+
+	blockTxBundles.TraceContext = tracing.InjectContext(ctx)
+
+	t := time.Now()
+	eh.publisher.BroadcastTxBundles(blockTxBundles)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.TxBundles), time.Since(t))
+}
+
+// HandleTxStatus will handle the final status of a transaction received from the tx status tracker.
+// The duplicates are checked by tx hash and status, so that each status is sent only once
+func (eh *eventsHandler) HandleTxStatus(txStatus data.TxStatus) {
+	if txStatus.TxHash == "" {
+		log.Warn("received empty tx hash", "event", common.TxStatus,
+			"will process", false,
+		)
+		return
+	}
+
+	ctx, span := tracing.StartSpan(tracing.ExtractContext(context.Background(), txStatus.TraceContext), "eventsHandler.HandleTxStatus", txStatus.TxHash)
+	defer span.End()
+
+	shouldProcessTxStatus := true
+	if eh.config.CheckDuplicates {
+		shouldProcessTxStatus = eh.tryCheckProcessedWithRetry(ctx, common.TxStatus, txStatus.TxHash+"_"+txStatus.Status)
+	}
+
+	if !shouldProcessTxStatus {
+		log.Info("received duplicated events", "event", common.TxStatus,
+			"tx hash", txStatus.TxHash,
+			"status", txStatus.Status,
+			"will process", false,
+		)
+		return
+	}
+
+	log.Debug("received", "event", common.TxStatus,
+		"tx hash", txStatus.TxHash,
+		"status", txStatus.Status,
+		"will process", shouldProcessTxStatus,
+	)
+
+	txStatus.TraceContext = tracing.InjectContext(ctx)
+
+	t := time.Now()
+	eh.publisher.BroadcastTxStatus(txStatus)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.TxStatus), time.Since(t))
+}
+
 // HandleBlockEventsWithOrder will handle full block events received from observer
 func (eh *eventsHandler) HandleBlockEventsWithOrder(blockTxs data.BlockEventsWithOrder) {
 	if blockTxs.Hash == "" {
@@ -260,9 +637,13 @@ func (eh *eventsHandler) HandleBlockEventsWithOrder(blockTxs data.BlockEventsWit
 		)
 		return
 	}
+
+	ctx, span := tracing.StartSpan(tracing.ExtractContext(context.Background(), blockTxs.TraceContext), "eventsHandler.HandleBlockEventsWithOrder", blockTxs.Hash)
+	defer span.End()
+
 	shouldProcessTxs := true
 	if eh.config.CheckDuplicates {
-		shouldProcessTxs = eh.tryCheckProcessedWithRetry(common.BlockEvents, blockTxs.Hash)
+		shouldProcessTxs = eh.tryCheckProcessedWithRetry(ctx, common.BlockEvents, blockTxs.Hash)
 	}
 
 	if !shouldProcessTxs {
@@ -278,18 +659,41 @@ func (eh *eventsHandler) HandleBlockEventsWithOrder(blockTxs data.BlockEventsWit
 		"will process", shouldProcessTxs,
 	)
 
+	blockTxs.TraceContext = tracing.InjectContext(ctx)
+
 	t := time.Now()
 	eh.publisher.BroadcastBlockEventsWithOrder(blockTxs)
 	eh.metricsHandler.AddRequest(getRabbitOpID(common.BlockEvents), time.Since(t))
 }
 
-func (eh *eventsHandler) tryCheckProcessedWithRetry(id, blockHash string) bool {
+// HandleSourceStale will handle the source stale events fired when no block arrives for a shard
+func (eh *eventsHandler) HandleSourceStale(event data.SourceStaleEvent) {
+	log.Warn("no block received for shard", "event", common.SourceStaleEvents,
+		"shard", event.ShardID,
+		"last nonce", event.LastNonce,
+		"stale for seconds", event.StaleForSeconds,
+	)
+
+	t := time.Now()
+	eh.publisher.BroadcastSourceStale(event)
+	eh.metricsHandler.AddRequest(getRabbitOpID(common.SourceStaleEvents), time.Since(t))
+}
+
+func (eh *eventsHandler) tryCheckProcessedWithRetry(ctx context.Context, id, blockHash string) bool {
 	var err error
 	var setSuccessful bool
 
 	prefix := getPrefixLockerKey(id)
 	key := prefix + blockHash
 
+	_, span := tracing.StartSpan(ctx, "redis.IsEventProcessed", blockHash)
+	defer span.End()
+
+	startTime := time.Now()
+	defer func() {
+		eh.metricsHandler.ObserveStage(common.DedupStage, time.Since(startTime))
+	}()
+
 	for {
 		t := time.Now()
 		setSuccessful, err = eh.locker.IsEventProcessed(context.Background(), key)
@@ -300,6 +704,8 @@ func (eh *eventsHandler) tryCheckProcessedWithRetry(id, blockHash string) bool {
 		}
 
 		log.Error("failed to check event in locker", "error", err.Error())
+		tracing.RecordError(span, err)
+		eh.metricsHandler.AddStageError(common.DedupStage)
 		if !eh.locker.HasConnection(context.Background()) {
 			log.Error("failure connecting to locker service")
 
@@ -327,6 +733,22 @@ func getPrefixLockerKey(id string) string {
 		return txsKeyPrefix
 	case common.BlockScrs:
 		return scrsKeyPrefix
+	case common.BlockRewards:
+		return rewardsKeyPrefix
+	case common.BlockReceipts:
+		return receiptsKeyPrefix
+	case common.BlockInvalidTxs:
+		return invalidTxsKeyPrefix
+	case common.AlteredAccounts:
+		return alteredAccountsKeyPrefix
+	case common.BlockHeader:
+		return blockHeaderKeyPrefix
+	case common.EpochStart:
+		return epochStartKeyPrefix
+	case common.TxBundles:
+		return txBundlesKeyPrefix
+	case common.TxStatus:
+		return txStatusKeyPrefix
 	case common.BlockEvents:
 		return txsWithOrderKeyPrefix
 	}
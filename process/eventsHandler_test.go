@@ -4,8 +4,12 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-notifier-go/common"
@@ -133,6 +137,36 @@ func TestHandlePushEvents(t *testing.T) {
 		eventsHandler.HandlePushEvents(blockEvents)
 		require.False(t, wasCalled)
 	})
+
+	t.Run("should count events by identifier and shard", func(t *testing.T) {
+		t.Parallel()
+
+		blockEvents := data.BlockEvents{
+			Hash:    "hash1",
+			ShardID: 2,
+			Events: []data.Event{
+				{Identifier: "transfer"},
+				{Identifier: "swap"},
+				{Identifier: "transfer"},
+			},
+		}
+
+		numEvents := make(map[string]uint64)
+		args := createMockEventsHandlerArgs()
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddEventsCalled: func(identifier string, shardID uint32, count uint64) {
+				require.Equal(t, uint32(2), shardID)
+				numEvents[identifier] += count
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		err = eventsHandler.HandlePushEvents(blockEvents)
+		require.Nil(t, err)
+		require.Equal(t, map[string]uint64{"transfer": 2, "swap": 1}, numEvents)
+	})
 }
 
 func TestHandleRevertEvents(t *testing.T) {
@@ -255,9 +289,12 @@ func TestHandleTxsEvents(t *testing.T) {
 
 		blockTxs := data.BlockTxs{
 			Hash: "hash1",
-			Txs: map[string]*transaction.Transaction{
-				"hash1": {
-					Nonce: 1,
+			Txs: []*data.NotifierTransaction{
+				{
+					Hash: "hash1",
+					Transaction: &transaction.Transaction{
+						Nonce: 1,
+					},
 				},
 			},
 		}
@@ -283,9 +320,12 @@ func TestHandleTxsEvents(t *testing.T) {
 
 		blockTxs := data.BlockTxs{
 			Hash: "hash1",
-			Txs: map[string]*transaction.Transaction{
-				"hash1": {
-					Nonce: 1,
+			Txs: []*data.NotifierTransaction{
+				{
+					Hash: "hash1",
+					Transaction: &transaction.Transaction{
+						Nonce: 1,
+					},
 				},
 			},
 		}
@@ -321,9 +361,12 @@ func TestHandleScrsEvents(t *testing.T) {
 
 		blockScrs := data.BlockScrs{
 			Hash: "hash1",
-			Scrs: map[string]*smartContractResult.SmartContractResult{
-				"hash2": {
-					Nonce: 2,
+			Scrs: []*data.NotifierSmartContractResult{
+				{
+					Hash: "hash2",
+					SmartContractResult: &smartContractResult.SmartContractResult{
+						Nonce: 2,
+					},
 				},
 			},
 		}
@@ -366,9 +409,12 @@ func TestHandleScrsEvents(t *testing.T) {
 
 		events := data.BlockScrs{
 			Hash: "hash1",
-			Scrs: map[string]*smartContractResult.SmartContractResult{
-				"hash2": {
-					Nonce: 2,
+			Scrs: []*data.NotifierSmartContractResult{
+				{
+					Hash: "hash2",
+					SmartContractResult: &smartContractResult.SmartContractResult{
+						Nonce: 2,
+					},
 				},
 			},
 		}
@@ -378,13 +424,513 @@ func TestHandleScrsEvents(t *testing.T) {
 	})
 }
 
+func TestHandleRewardsEvents(t *testing.T) {
+	t.Parallel()
+
+	blockRewards := data.BlockRewards{
+		Hash: "hash1",
+		Rewards: []*data.NotifierRewardTx{
+			{
+				Hash: "hash2",
+				RewardTx: &rewardTx.RewardTx{
+					Round: 2,
+				},
+			},
+		},
+	}
+
+	t.Run("broadcast rewards event was called", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastRewardsCalled: func(event data.BlockRewards) {
+				require.Equal(t, blockRewards, event)
+				wasCalled = true
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleBlockRewards(blockRewards)
+		require.True(t, wasCalled)
+	})
+
+	t.Run("check duplicates enabled, should not process event", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Config.CheckDuplicates = true
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastRewardsCalled: func(event data.BlockRewards) {
+				wasCalled = true
+			},
+		}
+		args.Locker = &mocks.LockerStub{
+			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				require.Equal(t, "rewards_hash1", blockHash)
+				return false, nil
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleBlockRewards(blockRewards)
+		require.False(t, wasCalled)
+	})
+}
+
+func TestHandleReceiptsEvents(t *testing.T) {
+	t.Parallel()
+
+	blockReceipts := data.BlockReceipts{
+		Hash: "hash1",
+		Receipts: []*data.NotifierReceipt{
+			{
+				Hash: "hash2",
+				Receipt: &receipt.Receipt{
+					TxHash: []byte("hash3"),
+				},
+			},
+		},
+	}
+
+	t.Run("broadcast receipts event was called", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastReceiptsCalled: func(event data.BlockReceipts) {
+				require.Equal(t, blockReceipts, event)
+				wasCalled = true
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleBlockReceipts(blockReceipts)
+		require.True(t, wasCalled)
+	})
+
+	t.Run("check duplicates enabled, should not process event", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Config.CheckDuplicates = true
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastReceiptsCalled: func(event data.BlockReceipts) {
+				wasCalled = true
+			},
+		}
+		args.Locker = &mocks.LockerStub{
+			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				require.Equal(t, "receipts_hash1", blockHash)
+				return false, nil
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleBlockReceipts(blockReceipts)
+		require.False(t, wasCalled)
+	})
+}
+
+func TestHandleInvalidTxsEvents(t *testing.T) {
+	t.Parallel()
+
+	blockInvalidTxs := data.BlockInvalidTxs{
+		Hash: "hash1",
+		InvalidTxs: []*data.NotifierTransaction{
+			{
+				Hash: "hash2",
+				Transaction: &transaction.Transaction{
+					Nonce: 2,
+				},
+			},
+		},
+	}
+
+	t.Run("broadcast invalid txs event was called", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastInvalidTxsCalled: func(event data.BlockInvalidTxs) {
+				require.Equal(t, blockInvalidTxs, event)
+				wasCalled = true
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleBlockInvalidTxs(blockInvalidTxs)
+		require.True(t, wasCalled)
+	})
+
+	t.Run("check duplicates enabled, should not process event", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Config.CheckDuplicates = true
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastInvalidTxsCalled: func(event data.BlockInvalidTxs) {
+				wasCalled = true
+			},
+		}
+		args.Locker = &mocks.LockerStub{
+			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				require.Equal(t, "invalidTxs_hash1", blockHash)
+				return false, nil
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleBlockInvalidTxs(blockInvalidTxs)
+		require.False(t, wasCalled)
+	})
+}
+
+func TestHandleAlteredAccountsEvents(t *testing.T) {
+	t.Parallel()
+
+	blockAlteredAccounts := data.BlockAlteredAccounts{
+		Hash: "hash1",
+		AlteredAccounts: map[string]*alteredAccount.AlteredAccount{
+			"erd1addr": {
+				Address: "erd1addr",
+				Balance: "10",
+			},
+		},
+	}
+
+	t.Run("broadcast altered accounts event was called", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastAlteredAccountsCalled: func(event data.BlockAlteredAccounts) {
+				require.Equal(t, blockAlteredAccounts, event)
+				wasCalled = true
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleAlteredAccounts(blockAlteredAccounts)
+		require.True(t, wasCalled)
+	})
+
+	t.Run("check duplicates enabled, should not process event", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Config.CheckDuplicates = true
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastAlteredAccountsCalled: func(event data.BlockAlteredAccounts) {
+				wasCalled = true
+			},
+		}
+		args.Locker = &mocks.LockerStub{
+			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				require.Equal(t, "alteredAccounts_hash1", blockHash)
+				return false, nil
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleAlteredAccounts(blockAlteredAccounts)
+		require.False(t, wasCalled)
+	})
+}
+
+func TestHandleBlockHeaderEvents(t *testing.T) {
+	t.Parallel()
+
+	blockHeader := data.BlockHeader{
+		Hash:    "hash1",
+		ShardID: 1,
+		Nonce:   10,
+	}
+
+	t.Run("broadcast block header event was called", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastBlockHeaderCalled: func(event data.BlockHeader) {
+				require.Equal(t, blockHeader, event)
+				wasCalled = true
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleBlockHeader(blockHeader)
+		require.True(t, wasCalled)
+	})
+
+	t.Run("check duplicates enabled, should not process event", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Config.CheckDuplicates = true
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastBlockHeaderCalled: func(event data.BlockHeader) {
+				wasCalled = true
+			},
+		}
+		args.Locker = &mocks.LockerStub{
+			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				require.Equal(t, "blockHeader_hash1", blockHash)
+				return false, nil
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleBlockHeader(blockHeader)
+		require.False(t, wasCalled)
+	})
+}
+
+func TestHandleEpochStartEvents(t *testing.T) {
+	t.Parallel()
+
+	epochStart := data.EpochStart{
+		Hash:  "hash1",
+		Epoch: 3,
+		Nonce: 10,
+	}
+
+	t.Run("broadcast epoch start event was called", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastEpochStartCalled: func(event data.EpochStart) {
+				require.Equal(t, epochStart, event)
+				wasCalled = true
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleEpochStart(epochStart)
+		require.True(t, wasCalled)
+	})
+
+	t.Run("check duplicates enabled, should not process event", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Config.CheckDuplicates = true
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastEpochStartCalled: func(event data.EpochStart) {
+				wasCalled = true
+			},
+		}
+		args.Locker = &mocks.LockerStub{
+			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				require.Equal(t, "epochStart_hash1", blockHash)
+				return false, nil
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleEpochStart(epochStart)
+		require.False(t, wasCalled)
+	})
+}
+
+func TestHandleTxBundlesEvents(t *testing.T) {
+	t.Parallel()
+
+	blockTxBundles := data.BlockTxBundles{
+		Hash: "hash1",
+		Bundles: []*data.TxBundle{
+			{TxHash: "txHash1"},
+		},
+	}
+
+	t.Run("broadcast tx bundles event was called", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastTxBundlesCalled: func(event data.BlockTxBundles) {
+				require.Equal(t, blockTxBundles, event)
+				wasCalled = true
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleTxBundles(blockTxBundles)
+		require.True(t, wasCalled)
+	})
+
+	t.Run("check duplicates enabled, should not process event", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Config.CheckDuplicates = true
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastTxBundlesCalled: func(event data.BlockTxBundles) {
+				wasCalled = true
+			},
+		}
+		args.Locker = &mocks.LockerStub{
+			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				require.Equal(t, "txBundles_hash1", blockHash)
+				return false, nil
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleTxBundles(blockTxBundles)
+		require.False(t, wasCalled)
+	})
+}
+
+func TestHandleTxStatusEvents(t *testing.T) {
+	t.Parallel()
+
+	txStatus := data.TxStatus{
+		TxHash:    "txHash1",
+		Sender:    "erd1sender",
+		Status:    common.TxStatusSuccess,
+		BlockHash: "hash1",
+	}
+
+	t.Run("broadcast tx status event was called", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastTxStatusCalled: func(event data.TxStatus) {
+				require.Equal(t, txStatus, event)
+				wasCalled = true
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleTxStatus(txStatus)
+		require.True(t, wasCalled)
+	})
+
+	t.Run("empty tx hash, should not process event", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastTxStatusCalled: func(event data.TxStatus) {
+				wasCalled = true
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleTxStatus(data.TxStatus{Status: common.TxStatusSuccess})
+		require.False(t, wasCalled)
+	})
+
+	t.Run("check duplicates enabled, should check tx hash and status", func(t *testing.T) {
+		t.Parallel()
+
+		wasCalled := false
+		args := createMockEventsHandlerArgs()
+		args.Config.CheckDuplicates = true
+		args.Publisher = &mocks.PublisherStub{
+			BroadcastTxStatusCalled: func(event data.TxStatus) {
+				wasCalled = true
+			},
+		}
+		args.Locker = &mocks.LockerStub{
+			IsEventProcessedCalled: func(ctx context.Context, blockHash string) (bool, error) {
+				require.Equal(t, "txStatus_txHash1_success", blockHash)
+				return false, nil
+			},
+		}
+
+		eventsHandler, err := process.NewEventsHandler(args)
+		require.Nil(t, err)
+
+		eventsHandler.HandleTxStatus(txStatus)
+		require.False(t, wasCalled)
+	})
+}
+
+func TestHandleSourceStale(t *testing.T) {
+	t.Parallel()
+
+	event := data.SourceStaleEvent{
+		ShardID:         1,
+		LastNonce:       10,
+		StaleForSeconds: 60,
+	}
+
+	wasCalled := false
+	args := createMockEventsHandlerArgs()
+	args.Publisher = &mocks.PublisherStub{
+		BroadcastSourceStaleCalled: func(ev data.SourceStaleEvent) {
+			require.Equal(t, event, ev)
+			wasCalled = true
+		},
+	}
+
+	eventsHandler, err := process.NewEventsHandler(args)
+	require.Nil(t, err)
+
+	eventsHandler.HandleSourceStale(event)
+	require.True(t, wasCalled)
+}
+
 func TestHandleBlockEventsWithOrderEvents(t *testing.T) {
 	t.Parallel()
 
 	events := data.BlockEventsWithOrder{
 		Hash: "hash1",
-		Txs: map[string]*data.NotifierTransaction{
-			"hash1": {
+		Txs: []*data.NotifierTransaction{
+			{
+				Hash: "hash1",
 				Transaction: &transaction.Transaction{
 					Nonce: 1,
 				},
@@ -503,11 +1049,25 @@ func TestTryCheckProcessedWithRetry(t *testing.T) {
 				return false
 			},
 		}
+		numStageErrors := 0
+		numStageObservations := 0
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddStageErrorCalled: func(stage string) {
+				require.Equal(t, common.DedupStage, stage)
+				numStageErrors++
+			},
+			ObserveStageCalled: func(stage string, duration time.Duration) {
+				require.Equal(t, common.DedupStage, stage)
+				numStageObservations++
+			},
+		}
 
 		eventsHandler, err := process.NewEventsHandler(args)
 		require.Nil(t, err)
 
 		ok := eventsHandler.TryCheckProcessedWithRetry(prefix, hash)
 		require.True(t, ok)
+		require.Equal(t, 1, numStageErrors)
+		require.Equal(t, 1, numStageObservations)
 	})
 }
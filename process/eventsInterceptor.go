@@ -3,11 +3,12 @@ package process
 import (
 	"bytes"
 	"encoding/hex"
+	"sort"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	nodeData "github.com/multiversx/mx-chain-core-go/data"
-	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
-	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 )
 
@@ -59,41 +60,85 @@ func (ei *eventsInterceptor) ProcessBlockEvents(eventsData *data.ArgsSaveBlockDa
 	if eventsData.Header == nil {
 		return nil, ErrNilBlockHeader
 	}
-	scrs := make(map[string]*smartContractResult.SmartContractResult)
+	scrs := make([]*data.NotifierSmartContractResult, 0, len(eventsData.TransactionsPool.Scrs))
 	scrHashes := make(map[string]string)
-	scrsWithOrder := make(map[string]*data.NotifierSmartContractResult)
 	for hash, scr := range eventsData.TransactionsPool.Scrs {
-		scrs[hash] = scr.TransactionHandler
+		if scr == nil {
+			continue
+		}
 		scrHashes[hash] = hash
-		scrsWithOrder[hash] = &data.NotifierSmartContractResult{
+		scrs = append(scrs, &data.NotifierSmartContractResult{
+			Hash:                hash,
 			SmartContractResult: scr.TransactionHandler,
 			FeeInfo:             scr.FeeInfo,
 			ExecutionOrder:      scr.ExecutionOrder,
-		}
+		})
 	}
+	sort.Slice(scrs, func(i, j int) bool {
+		return isBeforeInExecutionOrder(scrs[i].ExecutionOrder, scrs[i].Hash, scrs[j].ExecutionOrder, scrs[j].Hash)
+	})
 
 	events := ei.getLogEventsFromTransactionsPool(eventsData.TransactionsPool.Logs, scrHashes)
 
-	txs := make(map[string]*transaction.Transaction)
-	txsWithOrder := make(map[string]*data.NotifierTransaction)
-	for hash, tx := range eventsData.TransactionsPool.Txs {
-		txs[hash] = tx.TransactionHandler
-		txsWithOrder[hash] = &data.NotifierTransaction{
-			Transaction:    tx.TransactionHandler,
-			FeeInfo:        tx.FeeInfo,
-			ExecutionOrder: tx.ExecutionOrder,
+	txs := getTxsWithOrder(eventsData.TransactionsPool.Txs)
+
+	rewards := make([]*data.NotifierRewardTx, 0, len(eventsData.TransactionsPool.Rewards))
+	for hash, reward := range eventsData.TransactionsPool.Rewards {
+		if reward == nil {
+			continue
+		}
+		rewards = append(rewards, &data.NotifierRewardTx{
+			Hash:           hash,
+			RewardTx:       reward.TransactionHandler,
+			FeeInfo:        reward.FeeInfo,
+			ExecutionOrder: reward.ExecutionOrder,
+		})
+	}
+	sort.Slice(rewards, func(i, j int) bool {
+		return isBeforeInExecutionOrder(rewards[i].ExecutionOrder, rewards[i].Hash, rewards[j].ExecutionOrder, rewards[j].Hash)
+	})
+
+	receipts := make([]*data.NotifierReceipt, 0, len(eventsData.TransactionsPool.Receipts))
+	for hash, rec := range eventsData.TransactionsPool.Receipts {
+		if rec == nil {
+			continue
 		}
+		receipts = append(receipts, &data.NotifierReceipt{
+			Hash:           hash,
+			Receipt:        rec.TransactionHandler,
+			FeeInfo:        rec.FeeInfo,
+			ExecutionOrder: rec.ExecutionOrder,
+		})
+	}
+	sort.Slice(receipts, func(i, j int) bool {
+		return isBeforeInExecutionOrder(receipts[i].ExecutionOrder, receipts[i].Hash, receipts[j].ExecutionOrder, receipts[j].Hash)
+	})
+
+	invalidTxs := getTxsWithOrder(eventsData.TransactionsPool.Invalid)
+
+	blockHash := hex.EncodeToString(eventsData.HeaderHash)
+	setEventsBlockContext(events, blockHash, eventsData.Header, getExecutionOrders(eventsData.TransactionsPool))
+	txBundles := ei.createTxBundles(eventsData.TransactionsPool, events, eventsData.Header.GetShardID())
+
+	alteredAccounts := make(map[string]*alteredAccount.AlteredAccount)
+	for address, account := range eventsData.AlteredAccounts {
+		alteredAccounts[address] = account
 	}
 
 	return &data.InterceptorBlockData{
-		Hash:          hex.EncodeToString(eventsData.HeaderHash),
-		Body:          eventsData.Body,
-		Header:        eventsData.Header,
-		Txs:           txs,
-		TxsWithOrder:  txsWithOrder,
-		Scrs:          scrs,
-		ScrsWithOrder: scrsWithOrder,
-		LogEvents:     events,
+		Hash:                   blockHash,
+		Body:                   eventsData.Body,
+		Header:                 eventsData.Header,
+		Txs:                    txs,
+		Scrs:                   scrs,
+		Rewards:                rewards,
+		Receipts:               receipts,
+		InvalidTxs:             invalidTxs,
+		AlteredAccounts:        alteredAccounts,
+		NotarizedHeadersHashes: eventsData.NotarizedHeadersHashes,
+		HeaderGasConsumption:   eventsData.HeaderGasConsumption,
+		LogEvents:              events,
+		TxBundles:              txBundles,
 	}, nil
 }
 
@@ -152,11 +197,11 @@ func (ei *eventsInterceptor) getLogEventsFromTransactionsPool(logs []*data.LogDa
 		if event == nil || check.IfNil(event.EventHandler) {
 			continue
 		}
-		hexAddress := ei.hexKeyConvertor.Encode(event.EventHandler.GetAddress())
+		hexAddress := ei.hexKeyConvertor.SilentEncode(event.EventHandler.GetAddress(), log)
 		shardAddress := getShardOfAddress(hexAddress)
-		bech32Address := ei.pubKeyConverter.Encode(event.EventHandler.GetAddress())
-		bech32MainLogAddress := ei.pubKeyConverter.Encode(event.Address)
-		hexMainLogAddress := ei.hexKeyConvertor.Encode(event.Address)
+		bech32Address := ei.pubKeyConverter.SilentEncode(event.EventHandler.GetAddress(), log)
+		bech32MainLogAddress := ei.pubKeyConverter.SilentEncode(event.Address, log)
+		hexMainLogAddress := ei.hexKeyConvertor.SilentEncode(event.Address, log)
 		shardMainLogAddress := getShardOfAddress(hexMainLogAddress)
 		eventIdentifier := string(event.EventHandler.GetIdentifier())
 
@@ -181,6 +226,90 @@ func (ei *eventsInterceptor) getLogEventsFromTransactionsPool(logs []*data.LogDa
 	return events
 }
 
+// setEventsBlockContext sets the block context on the provided events, together with the execution
+// order of the originating transaction. The events are then sorted in canonical execution order and
+// their position in the block is set accordingly
+func setEventsBlockContext(events []data.Event, blockHash string, header nodeData.HeaderHandler, executionOrders map[string]int) {
+	for i := range events {
+		events[i].BlockHash = blockHash
+		events[i].ShardID = header.GetShardID()
+		events[i].BlockNonce = header.GetNonce()
+		events[i].BlockRound = header.GetRound()
+		events[i].BlockTimeStamp = header.GetTimeStamp()
+		events[i].ExecutionOrder = executionOrders[events[i].TxHash]
+	}
+
+	sortEventsByExecutionOrder(events, executionOrders)
+
+	for i := range events {
+		events[i].EventIndex = i
+	}
+}
+
+// sortEventsByExecutionOrder orders the events by the execution order of their originating
+// transaction or smart contract result. The events whose originating transaction is not in the block
+// have no known execution order and are placed last. The sort is stable, so events with the same
+// execution order, or without one, keep their position within the log
+func sortEventsByExecutionOrder(events []data.Event, executionOrders map[string]int) {
+	sort.SliceStable(events, func(i, j int) bool {
+		_, isKnownI := executionOrders[events[i].TxHash]
+		_, isKnownJ := executionOrders[events[j].TxHash]
+		if isKnownI != isKnownJ {
+			return isKnownI
+		}
+
+		return events[i].ExecutionOrder < events[j].ExecutionOrder
+	})
+}
+
+// getTxsWithOrder returns the transactions of the pool ordered by their execution order
+func getTxsWithOrder(poolTxs map[string]*data.NodeTransaction) []*data.NotifierTransaction {
+	txs := make([]*data.NotifierTransaction, 0, len(poolTxs))
+	for hash, tx := range poolTxs {
+		if tx == nil {
+			continue
+		}
+		txs = append(txs, &data.NotifierTransaction{
+			Hash:           hash,
+			Transaction:    tx.TransactionHandler,
+			FeeInfo:        tx.FeeInfo,
+			ExecutionOrder: tx.ExecutionOrder,
+		})
+	}
+	sort.Slice(txs, func(i, j int) bool {
+		return isBeforeInExecutionOrder(txs[i].ExecutionOrder, txs[i].Hash, txs[j].ExecutionOrder, txs[j].Hash)
+	})
+
+	return txs
+}
+
+// isBeforeInExecutionOrder orders by execution order, and by hash for the same execution order,
+// so the order does not depend on the map iteration order of the transactions pool
+func isBeforeInExecutionOrder(executionOrderI int, hashI string, executionOrderJ int, hashJ string) bool {
+	if executionOrderI != executionOrderJ {
+		return executionOrderI < executionOrderJ
+	}
+
+	return hashI < hashJ
+}
+
+// getExecutionOrders returns the execution order of the block transactions and smart contract results, mapped by hash
+func getExecutionOrders(pool *data.TransactionsPool) map[string]int {
+	executionOrders := make(map[string]int, len(pool.Txs)+len(pool.Scrs))
+	for hash, tx := range pool.Txs {
+		if tx != nil {
+			executionOrders[hash] = tx.ExecutionOrder
+		}
+	}
+	for hash, scr := range pool.Scrs {
+		if scr != nil {
+			executionOrders[hash] = scr.ExecutionOrder
+		}
+	}
+
+	return executionOrders
+}
+
 // IsInterfaceNil returns whether the interface is nil
 func (ei *eventsInterceptor) IsInterfaceNil() bool {
 	return ei == nil
@@ -5,7 +5,10 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/alteredAccount"
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-notifier-go/data"
@@ -100,6 +103,92 @@ func TestProcessBlockEvents(t *testing.T) {
 		require.Equal(t, process.ErrNilBlockHeader, err)
 	})
 
+	t.Run("nil transactions pool entries should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		eventsInterceptor, _ := process.NewEventsInterceptor(createMockEventsInterceptorArgs())
+
+		eventsData := &data.ArgsSaveBlockData{
+			HeaderHash: []byte("headerHash"),
+			TransactionsPool: &data.TransactionsPool{
+				Txs:      map[string]*data.NodeTransaction{"hash1": nil},
+				Scrs:     map[string]*data.NodeSmartContractResult{"hash2": nil},
+				Rewards:  map[string]*data.NodeRewardTx{"hash3": nil},
+				Receipts: map[string]*data.NodeReceipt{"hash4": nil},
+				Invalid:  map[string]*data.NodeTransaction{"hash5": nil},
+			},
+			Body:   &block.Body{},
+			Header: &block.HeaderV2{Header: &block.Header{}},
+		}
+
+		var events *data.InterceptorBlockData
+		var err error
+		require.NotPanics(t, func() {
+			events, err = eventsInterceptor.ProcessBlockEvents(eventsData)
+		})
+		require.Nil(t, err)
+		require.Empty(t, events.Txs)
+		require.Empty(t, events.Scrs)
+		require.Empty(t, events.Rewards)
+		require.Empty(t, events.Receipts)
+		require.Empty(t, events.InvalidTxs)
+	})
+
+	t.Run("should order the transactions pool entries by execution order", func(t *testing.T) {
+		t.Parallel()
+
+		eventsInterceptor, _ := process.NewEventsInterceptor(createMockEventsInterceptorArgs())
+
+		eventsData := &data.ArgsSaveBlockData{
+			HeaderHash: []byte("headerHash"),
+			TransactionsPool: &data.TransactionsPool{
+				Txs: map[string]*data.NodeTransaction{
+					"txHash3": {TransactionHandler: &transaction.Transaction{Nonce: 3}, ExecutionOrder: 2},
+					"txHash1": {TransactionHandler: &transaction.Transaction{Nonce: 1}, ExecutionOrder: 5},
+					"txHash2": {TransactionHandler: &transaction.Transaction{Nonce: 2}, ExecutionOrder: 2},
+				},
+				Scrs: map[string]*data.NodeSmartContractResult{
+					"scrHash1": {TransactionHandler: &smartContractResult.SmartContractResult{}, ExecutionOrder: 4},
+					"scrHash2": {TransactionHandler: &smartContractResult.SmartContractResult{}, ExecutionOrder: 3},
+				},
+				Rewards: map[string]*data.NodeRewardTx{
+					"rewardHash1": {TransactionHandler: &rewardTx.RewardTx{}, ExecutionOrder: 7},
+					"rewardHash2": {TransactionHandler: &rewardTx.RewardTx{}, ExecutionOrder: 6},
+				},
+				Receipts: map[string]*data.NodeReceipt{
+					"receiptHash1": {TransactionHandler: &receipt.Receipt{}, ExecutionOrder: 9},
+					"receiptHash2": {TransactionHandler: &receipt.Receipt{}, ExecutionOrder: 8},
+				},
+				Invalid: map[string]*data.NodeTransaction{
+					"invalidHash1": {TransactionHandler: &transaction.Transaction{}, ExecutionOrder: 11},
+					"invalidHash2": {TransactionHandler: &transaction.Transaction{}, ExecutionOrder: 10},
+				},
+			},
+			Body:   &block.Body{},
+			Header: &block.HeaderV2{Header: &block.Header{}},
+		}
+
+		events, err := eventsInterceptor.ProcessBlockEvents(eventsData)
+		require.Nil(t, err)
+
+		txHashes := make([]string, 0, len(events.Txs))
+		for _, tx := range events.Txs {
+			txHashes = append(txHashes, tx.Hash)
+		}
+		require.Equal(t, []string{"txHash2", "txHash3", "txHash1"}, txHashes)
+		require.Equal(t, 2, events.Txs[0].ExecutionOrder)
+		require.Equal(t, uint64(2), events.Txs[0].Nonce)
+
+		require.Equal(t, "scrHash2", events.Scrs[0].Hash)
+		require.Equal(t, "scrHash1", events.Scrs[1].Hash)
+		require.Equal(t, "rewardHash2", events.Rewards[0].Hash)
+		require.Equal(t, "rewardHash1", events.Rewards[1].Hash)
+		require.Equal(t, "receiptHash2", events.Receipts[0].Hash)
+		require.Equal(t, "receiptHash1", events.Receipts[1].Hash)
+		require.Equal(t, "invalidHash2", events.InvalidTxs[0].Hash)
+		require.Equal(t, "invalidHash1", events.InvalidTxs[1].Hash)
+	})
+
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -121,6 +210,33 @@ func TestProcessBlockEvents(t *testing.T) {
 				ExecutionOrder: 1,
 			},
 		}
+		rewards := map[string]*data.NodeRewardTx{
+			"hash4": {
+				TransactionHandler: &rewardTx.RewardTx{
+					Round: 4,
+				},
+			},
+		}
+		receipts := map[string]*data.NodeReceipt{
+			"hash5": {
+				TransactionHandler: &receipt.Receipt{
+					TxHash: []byte("hash2"),
+				},
+			},
+		}
+		invalidTxs := map[string]*data.NodeTransaction{
+			"hash6": {
+				TransactionHandler: &transaction.Transaction{
+					Nonce: 6,
+				},
+			},
+		}
+		alteredAccounts := map[string]*alteredAccount.AlteredAccount{
+			"erd1addr": {
+				Address: "erd1addr",
+				Balance: "10",
+			},
+		}
 		addr := []byte("addr1")
 
 		blockBody := &block.Body{
@@ -134,12 +250,16 @@ func TestProcessBlockEvents(t *testing.T) {
 		}
 		blockHash := []byte("blockHash")
 		blockEvents := data.ArgsSaveBlockData{
-			HeaderHash: blockHash,
-			Body:       blockBody,
-			Header:     blockHeader,
+			HeaderHash:      blockHash,
+			Body:            blockBody,
+			Header:          blockHeader,
+			AlteredAccounts: alteredAccounts,
 			TransactionsPool: &data.TransactionsPool{
-				Txs:  txs,
-				Scrs: scrs,
+				Txs:      txs,
+				Scrs:     scrs,
+				Rewards:  rewards,
+				Receipts: receipts,
+				Invalid:  invalidTxs,
 				Logs: []*data.LogData{
 					{
 						LogHandler: &transaction.Log{
@@ -155,44 +275,59 @@ func TestProcessBlockEvents(t *testing.T) {
 			},
 		}
 
-		expTxs := map[string]*transaction.Transaction{
-			"hash2": {
-				Nonce: 2,
+		expEvents := &data.InterceptorBlockData{
+			Hash:   hex.EncodeToString(blockHash),
+			Body:   blockBody,
+			Header: blockHeader,
+			Txs: []*data.NotifierTransaction{
+				{
+					Hash: "hash2",
+					Transaction: &transaction.Transaction{
+						Nonce: 2,
+					},
+					ExecutionOrder: 1,
+				},
 			},
-		}
-		expTxsWithOrder := map[string]*data.NotifierTransaction{
-			"hash2": {
-				Transaction: &transaction.Transaction{
-					Nonce: 2,
+			Scrs: []*data.NotifierSmartContractResult{
+				{
+					Hash: "hash3",
+					SmartContractResult: &smartContractResult.SmartContractResult{
+						Nonce: 3,
+					},
+					ExecutionOrder: 1,
 				},
-				ExecutionOrder: 1,
 			},
-		}
-		expScrs := map[string]*smartContractResult.SmartContractResult{
-			"hash3": {
-				Nonce: 3,
+			Rewards: []*data.NotifierRewardTx{
+				{
+					Hash: "hash4",
+					RewardTx: &rewardTx.RewardTx{
+						Round: 4,
+					},
+				},
 			},
-		}
-		expScrsWithOrder := map[string]*data.NotifierSmartContractResult{
-			"hash3": {
-				SmartContractResult: &smartContractResult.SmartContractResult{
-					Nonce: 3,
+			Receipts: []*data.NotifierReceipt{
+				{
+					Hash: "hash5",
+					Receipt: &receipt.Receipt{
+						TxHash: []byte("hash2"),
+					},
 				},
-				ExecutionOrder: 1,
 			},
-		}
-
-		expEvents := &data.InterceptorBlockData{
-			Hash:          hex.EncodeToString(blockHash),
-			Body:          blockBody,
-			Header:        blockHeader,
-			Txs:           expTxs,
-			TxsWithOrder:  expTxsWithOrder,
-			Scrs:          expScrs,
-			ScrsWithOrder: expScrsWithOrder,
+			InvalidTxs: []*data.NotifierTransaction{
+				{
+					Hash: "hash6",
+					Transaction: &transaction.Transaction{
+						Nonce: 6,
+					},
+				},
+			},
+			AlteredAccounts: alteredAccounts,
 			LogEvents: []data.Event{
 				{
-					Address: hex.EncodeToString(addr),
+					Address:        hex.EncodeToString(addr),
+					BlockHash:      hex.EncodeToString(blockHash),
+					ShardID:        1,
+					BlockTimeStamp: 1234,
 				},
 			},
 		}
@@ -259,3 +394,127 @@ func TestGetLogEventsFromTransactionsPool(t *testing.T) {
 	require.Equal(t, txHash1, receivedEvents[1].TxHash)
 	require.Equal(t, txHash2, receivedEvents[2].TxHash)
 }
+
+func TestSetEventsBlockContext(t *testing.T) {
+	t.Parallel()
+
+	header := &block.HeaderV2{
+		Header: &block.Header{
+			ShardID:   1,
+			Nonce:     10,
+			Round:     11,
+			TimeStamp: 1234,
+		},
+	}
+	pool := &data.TransactionsPool{
+		Txs: map[string]*data.NodeTransaction{
+			"txHash1": {
+				TransactionHandler: &transaction.Transaction{},
+				ExecutionOrder:     2,
+			},
+		},
+		Scrs: map[string]*data.NodeSmartContractResult{
+			"scrHash1": {
+				TransactionHandler: &smartContractResult.SmartContractResult{},
+				ExecutionOrder:     3,
+			},
+		},
+	}
+	events := []data.Event{
+		{Identifier: "ESDTTransfer", TxHash: "txHash1"},
+		{Identifier: "transferValueOnly", TxHash: "scrHash1"},
+		{Identifier: "writeLog", TxHash: "txHash1"},
+	}
+
+	process.SetEventsBlockContext(events, "blockHash", header, pool)
+
+	expectedIdentifiers := []string{"ESDTTransfer", "writeLog", "transferValueOnly"}
+	expectedExecutionOrders := []int{2, 2, 3}
+	for i, event := range events {
+		require.Equal(t, expectedIdentifiers[i], event.Identifier)
+		require.Equal(t, "blockHash", event.BlockHash)
+		require.Equal(t, uint32(1), event.ShardID)
+		require.Equal(t, uint64(10), event.BlockNonce)
+		require.Equal(t, uint64(11), event.BlockRound)
+		require.Equal(t, uint64(1234), event.BlockTimeStamp)
+		require.Equal(t, expectedExecutionOrders[i], event.ExecutionOrder)
+		require.Equal(t, i, event.EventIndex)
+	}
+}
+
+func TestSetEventsBlockContext_ShouldSortEventsByExecutionOrder(t *testing.T) {
+	t.Parallel()
+
+	header := &block.Header{Nonce: 10}
+	pool := &data.TransactionsPool{
+		Txs: map[string]*data.NodeTransaction{
+			"txHash1": {
+				TransactionHandler: &transaction.Transaction{},
+				ExecutionOrder:     5,
+			},
+			"txHash2": {
+				TransactionHandler: &transaction.Transaction{},
+				ExecutionOrder:     1,
+			},
+		},
+		Scrs: map[string]*data.NodeSmartContractResult{
+			"scrHash1": {
+				TransactionHandler: &smartContractResult.SmartContractResult{},
+				ExecutionOrder:     3,
+			},
+		},
+	}
+	events := []data.Event{
+		{Identifier: "event1", TxHash: "txHash1"},
+		{Identifier: "event2", TxHash: "txHash1"},
+		{Identifier: "event3", TxHash: "scrHash1"},
+		{Identifier: "event4", TxHash: "txHash2"},
+		{Identifier: "event5", TxHash: "scrHash1"},
+		{Identifier: "event6", TxHash: "txHash2"},
+	}
+
+	process.SetEventsBlockContext(events, "blockHash", header, pool)
+
+	expectedIdentifiers := []string{"event4", "event6", "event3", "event5", "event1", "event2"}
+	expectedExecutionOrders := []int{1, 1, 3, 3, 5, 5}
+	for i, event := range events {
+		require.Equal(t, expectedIdentifiers[i], event.Identifier)
+		require.Equal(t, expectedExecutionOrders[i], event.ExecutionOrder)
+		require.Equal(t, i, event.EventIndex)
+	}
+}
+
+func TestSetEventsBlockContext_EventsWithUnknownExecutionOrderShouldBeLast(t *testing.T) {
+	t.Parallel()
+
+	header := &block.Header{Nonce: 10}
+	pool := &data.TransactionsPool{
+		Txs: map[string]*data.NodeTransaction{
+			"txHash1": {
+				TransactionHandler: &transaction.Transaction{},
+				ExecutionOrder:     2,
+			},
+			"txHash2": {
+				TransactionHandler: &transaction.Transaction{},
+				ExecutionOrder:     0,
+			},
+		},
+	}
+	events := []data.Event{
+		{Identifier: "event1", TxHash: "unknownTxHash1"},
+		{Identifier: "event2", TxHash: "txHash1"},
+		{Identifier: "event3", TxHash: "unknownTxHash2"},
+		{Identifier: "event4", TxHash: "txHash2"},
+		{Identifier: "event5", TxHash: "unknownTxHash1"},
+	}
+
+	process.SetEventsBlockContext(events, "blockHash", header, pool)
+
+	expectedIdentifiers := []string{"event4", "event2", "event1", "event3", "event5"}
+	expectedExecutionOrders := []int{0, 2, 0, 0, 0}
+	for i, event := range events {
+		require.Equal(t, expectedIdentifiers[i], event.Identifier)
+		require.Equal(t, expectedExecutionOrders[i], event.ExecutionOrder)
+		require.Equal(t, i, event.EventIndex)
+	}
+}
@@ -1,10 +1,16 @@
 package process
 
-import "github.com/multiversx/mx-chain-notifier-go/data"
+import (
+	"context"
+	"time"
+
+	nodeData "github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
 
 // TryCheckProcessedWithRetry exports internal method for testing
 func (eh *eventsHandler) TryCheckProcessedWithRetry(prefix, blockHash string) bool {
-	return eh.tryCheckProcessedWithRetry(prefix, blockHash)
+	return eh.tryCheckProcessedWithRetry(context.Background(), prefix, blockHash)
 }
 
 // GetLogEventsFromTransactionsPool exports internal method for testing
@@ -12,3 +18,18 @@ func (ei *eventsInterceptor) GetLogEventsFromTransactionsPool(logs []*data.LogDa
 	scrHashes := make(map[string]string)
 	return ei.getLogEventsFromTransactionsPool(logs, scrHashes)
 }
+
+// SetEventsBlockContext exports internal function for testing
+func SetEventsBlockContext(events []data.Event, blockHash string, header nodeData.HeaderHandler, pool *data.TransactionsPool) {
+	setEventsBlockContext(events, blockHash, header, getExecutionOrders(pool))
+}
+
+// CreateTxBundles exports internal method for testing
+func (ei *eventsInterceptor) CreateTxBundles(pool *data.TransactionsPool, events []data.Event, shardID uint32) []*data.TxBundle {
+	return ei.createTxBundles(pool, events, shardID)
+}
+
+// CheckExpiredTxsAt exports internal method for testing, checking the deadlines at the provided time
+func (tst *txStatusTracker) CheckExpiredTxsAt(now time.Time) {
+	tst.checkExpiredTxsAt(now)
+}
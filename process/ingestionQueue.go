@@ -0,0 +1,156 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+)
+
+const defaultMaxQueuedBlocksPerShard = 100
+
+// ArgsIngestionQueue defines the arguments needed for an ingestion queue component
+type ArgsIngestionQueue struct {
+	Config               config.IngestionQueueConfig
+	StatusMetricsHandler common.StatusMetricsHandler
+}
+
+type queuedBlock struct {
+	queuedAt       time.Time
+	processHandler func()
+}
+
+type ingestionQueue struct {
+	maxQueuedBlocks uint32
+	metricsHandler  common.StatusMetricsHandler
+	pendingBlocks   sync.WaitGroup
+
+	mutQueues sync.Mutex
+	queues    map[uint32]chan queuedBlock
+	isClosed  bool
+}
+
+// NewIngestionQueue creates a component which keeps a bounded queue for each shard. The queued blocks
+// of a shard are processed one by one, in the order they were received. The queues are kept in memory
+// only, so the pushes are answered only after their block was processed
+func NewIngestionQueue(args ArgsIngestionQueue) (*ingestionQueue, error) {
+	if check.IfNil(args.StatusMetricsHandler) {
+		return nil, common.ErrNilStatusMetricsHandler
+	}
+
+	maxQueuedBlocks := args.Config.MaxQueuedBlocksPerShard
+	if maxQueuedBlocks == 0 {
+		maxQueuedBlocks = defaultMaxQueuedBlocksPerShard
+	}
+
+	return &ingestionQueue{
+		maxQueuedBlocks: maxQueuedBlocks,
+		metricsHandler:  args.StatusMetricsHandler,
+		queues:          make(map[uint32]chan queuedBlock),
+	}, nil
+}
+
+// Enqueue adds the process handler of a block to the queue of the provided shard, without waiting.
+// An error is returned if the queue is full or if the component is draining
+func (iq *ingestionQueue) Enqueue(shardID uint32, processHandler func()) error {
+	iq.mutQueues.Lock()
+	defer iq.mutQueues.Unlock()
+
+	if iq.isClosed {
+		return common.ErrNotifierDraining
+	}
+
+	queue := iq.getOrCreateQueue(shardID)
+
+	iq.pendingBlocks.Add(1)
+	select {
+	case queue <- queuedBlock{queuedAt: time.Now(), processHandler: processHandler}:
+		iq.metricsHandler.SetIngestionQueueDepth(shardID, len(queue))
+		return nil
+	default:
+		iq.pendingBlocks.Done()
+		iq.metricsHandler.AddStageError(common.IngestionQueueStage)
+		return fmt.Errorf("%w: %d blocks queued for shard %d", common.ErrIngestionQueueFull, len(queue), shardID)
+	}
+}
+
+// getOrCreateQueue returns the queue of the shard, starting its processing goroutine on the first call
+func (iq *ingestionQueue) getOrCreateQueue(shardID uint32) chan queuedBlock {
+	queue, ok := iq.queues[shardID]
+	if ok {
+		return queue
+	}
+
+	queue = make(chan queuedBlock, iq.maxQueuedBlocks)
+	iq.queues[shardID] = queue
+
+	go iq.processQueue(shardID, queue)
+
+	return queue
+}
+
+func (iq *ingestionQueue) processQueue(shardID uint32, queue chan queuedBlock) {
+	for block := range queue {
+		iq.metricsHandler.ObserveStage(common.IngestionQueueStage, time.Since(block.queuedAt))
+		iq.metricsHandler.SetIngestionQueueDepth(shardID, len(queue))
+
+		block.processHandler()
+		iq.pendingBlocks.Done()
+	}
+}
+
+// Drain stops accepting new blocks and waits for the queued ones to be processed. An error is returned
+// if the context is done before
+func (iq *ingestionQueue) Drain(ctx context.Context) error {
+	iq.mutQueues.Lock()
+	iq.isClosed = true
+	iq.mutQueues.Unlock()
+
+	processingDone := make(chan struct{})
+	go func() {
+		iq.pendingBlocks.Wait()
+		close(processingDone)
+	}()
+
+	select {
+	case <-processingDone:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("%w: %d queued blocks not processed: %s", common.ErrUndeliveredData, iq.numQueuedBlocks(), ctx.Err().Error())
+	}
+}
+
+func (iq *ingestionQueue) numQueuedBlocks() int {
+	iq.mutQueues.Lock()
+	defer iq.mutQueues.Unlock()
+
+	numQueuedBlocks := 0
+	for _, queue := range iq.queues {
+		numQueuedBlocks += len(queue)
+	}
+
+	return numQueuedBlocks
+}
+
+// Close stops the processing goroutines once the queued blocks are processed
+func (iq *ingestionQueue) Close() error {
+	iq.mutQueues.Lock()
+	defer iq.mutQueues.Unlock()
+
+	iq.isClosed = true
+	for shardID, queue := range iq.queues {
+		close(queue)
+		delete(iq.queues, shardID)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (iq *ingestionQueue) IsInterfaceNil() bool {
+	return iq == nil
+}
@@ -0,0 +1,162 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockIngestionQueueArgs() process.ArgsIngestionQueue {
+	return process.ArgsIngestionQueue{
+		Config: config.IngestionQueueConfig{
+			Enabled:                 true,
+			MaxQueuedBlocksPerShard: 2,
+		},
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+	}
+}
+
+func TestNewIngestionQueue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil status metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockIngestionQueueArgs()
+		args.StatusMetricsHandler = nil
+
+		iq, err := process.NewIngestionQueue(args)
+		require.True(t, check.IfNil(iq))
+		require.Equal(t, common.ErrNilStatusMetricsHandler, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		iq, err := process.NewIngestionQueue(createMockIngestionQueueArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(iq))
+	})
+}
+
+func TestIngestionQueue_Enqueue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should process the blocks of a shard in order", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockIngestionQueueArgs()
+		args.Config.MaxQueuedBlocksPerShard = 100
+		iq, _ := process.NewIngestionQueue(args)
+		defer iq.Close()
+
+		mutProcessed := sync.Mutex{}
+		processed := make(map[uint32][]int)
+		for i := 0; i < 50; i++ {
+			for shardID := uint32(0); shardID < 3; shardID++ {
+				index, shard := i, shardID
+				err := iq.Enqueue(shardID, func() {
+					mutProcessed.Lock()
+					processed[shard] = append(processed[shard], index)
+					mutProcessed.Unlock()
+				})
+				require.Nil(t, err)
+			}
+		}
+
+		err := iq.Drain(context.Background())
+		require.Nil(t, err)
+
+		for shardID := uint32(0); shardID < 3; shardID++ {
+			require.Len(t, processed[shardID], 50)
+			for i, index := range processed[shardID] {
+				assert.Equal(t, i, index)
+			}
+		}
+	})
+
+	t.Run("full queue should error", func(t *testing.T) {
+		t.Parallel()
+
+		numRejected := 0
+		args := createMockIngestionQueueArgs()
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddStageErrorCalled: func(stage string) {
+				assert.Equal(t, common.IngestionQueueStage, stage)
+				numRejected++
+			},
+		}
+		iq, _ := process.NewIngestionQueue(args)
+		defer iq.Close()
+
+		processingStarted := make(chan struct{})
+		releaseProcessing := make(chan struct{})
+		err := iq.Enqueue(0, func() {
+			close(processingStarted)
+			<-releaseProcessing
+		})
+		require.Nil(t, err)
+		<-processingStarted
+
+		require.Nil(t, iq.Enqueue(0, func() {}))
+		require.Nil(t, iq.Enqueue(0, func() {}))
+
+		err = iq.Enqueue(0, func() {})
+		require.True(t, errors.Is(err, common.ErrIngestionQueueFull))
+		require.Equal(t, 1, numRejected)
+
+		// the queues of the other shards are not affected
+		require.Nil(t, iq.Enqueue(1, func() {}))
+
+		close(releaseProcessing)
+	})
+}
+
+func TestIngestionQueue_Drain(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should reject new blocks", func(t *testing.T) {
+		t.Parallel()
+
+		iq, _ := process.NewIngestionQueue(createMockIngestionQueueArgs())
+		defer iq.Close()
+
+		err := iq.Drain(context.Background())
+		require.Nil(t, err)
+
+		err = iq.Enqueue(0, func() {
+			assert.Fail(t, "should not have been called")
+		})
+		require.Equal(t, common.ErrNotifierDraining, err)
+	})
+
+	t.Run("blocks not processed before deadline should error", func(t *testing.T) {
+		t.Parallel()
+
+		iq, _ := process.NewIngestionQueue(createMockIngestionQueueArgs())
+		defer iq.Close()
+
+		releaseProcessing := make(chan struct{})
+		defer close(releaseProcessing)
+		err := iq.Enqueue(0, func() {
+			<-releaseProcessing
+		})
+		require.Nil(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err = iq.Drain(ctx)
+		require.True(t, errors.Is(err, common.ErrUndeliveredData))
+	})
+}
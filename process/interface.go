@@ -10,6 +10,7 @@ import (
 // It makes sure that a duplicated entry is not processed multiple times.
 type LockService interface {
 	IsEventProcessed(ctx context.Context, blockHash string) (bool, error)
+	AddToSet(ctx context.Context, key string, member string) (int64, error)
 	HasConnection(ctx context.Context) bool
 	IsInterfaceNil() bool
 }
@@ -23,6 +24,15 @@ type Publisher interface {
 	BroadcastTxs(event data.BlockTxs)
 	BroadcastBlockEventsWithOrder(event data.BlockEventsWithOrder)
 	BroadcastScrs(event data.BlockScrs)
+	BroadcastRewards(event data.BlockRewards)
+	BroadcastReceipts(event data.BlockReceipts)
+	BroadcastInvalidTxs(event data.BlockInvalidTxs)
+	BroadcastAlteredAccounts(event data.BlockAlteredAccounts)
+	BroadcastBlockHeader(event data.BlockHeader)
+	BroadcastEpochStart(event data.EpochStart)
+	BroadcastTxBundles(event data.BlockTxBundles)
+	BroadcastTxStatus(event data.TxStatus)
+	BroadcastSourceStale(event data.SourceStaleEvent)
 	IsInterfaceNil() bool
 }
 
@@ -33,7 +43,16 @@ type EventsHandler interface {
 	HandleFinalizedEvents(finalizedBlock data.FinalizedBlock)
 	HandleBlockTxs(blockTxs data.BlockTxs)
 	HandleBlockScrs(blockScrs data.BlockScrs)
+	HandleBlockRewards(blockRewards data.BlockRewards)
+	HandleBlockReceipts(blockReceipts data.BlockReceipts)
+	HandleBlockInvalidTxs(blockInvalidTxs data.BlockInvalidTxs)
+	HandleAlteredAccounts(blockAlteredAccounts data.BlockAlteredAccounts)
+	HandleBlockHeader(blockHeader data.BlockHeader)
+	HandleEpochStart(epochStart data.EpochStart)
+	HandleTxBundles(blockTxBundles data.BlockTxBundles)
+	HandleTxStatus(txStatus data.TxStatus)
 	HandleBlockEventsWithOrder(blockTxs data.BlockEventsWithOrder)
+	HandleSourceStale(event data.SourceStaleEvent)
 	IsInterfaceNil() bool
 }
 
@@ -42,3 +61,43 @@ type EventsInterceptor interface {
 	ProcessBlockEvents(eventsData *data.ArgsSaveBlockData) (*data.InterceptorBlockData, error)
 	IsInterfaceNil() bool
 }
+
+// ObserversQuorum defines the behaviour of a component which decides when a block pushed
+// by observers can be published
+type ObserversQuorum interface {
+	ProcessBlock(vote data.ObserverBlockVote, publishHandler func())
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// IngestionQueue defines the behaviour of a component which queues the blocks pushed by observers
+// and processes them in order, per shard
+type IngestionQueue interface {
+	Enqueue(shardID uint32, processHandler func()) error
+	Drain(ctx context.Context) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// TxStatusTracker defines the behaviour of a component which follows the transactions across blocks
+// and shards and handles their final status
+type TxStatusTracker interface {
+	ProcessTxBundles(blockTxBundles data.BlockTxBundles)
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// TxStatusStorer defines the behaviour of a component which stores the transactions followed by the tx status tracker
+type TxStatusStorer interface {
+	Track(ctx context.Context, entry data.TrackedTx, deadlineMs int64) error
+	ClaimFinalStatus(ctx context.Context, txHash string) (bool, error)
+	Remove(ctx context.Context, txHash string) (*data.TrackedTx, error)
+	PopExpired(ctx context.Context, nowMs int64, maxNum int) ([]*data.TrackedTx, error)
+	IsInterfaceNil() bool
+}
+
+// TxStatusHandler defines the behaviour of a component which handles the final status of the transactions
+type TxStatusHandler interface {
+	HandleTxStatus(txStatus data.TxStatus)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,525 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+const (
+	quorumVotesKeyPrefix     = "quorumVotes_"
+	quorumDigestsKeyPrefix   = "quorumDigests_"
+	quorumPublishedKeyPrefix = "quorumPublished_"
+
+	quorumMetricPrefix          = "Quorum"
+	quorumReachedOperation      = "reached"
+	quorumTimeoutsOperation     = "timeouts"
+	quorumDisagreementOperation = "disagreements"
+
+	defaultQuorumTimeout  = 3 * time.Second
+	maxQuorumClaimRetries = 3
+)
+
+// ArgsObserversQuorum defines the arguments needed for an observers quorum component
+type ArgsObserversQuorum struct {
+	Config               config.QuorumConfig
+	Locker               LockService
+	IngestionQueue       IngestionQueue
+	StatusMetricsHandler common.StatusMetricsHandler
+}
+
+type quorumCandidate struct {
+	votes          int64
+	publishHandler func()
+}
+
+// pendingBlock is a block which is either waiting for quorum, or decided and waiting for the blocks of the
+// shard with lower nonces to be decided. The decision is the publishing of the block, once its turn comes
+type pendingBlock struct {
+	index      uint64
+	hash       string
+	shardID    uint32
+	nonce      uint64
+	timer      *time.Timer
+	candidates map[string]*quorumCandidate
+	decision   func() error
+}
+
+type observersQuorum struct {
+	minObservers   int64
+	timeout        time.Duration
+	locker         LockService
+	ingestionQueue IngestionQueue
+	metricsHandler common.StatusMetricsHandler
+
+	mutPending          sync.Mutex
+	pending             map[string]*pendingBlock
+	numPending          uint64
+	isClosed            bool
+	mutShardsPublish    map[uint32]*sync.Mutex
+	lastPublishedNonces map[uint32]uint64
+}
+
+// NewObserversQuorum creates a component which publishes a block only after enough distinct observers
+// pushed the same block data. The votes are shared between notifier instances through the lock service
+func NewObserversQuorum(args ArgsObserversQuorum) (*observersQuorum, error) {
+	err := checkQuorumArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := defaultQuorumTimeout
+	if args.Config.TimeoutInMs > 0 {
+		timeout = time.Millisecond * time.Duration(args.Config.TimeoutInMs)
+	}
+
+	return &observersQuorum{
+		minObservers:        int64(args.Config.MinObservers),
+		timeout:             timeout,
+		locker:              args.Locker,
+		ingestionQueue:      args.IngestionQueue,
+		metricsHandler:      args.StatusMetricsHandler,
+		pending:             make(map[string]*pendingBlock),
+		mutShardsPublish:    make(map[uint32]*sync.Mutex),
+		lastPublishedNonces: make(map[uint32]uint64),
+	}, nil
+}
+
+func checkQuorumArgs(args ArgsObserversQuorum) error {
+	if args.Config.MinObservers == 0 {
+		return fmt.Errorf("%w for quorum min observers", ErrInvalidValue)
+	}
+	if check.IfNil(args.Locker) {
+		return ErrNilLockService
+	}
+	if check.IfNil(args.IngestionQueue) {
+		return ErrNilIngestionQueue
+	}
+	if check.IfNil(args.StatusMetricsHandler) {
+		return common.ErrNilStatusMetricsHandler
+	}
+
+	return nil
+}
+
+// ProcessBlock records the observer vote and calls the publish handler once the quorum is reached.
+// If the quorum is not reached in time, the most voted block data is published with a warning, through
+// the ingestion queue of the shard, so that it keeps its order relative to the other blocks of the shard.
+// A decided block is published only after the pending blocks of the shard with lower nonces are decided
+func (oq *observersQuorum) ProcessBlock(vote data.ObserverBlockVote, publishHandler func()) {
+	votes := oq.addVote(vote)
+	if votes >= oq.minObservers {
+		oq.decide(vote, func() error {
+			return oq.publishReached(vote, votes, publishHandler)
+		})
+		return
+	}
+
+	isPending := oq.addPending(vote, votes, publishHandler)
+	if isPending {
+		return
+	}
+
+	// the component is closing, there is no time left to wait for other observers
+	if oq.claimPublishing(vote.Hash) {
+		log.Warn("quorum closed, publishing the block without waiting for other observers",
+			"block hash", vote.Hash,
+			"shard", vote.ShardID,
+			"votes", votes,
+		)
+		publishHandler()
+	}
+}
+
+func (oq *observersQuorum) addVote(vote data.ObserverBlockVote) int64 {
+	ctx := context.Background()
+
+	votes, err := oq.locker.AddToSet(ctx, quorumVotesKeyPrefix+vote.Hash+"_"+vote.Digest, vote.ObserverID)
+	if err != nil {
+		log.Error("failed to record quorum vote", "block hash", vote.Hash, "observer", vote.ObserverID, "error", err.Error())
+		return 0
+	}
+
+	numDigests, err := oq.locker.AddToSet(ctx, quorumDigestsKeyPrefix+vote.Hash, vote.Digest)
+	if err != nil {
+		log.Error("failed to record quorum digest", "block hash", vote.Hash, "observer", vote.ObserverID, "error", err.Error())
+		return votes
+	}
+
+	// the first vote for a digest which differs from an already known one
+	if numDigests > 1 && votes == 1 {
+		log.Warn("observers disagree on block data",
+			"block hash", vote.Hash,
+			"shard", vote.ShardID,
+			"observer", vote.ObserverID,
+			"digest", vote.Digest,
+			"num digests", numDigests,
+		)
+		oq.metricsHandler.AddCounter(getQuorumOpID(quorumDisagreementOperation), 1)
+	}
+
+	return votes
+}
+
+// addPending records the block as waiting for quorum. It returns false if the component is closed
+func (oq *observersQuorum) addPending(vote data.ObserverBlockVote, votes int64, publishHandler func()) bool {
+	oq.mutPending.Lock()
+	defer oq.mutPending.Unlock()
+
+	if oq.isClosed {
+		return false
+	}
+
+	block, ok := oq.pending[vote.Hash]
+	if !ok {
+		block = oq.createPendingBlock(vote)
+		block.timer = time.AfterFunc(oq.timeout, func() {
+			oq.enqueueTimeout(vote.Hash, vote.ShardID)
+		})
+	}
+
+	candidate, ok := block.candidates[vote.Digest]
+	if !ok {
+		candidate = &quorumCandidate{
+			publishHandler: publishHandler,
+		}
+		block.candidates[vote.Digest] = candidate
+	}
+	if votes > candidate.votes {
+		candidate.votes = votes
+	}
+
+	return true
+}
+
+func (oq *observersQuorum) createPendingBlock(vote data.ObserverBlockVote) *pendingBlock {
+	oq.numPending++
+	block := &pendingBlock{
+		index:      oq.numPending,
+		hash:       vote.Hash,
+		shardID:    vote.ShardID,
+		nonce:      vote.Nonce,
+		candidates: make(map[string]*quorumCandidate),
+	}
+	oq.pending[vote.Hash] = block
+
+	return block
+}
+
+// decide records the decision for the block, then publishes the decided blocks of the shard which are no
+// longer held back by a pending block with a lower nonce. After close, the decision is applied right away
+func (oq *observersQuorum) decide(vote data.ObserverBlockVote, decision func() error) {
+	isDecided := oq.setDecision(vote, decision)
+	if !isDecided {
+		_ = decision()
+		return
+	}
+
+	oq.publishDecided(vote.ShardID)
+}
+
+func (oq *observersQuorum) setDecision(vote data.ObserverBlockVote, decision func() error) bool {
+	oq.mutPending.Lock()
+	defer oq.mutPending.Unlock()
+
+	if oq.isClosed {
+		return false
+	}
+
+	block, ok := oq.pending[vote.Hash]
+	if !ok {
+		block = oq.createPendingBlock(vote)
+	}
+	if block.timer != nil {
+		block.timer.Stop()
+	}
+	if block.decision == nil {
+		block.decision = decision
+	}
+
+	return true
+}
+
+// publishDecided publishes, in nonce order, the decided blocks of the shard up to the first one still waiting
+// for quorum. The blocks of a shard are published one at a time, so that they are not reordered
+func (oq *observersQuorum) publishDecided(shardID uint32) {
+	mutPublish := oq.getShardPublishMutex(shardID)
+	mutPublish.Lock()
+	defer mutPublish.Unlock()
+
+	for _, block := range oq.popDecided(shardID) {
+		_ = block.decision()
+	}
+}
+
+func (oq *observersQuorum) getShardPublishMutex(shardID uint32) *sync.Mutex {
+	oq.mutPending.Lock()
+	defer oq.mutPending.Unlock()
+
+	mutPublish, ok := oq.mutShardsPublish[shardID]
+	if !ok {
+		mutPublish = &sync.Mutex{}
+		oq.mutShardsPublish[shardID] = mutPublish
+	}
+
+	return mutPublish
+}
+
+func (oq *observersQuorum) popDecided(shardID uint32) []*pendingBlock {
+	oq.mutPending.Lock()
+	defer oq.mutPending.Unlock()
+
+	shardBlocks := make([]*pendingBlock, 0)
+	for _, block := range oq.pending {
+		if block.shardID == shardID {
+			shardBlocks = append(shardBlocks, block)
+		}
+	}
+	sortPendingBlocks(shardBlocks)
+
+	lastPublishedNonce, hasPublished := oq.lastPublishedNonces[shardID]
+	decided := make([]*pendingBlock, 0, len(shardBlocks))
+	for i, block := range shardBlocks {
+		// a block for a nonce already published, such as a late fork, does not hold back the next nonces
+		isStale := hasPublished && block.nonce <= lastPublishedNonce
+		if block.decision == nil && !isStale {
+			logHeldBlocks(shardID, block.nonce, shardBlocks[i+1:])
+			break
+		}
+		if block.decision == nil {
+			continue
+		}
+
+		delete(oq.pending, block.hash)
+		decided = append(decided, block)
+		if !hasPublished || block.nonce > lastPublishedNonce {
+			lastPublishedNonce = block.nonce
+			hasPublished = true
+		}
+	}
+	if hasPublished {
+		oq.lastPublishedNonces[shardID] = lastPublishedNonce
+	}
+
+	return decided
+}
+
+func logHeldBlocks(shardID uint32, waitingNonce uint64, blocks []*pendingBlock) {
+	numHeld := 0
+	for _, block := range blocks {
+		if block.decision != nil {
+			numHeld++
+		}
+	}
+	if numHeld == 0 {
+		return
+	}
+
+	log.Debug("decided blocks held until the blocks with lower nonces are decided",
+		"shard", shardID,
+		"num held", numHeld,
+		"waiting nonce", waitingNonce,
+	)
+}
+
+// sortPendingBlocks sorts the blocks by shard and nonce, the blocks with the same nonce being kept in the order
+// they were received
+func sortPendingBlocks(blocks []*pendingBlock) {
+	sort.Slice(blocks, func(i, j int) bool {
+		if blocks[i].shardID != blocks[j].shardID {
+			return blocks[i].shardID < blocks[j].shardID
+		}
+		if blocks[i].nonce != blocks[j].nonce {
+			return blocks[i].nonce < blocks[j].nonce
+		}
+
+		return blocks[i].index < blocks[j].index
+	})
+}
+
+// enqueueTimeout runs on the timer goroutine and sends the timeout publish to the ingestion queue of the
+// shard. The block stays pending until the queue processes it, so that a late vote can still reach the
+// quorum. If the queue does not accept it, the timer is restarted
+func (oq *observersQuorum) enqueueTimeout(hash string, shardID uint32) {
+	if !oq.isWaitingForQuorum(hash) {
+		return
+	}
+
+	err := oq.ingestionQueue.Enqueue(shardID, func() {
+		oq.onTimeout(hash)
+	})
+	if err == nil {
+		return
+	}
+
+	log.Warn("failed to enqueue quorum timeout, will retry", "block hash", hash, "shard", shardID, "error", err.Error())
+
+	oq.mutPending.Lock()
+	defer oq.mutPending.Unlock()
+
+	block, ok := oq.pending[hash]
+	if ok && block.decision == nil && !oq.isClosed {
+		block.timer.Reset(oq.timeout)
+	}
+}
+
+func (oq *observersQuorum) isWaitingForQuorum(hash string) bool {
+	oq.mutPending.Lock()
+	defer oq.mutPending.Unlock()
+
+	block, ok := oq.pending[hash]
+
+	return ok && block.decision == nil
+}
+
+// onTimeout decides to publish the most voted block data, unless the block was decided in the meantime
+func (oq *observersQuorum) onTimeout(hash string) {
+	oq.mutPending.Lock()
+	block, ok := oq.pending[hash]
+	isWaitingForQuorum := ok && block.decision == nil
+	if isWaitingForQuorum {
+		block.decision = func() error {
+			return oq.publishMostVoted(hash, block, "quorum not reached in time, publishing the most voted block data")
+		}
+	}
+	oq.mutPending.Unlock()
+
+	if !isWaitingForQuorum {
+		return
+	}
+
+	oq.publishDecided(block.shardID)
+}
+
+// publishReached publishes the block data which reached the quorum, if no other instance published the block
+func (oq *observersQuorum) publishReached(vote data.ObserverBlockVote, votes int64, publishHandler func()) error {
+	claimed, err := oq.tryClaimPublishing(vote.Hash)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	log.Debug("quorum reached", "block hash", vote.Hash, "shard", vote.ShardID, "nonce", vote.Nonce, "votes", votes)
+	oq.metricsHandler.AddCounter(getQuorumOpID(quorumReachedOperation), 1)
+	publishHandler()
+
+	return nil
+}
+
+// publishMostVoted publishes the block data with the most votes, if no other instance published the block.
+// An error is returned if the publishing could not be claimed
+func (oq *observersQuorum) publishMostVoted(hash string, block *pendingBlock, message string) error {
+	var best *quorumCandidate
+	for _, candidate := range block.candidates {
+		if best == nil || candidate.votes > best.votes {
+			best = candidate
+		}
+	}
+
+	claimed, err := oq.tryClaimPublishing(hash)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return nil
+	}
+
+	log.Warn(message,
+		"block hash", hash,
+		"shard", block.shardID,
+		"votes", best.votes,
+		"min observers", oq.minObservers,
+		"num digests", len(block.candidates),
+	)
+	oq.metricsHandler.AddCounter(getQuorumOpID(quorumTimeoutsOperation), 1)
+	best.publishHandler()
+
+	return nil
+}
+
+// claimPublishing returns true only for the first caller, across all notifier instances
+func (oq *observersQuorum) claimPublishing(hash string) bool {
+	claimed, err := oq.tryClaimPublishing(hash)
+
+	return err == nil && claimed
+}
+
+func (oq *observersQuorum) tryClaimPublishing(hash string) (bool, error) {
+	var err error
+	for i := 0; i < maxQuorumClaimRetries; i++ {
+		var claimed bool
+		claimed, err = oq.locker.IsEventProcessed(context.Background(), quorumPublishedKeyPrefix+hash)
+		if err == nil {
+			return claimed, nil
+		}
+
+		time.Sleep(setRetryDuration)
+	}
+
+	log.Error("failed to claim block publishing", "block hash", hash, "error", err.Error())
+
+	return false, err
+}
+
+func getQuorumOpID(operation string) string {
+	return fmt.Sprintf("%s-%s", quorumMetricPrefix, operation)
+}
+
+// Close publishes the blocks still waiting for quorum with their most voted data, together with the decided
+// blocks held back by them, in nonce order for each shard. An error is returned if some of them could not be published
+func (oq *observersQuorum) Close() error {
+	oq.mutPending.Lock()
+	oq.isClosed = true
+	blocks := make([]*pendingBlock, 0, len(oq.pending))
+	for hash, block := range oq.pending {
+		if block.timer != nil {
+			block.timer.Stop()
+		}
+		blocks = append(blocks, block)
+		delete(oq.pending, hash)
+	}
+	oq.mutPending.Unlock()
+
+	sortPendingBlocks(blocks)
+
+	numNotPublished := 0
+	for _, block := range blocks {
+		err := oq.publishOnClose(block)
+		if err != nil {
+			numNotPublished++
+		}
+	}
+
+	if numNotPublished > 0 {
+		return fmt.Errorf("%w: %d blocks waiting for quorum not published", common.ErrUndeliveredData, numNotPublished)
+	}
+
+	return nil
+}
+
+// publishOnClose waits for the blocks of the shard which are being published, so that the order is kept
+func (oq *observersQuorum) publishOnClose(block *pendingBlock) error {
+	mutPublish := oq.getShardPublishMutex(block.shardID)
+	mutPublish.Lock()
+	defer mutPublish.Unlock()
+
+	if block.decision != nil {
+		return block.decision()
+	}
+
+	return oq.publishMostVoted(block.hash, block, "closing before the quorum was reached, publishing the most voted block data")
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (oq *observersQuorum) IsInterfaceNil() bool {
+	return oq == nil
+}
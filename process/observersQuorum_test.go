@@ -0,0 +1,598 @@
+package process_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/multiversx/mx-chain-notifier-go/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockObserversQuorumArgs(t *testing.T) process.ArgsObserversQuorum {
+	locker, err := redis.NewRedlockWrapper(redis.ArgsRedlockWrapper{
+		Client:       mocks.NewRedisClientMock(),
+		TTLInMinutes: 30,
+	})
+	require.Nil(t, err)
+
+	return process.ArgsObserversQuorum{
+		Config: config.QuorumConfig{
+			Enabled:      true,
+			MinObservers: 2,
+			TimeoutInMs:  50,
+		},
+		Locker: locker,
+		IngestionQueue: &mocks.IngestionQueueStub{
+			EnqueueCalled: func(_ uint32, processHandler func()) error {
+				processHandler()
+				return nil
+			},
+		},
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+	}
+}
+
+type countersMetrics struct {
+	mocks.StatusMetricsStub
+	mut      sync.Mutex
+	counters map[string]uint64
+}
+
+func newCountersMetrics() *countersMetrics {
+	cm := &countersMetrics{
+		counters: make(map[string]uint64),
+	}
+	cm.AddCounterCalled = func(operation string, value uint64) {
+		cm.mut.Lock()
+		cm.counters[operation] += value
+		cm.mut.Unlock()
+	}
+
+	return cm
+}
+
+func (cm *countersMetrics) get(operation string) uint64 {
+	cm.mut.Lock()
+	defer cm.mut.Unlock()
+
+	return cm.counters[operation]
+}
+
+func createVote(observerID string, digest string) data.ObserverBlockVote {
+	return data.ObserverBlockVote{
+		ObserverID: observerID,
+		ShardID:    1,
+		Hash:       "hash1",
+		Digest:     digest,
+	}
+}
+
+func TestNewObserversQuorum(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero min observers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		args.Config.MinObservers = 0
+
+		oq, err := process.NewObserversQuorum(args)
+		require.True(t, check.IfNil(oq))
+		require.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+
+	t.Run("nil locker", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		args.Locker = nil
+
+		oq, err := process.NewObserversQuorum(args)
+		require.True(t, check.IfNil(oq))
+		require.Equal(t, process.ErrNilLockService, err)
+	})
+
+	t.Run("nil ingestion queue", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		args.IngestionQueue = nil
+
+		oq, err := process.NewObserversQuorum(args)
+		require.True(t, check.IfNil(oq))
+		require.Equal(t, process.ErrNilIngestionQueue, err)
+	})
+
+	t.Run("nil status metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		args.StatusMetricsHandler = nil
+
+		oq, err := process.NewObserversQuorum(args)
+		require.True(t, check.IfNil(oq))
+		require.Equal(t, common.ErrNilStatusMetricsHandler, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		oq, err := process.NewObserversQuorum(createMockObserversQuorumArgs(t))
+		require.Nil(t, err)
+		require.False(t, check.IfNil(oq))
+	})
+}
+
+func TestObserversQuorum_ProcessBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("quorum reached should publish once", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		args.Config.TimeoutInMs = 10000
+		metrics := newCountersMetrics()
+		args.StatusMetricsHandler = metrics
+		oq, _ := process.NewObserversQuorum(args)
+		defer func() {
+			_ = oq.Close()
+		}()
+
+		numPublished := uint32(0)
+		publish := func() {
+			atomic.AddUint32(&numPublished, 1)
+		}
+
+		oq.ProcessBlock(createVote("observer0", "digest1"), publish)
+		require.Equal(t, uint32(0), atomic.LoadUint32(&numPublished))
+
+		oq.ProcessBlock(createVote("observer1", "digest1"), publish)
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numPublished))
+
+		oq.ProcessBlock(createVote("observer2", "digest1"), publish)
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numPublished))
+		require.Equal(t, uint64(1), metrics.get("Quorum-reached"))
+	})
+
+	t.Run("same observer should not reach quorum, should publish on timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		metrics := newCountersMetrics()
+		args.StatusMetricsHandler = metrics
+		oq, _ := process.NewObserversQuorum(args)
+
+		numPublished := uint32(0)
+		publish := func() {
+			atomic.AddUint32(&numPublished, 1)
+		}
+
+		oq.ProcessBlock(createVote("observer0", "digest1"), publish)
+		oq.ProcessBlock(createVote("observer0", "digest1"), publish)
+		require.Equal(t, uint32(0), atomic.LoadUint32(&numPublished))
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadUint32(&numPublished) == 1
+		}, time.Second, 5*time.Millisecond)
+		require.Equal(t, uint64(1), metrics.get("Quorum-timeouts"))
+		require.Equal(t, uint64(0), metrics.get("Quorum-reached"))
+	})
+
+	t.Run("disagreement should be recorded and most voted data published on timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		args.Config.MinObservers = 3
+		metrics := newCountersMetrics()
+		args.StatusMetricsHandler = metrics
+		oq, _ := process.NewObserversQuorum(args)
+
+		publishedDigest := make(chan string, 2)
+		oq.ProcessBlock(createVote("observer0", "digest1"), func() {
+			publishedDigest <- "digest1"
+		})
+		oq.ProcessBlock(createVote("observer1", "digest2"), func() {
+			publishedDigest <- "digest2"
+		})
+		oq.ProcessBlock(createVote("observer2", "digest2"), func() {
+			publishedDigest <- "digest2"
+		})
+		require.Equal(t, uint64(1), metrics.get("Quorum-disagreements"))
+
+		select {
+		case digest := <-publishedDigest:
+			assert.Equal(t, "digest2", digest)
+		case <-time.After(time.Second):
+			require.Fail(t, "block was not published on timeout")
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		require.Equal(t, 0, len(publishedDigest))
+	})
+
+	t.Run("multiple instances should publish only once", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		firstInstance, _ := process.NewObserversQuorum(args)
+		secondInstance, _ := process.NewObserversQuorum(args)
+
+		numPublished := uint32(0)
+		publish := func() {
+			atomic.AddUint32(&numPublished, 1)
+		}
+
+		firstInstance.ProcessBlock(createVote("observer0", "digest1"), publish)
+		secondInstance.ProcessBlock(createVote("observer1", "digest1"), publish)
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numPublished))
+
+		// the pending block of the first instance times out, but it was already published
+		time.Sleep(200 * time.Millisecond)
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numPublished))
+	})
+
+	t.Run("timeout should publish through the ingestion queue of the shard", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		queued := make(chan func(), 1)
+		enqueuedShard := uint32(0)
+		args.IngestionQueue = &mocks.IngestionQueueStub{
+			EnqueueCalled: func(shardID uint32, processHandler func()) error {
+				atomic.StoreUint32(&enqueuedShard, shardID)
+				queued <- processHandler
+				return nil
+			},
+		}
+		oq, _ := process.NewObserversQuorum(args)
+
+		numPublished := uint32(0)
+		oq.ProcessBlock(createVote("observer0", "digest1"), func() {
+			atomic.AddUint32(&numPublished, 1)
+		})
+
+		var processHandler func()
+		select {
+		case processHandler = <-queued:
+		case <-time.After(time.Second):
+			require.Fail(t, "timeout publish was not enqueued")
+		}
+		require.Equal(t, uint32(1), atomic.LoadUint32(&enqueuedShard))
+		require.Equal(t, uint32(0), atomic.LoadUint32(&numPublished))
+
+		processHandler()
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numPublished))
+	})
+
+	t.Run("quorum reached while the timeout is queued should publish once", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		queued := make(chan func(), 1)
+		args.IngestionQueue = &mocks.IngestionQueueStub{
+			EnqueueCalled: func(_ uint32, processHandler func()) error {
+				queued <- processHandler
+				return nil
+			},
+		}
+		oq, _ := process.NewObserversQuorum(args)
+
+		numPublished := uint32(0)
+		publish := func() {
+			atomic.AddUint32(&numPublished, 1)
+		}
+		oq.ProcessBlock(createVote("observer0", "digest1"), publish)
+
+		var processHandler func()
+		select {
+		case processHandler = <-queued:
+		case <-time.After(time.Second):
+			require.Fail(t, "timeout publish was not enqueued")
+		}
+
+		oq.ProcessBlock(createVote("observer1", "digest1"), publish)
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numPublished))
+
+		processHandler()
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numPublished))
+	})
+
+	t.Run("full ingestion queue should retry the timeout publish", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		numEnqueueCalls := uint32(0)
+		args.IngestionQueue = &mocks.IngestionQueueStub{
+			EnqueueCalled: func(_ uint32, processHandler func()) error {
+				if atomic.AddUint32(&numEnqueueCalls, 1) == 1 {
+					return common.ErrIngestionQueueFull
+				}
+
+				processHandler()
+				return nil
+			},
+		}
+		oq, _ := process.NewObserversQuorum(args)
+
+		numPublished := uint32(0)
+		oq.ProcessBlock(createVote("observer0", "digest1"), func() {
+			atomic.AddUint32(&numPublished, 1)
+		})
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadUint32(&numPublished) == 1
+		}, time.Second, 5*time.Millisecond)
+		require.Equal(t, uint32(2), atomic.LoadUint32(&numEnqueueCalls))
+	})
+
+	t.Run("locker error should publish on timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		args.Locker = &mocks.LockerStub{
+			AddToSetCalled: func(_ context.Context, _ string, _ string) (int64, error) {
+				return 0, errors.New("redis error")
+			},
+			IsEventProcessedCalled: func(_ context.Context, _ string) (bool, error) {
+				return true, nil
+			},
+		}
+		oq, _ := process.NewObserversQuorum(args)
+
+		numPublished := uint32(0)
+		oq.ProcessBlock(createVote("observer0", "digest1"), func() {
+			atomic.AddUint32(&numPublished, 1)
+		})
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadUint32(&numPublished) == 1
+		}, time.Second, 5*time.Millisecond)
+	})
+}
+
+func createNonceVote(observerID string, nonce uint64) data.ObserverBlockVote {
+	vote := createVote(observerID, "digest1")
+	vote.Nonce = nonce
+	vote.Hash = fmt.Sprintf("hash%d", nonce)
+
+	return vote
+}
+
+type publishedBlocks struct {
+	mut    sync.Mutex
+	hashes []string
+}
+
+func (pb *publishedBlocks) handler(hash string) func() {
+	return func() {
+		pb.mut.Lock()
+		pb.hashes = append(pb.hashes, hash)
+		pb.mut.Unlock()
+	}
+}
+
+func (pb *publishedBlocks) get() []string {
+	pb.mut.Lock()
+	defer pb.mut.Unlock()
+
+	return append([]string{}, pb.hashes...)
+}
+
+func TestObserversQuorum_ShardOrdering(t *testing.T) {
+	t.Parallel()
+
+	t.Run("later nonce reaching quorum should wait for the earlier nonce quorum", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		args.Config.TimeoutInMs = 10000
+		oq, _ := process.NewObserversQuorum(args)
+		defer func() {
+			_ = oq.Close()
+		}()
+
+		published := &publishedBlocks{}
+		oq.ProcessBlock(createNonceVote("observer0", 1), published.handler("hash1"))
+		oq.ProcessBlock(createNonceVote("observer0", 2), published.handler("hash2"))
+		oq.ProcessBlock(createNonceVote("observer1", 2), published.handler("hash2"))
+		require.Empty(t, published.get())
+
+		oq.ProcessBlock(createNonceVote("observer1", 1), published.handler("hash1"))
+		require.Equal(t, []string{"hash1", "hash2"}, published.get())
+	})
+
+	t.Run("later nonce reaching quorum should be published after the earlier nonce times out", func(t *testing.T) {
+		t.Parallel()
+
+		oq, _ := process.NewObserversQuorum(createMockObserversQuorumArgs(t))
+		defer func() {
+			_ = oq.Close()
+		}()
+
+		published := &publishedBlocks{}
+		oq.ProcessBlock(createNonceVote("observer0", 1), published.handler("hash1"))
+		oq.ProcessBlock(createNonceVote("observer0", 2), published.handler("hash2"))
+		oq.ProcessBlock(createNonceVote("observer1", 2), published.handler("hash2"))
+		require.Empty(t, published.get())
+
+		require.Eventually(t, func() bool {
+			return len(published.get()) == 2
+		}, time.Second, 5*time.Millisecond)
+		require.Equal(t, []string{"hash1", "hash2"}, published.get())
+	})
+
+	t.Run("other shards should not be held back", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		args.Config.TimeoutInMs = 10000
+		oq, _ := process.NewObserversQuorum(args)
+		defer func() {
+			_ = oq.Close()
+		}()
+
+		published := &publishedBlocks{}
+		oq.ProcessBlock(createNonceVote("observer0", 1), published.handler("hash1"))
+
+		vote := createNonceVote("observer0", 2)
+		vote.ShardID = 2
+		oq.ProcessBlock(vote, published.handler("hash2"))
+		vote.ObserverID = "observer1"
+		oq.ProcessBlock(vote, published.handler("hash2"))
+		require.Equal(t, []string{"hash2"}, published.get())
+	})
+
+	t.Run("pending block for a published nonce should not hold back the next nonces", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		args.Config.TimeoutInMs = 10000
+		oq, _ := process.NewObserversQuorum(args)
+		defer func() {
+			_ = oq.Close()
+		}()
+
+		published := &publishedBlocks{}
+		oq.ProcessBlock(createNonceVote("observer0", 1), published.handler("hash1"))
+		oq.ProcessBlock(createNonceVote("observer1", 1), published.handler("hash1"))
+		require.Equal(t, []string{"hash1"}, published.get())
+
+		fork := createNonceVote("observer2", 1)
+		fork.Hash = "hash1-fork"
+		oq.ProcessBlock(fork, published.handler("hash1-fork"))
+
+		oq.ProcessBlock(createNonceVote("observer0", 2), published.handler("hash2"))
+		oq.ProcessBlock(createNonceVote("observer1", 2), published.handler("hash2"))
+		require.Equal(t, []string{"hash1", "hash2"}, published.get())
+	})
+
+	t.Run("close should publish the held blocks in nonce order", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		args.Config.TimeoutInMs = 10000
+		oq, _ := process.NewObserversQuorum(args)
+
+		published := &publishedBlocks{}
+		oq.ProcessBlock(createNonceVote("observer0", 2), published.handler("hash2"))
+		oq.ProcessBlock(createNonceVote("observer0", 1), published.handler("hash1"))
+		oq.ProcessBlock(createNonceVote("observer0", 3), published.handler("hash3"))
+		oq.ProcessBlock(createNonceVote("observer1", 3), published.handler("hash3"))
+		require.Empty(t, published.get())
+
+		require.Nil(t, oq.Close())
+		require.Equal(t, []string{"hash1", "hash2", "hash3"}, published.get())
+	})
+}
+
+func TestObserversQuorum_Close(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should publish the most voted data of the pending blocks, in order", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		args.Config.MinObservers = 3
+		args.Config.TimeoutInMs = 10000
+		oq, _ := process.NewObserversQuorum(args)
+
+		var published []string
+		publish := func(blockData string) func() {
+			return func() {
+				published = append(published, blockData)
+			}
+		}
+
+		for i := 0; i < 5; i++ {
+			vote := createVote("observer0", "digest1")
+			vote.Hash = fmt.Sprintf("hash%d", i)
+			oq.ProcessBlock(vote, publish(vote.Hash+"-digest1"))
+		}
+		vote := createVote("observer1", "digest2")
+		vote.Hash = "hash3"
+		oq.ProcessBlock(vote, publish("hash3-digest2"))
+		vote = createVote("observer2", "digest2")
+		vote.Hash = "hash3"
+		oq.ProcessBlock(vote, publish("hash3-digest2"))
+		require.Empty(t, published)
+
+		err := oq.Close()
+		require.Nil(t, err)
+		expected := []string{"hash0-digest1", "hash1-digest1", "hash2-digest1", "hash3-digest2", "hash4-digest1"}
+		require.Equal(t, expected, published)
+
+		// the timers were stopped, nothing else is published
+		time.Sleep(100 * time.Millisecond)
+		require.Equal(t, expected, published)
+	})
+
+	t.Run("block published by another instance should not be published again", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		args.Config.TimeoutInMs = 10000
+		firstInstance, _ := process.NewObserversQuorum(args)
+		secondInstance, _ := process.NewObserversQuorum(args)
+
+		numPublished := uint32(0)
+		publish := func() {
+			atomic.AddUint32(&numPublished, 1)
+		}
+		firstInstance.ProcessBlock(createVote("observer0", "digest1"), publish)
+		secondInstance.ProcessBlock(createVote("observer0", "digest1"), publish)
+
+		require.Nil(t, firstInstance.Close())
+		require.Nil(t, secondInstance.Close())
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numPublished))
+	})
+
+	t.Run("claim error should return undelivered data error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockObserversQuorumArgs(t)
+		args.Config.TimeoutInMs = 10000
+		args.Locker = &mocks.LockerStub{
+			AddToSetCalled: func(_ context.Context, _ string, _ string) (int64, error) {
+				return 1, nil
+			},
+			IsEventProcessedCalled: func(_ context.Context, _ string) (bool, error) {
+				return false, errors.New("redis error")
+			},
+		}
+		oq, _ := process.NewObserversQuorum(args)
+
+		numPublished := uint32(0)
+		oq.ProcessBlock(createVote("observer0", "digest1"), func() {
+			atomic.AddUint32(&numPublished, 1)
+		})
+
+		err := oq.Close()
+		require.True(t, errors.Is(err, common.ErrUndeliveredData))
+		require.Equal(t, uint32(0), atomic.LoadUint32(&numPublished))
+	})
+
+	t.Run("vote after close should publish right away", func(t *testing.T) {
+		t.Parallel()
+
+		oq, _ := process.NewObserversQuorum(createMockObserversQuorumArgs(t))
+		require.Nil(t, oq.Close())
+
+		numPublished := uint32(0)
+		oq.ProcessBlock(createVote("observer0", "digest1"), func() {
+			atomic.AddUint32(&numPublished, 1)
+		})
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numPublished))
+	})
+}
@@ -0,0 +1,172 @@
+package process
+
+import (
+	"encoding/hex"
+	"sort"
+
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+const addressLength = 32
+
+// createTxBundles groups the block transactions with their smart contract results and events. The smart
+// contract results are linked to the originating transaction via the original tx hash, and into a tree via
+// the previous tx hash. The smart contract results of a transaction executed in another shard are grouped
+// in a bundle without transaction. The events are expected to be already sorted in execution order
+func (ei *eventsInterceptor) createTxBundles(pool *data.TransactionsPool, events []data.Event, shardID uint32) []*data.TxBundle {
+	bundles := make(map[string]*data.TxBundle)
+	bundleHashes := make(map[string]string)
+
+	for hash, tx := range pool.Txs {
+		if tx == nil || tx.TransactionHandler == nil {
+			continue
+		}
+
+		bundles[hash] = &data.TxBundle{
+			TxHash:         hash,
+			Sender:         ei.encodeAddress(tx.TransactionHandler.SndAddr),
+			Receiver:       ei.encodeAddress(tx.TransactionHandler.RcvAddr),
+			Tx:             tx.TransactionHandler,
+			FeeInfo:        tx.FeeInfo,
+			ExecutionOrder: tx.ExecutionOrder,
+			Scrs:           make([]*data.ScrNode, 0),
+			Events:         make([]data.Event, 0),
+		}
+		bundleHashes[hash] = hash
+	}
+
+	scrNodes := make(map[string]*data.ScrNode)
+	for hash, scr := range pool.Scrs {
+		if scr == nil || scr.TransactionHandler == nil {
+			continue
+		}
+
+		originalTxHash := hex.EncodeToString(scr.TransactionHandler.OriginalTxHash)
+		if originalTxHash == "" {
+			originalTxHash = hash
+		}
+
+		bundle, ok := bundles[originalTxHash]
+		if !ok {
+			bundle = &data.TxBundle{
+				TxHash:         originalTxHash,
+				Sender:         ei.encodeAddress(scr.TransactionHandler.OriginalSender),
+				Receiver:       ei.encodeAddress(scr.TransactionHandler.RcvAddr),
+				ExecutionOrder: scr.ExecutionOrder,
+				Scrs:           make([]*data.ScrNode, 0),
+				Events:         make([]data.Event, 0),
+			}
+			bundles[originalTxHash] = bundle
+		}
+		if bundle.Tx == nil && scr.ExecutionOrder < bundle.ExecutionOrder {
+			bundle.ExecutionOrder = scr.ExecutionOrder
+		}
+
+		scrNodes[hash] = &data.ScrNode{
+			Hash:           hash,
+			Scr:            scr.TransactionHandler,
+			ExecutionOrder: scr.ExecutionOrder,
+		}
+		bundleHashes[hash] = originalTxHash
+	}
+
+	for hash, node := range scrNodes {
+		bundleHash := bundleHashes[hash]
+		prevTxHash := hex.EncodeToString(node.Scr.PrevTxHash)
+
+		parent, ok := scrNodes[prevTxHash]
+		if ok && prevTxHash != hash && bundleHashes[prevTxHash] == bundleHash {
+			parent.Children = append(parent.Children, node)
+			continue
+		}
+
+		bundles[bundleHash].Scrs = append(bundles[bundleHash].Scrs, node)
+	}
+
+	for _, event := range events {
+		bundleHash, ok := bundleHashes[event.TxHash]
+		if !ok {
+			continue
+		}
+
+		bundles[bundleHash].Events = append(bundles[bundleHash].Events, event)
+	}
+
+	sortedBundles := make([]*data.TxBundle, 0, len(bundles))
+	for _, bundle := range bundles {
+		sortScrNodes(bundle.Scrs)
+		bundle.Status = getTxBundleStatus(bundle, shardID)
+		sortedBundles = append(sortedBundles, bundle)
+	}
+
+	sort.Slice(sortedBundles, func(i, j int) bool {
+		if sortedBundles[i].ExecutionOrder != sortedBundles[j].ExecutionOrder {
+			return sortedBundles[i].ExecutionOrder < sortedBundles[j].ExecutionOrder
+		}
+		return sortedBundles[i].TxHash < sortedBundles[j].TxHash
+	})
+
+	return sortedBundles
+}
+
+func (ei *eventsInterceptor) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return ei.pubKeyConverter.SilentEncode(address, log)
+}
+
+func sortScrNodes(nodes []*data.ScrNode) {
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].ExecutionOrder != nodes[j].ExecutionOrder {
+			return nodes[i].ExecutionOrder < nodes[j].ExecutionOrder
+		}
+		return nodes[i].Hash < nodes[j].Hash
+	})
+
+	for _, node := range nodes {
+		sortScrNodes(node.Children)
+	}
+}
+
+// getTxBundleStatus returns failed if the bundle has a signalError or internalVMErrors event, pending if
+// the transaction or one of its smart contract results is sent to another shard, and success otherwise
+func getTxBundleStatus(bundle *data.TxBundle, shardID uint32) string {
+	for _, event := range bundle.Events {
+		if event.Identifier == "signalError" || event.Identifier == "internalVMErrors" {
+			return common.TxStatusFailed
+		}
+	}
+
+	if bundle.Tx != nil && isCrossShardAddress(bundle.Tx.RcvAddr, shardID) {
+		return common.TxStatusPending
+	}
+	if hasCrossShardScr(bundle.Scrs, shardID) {
+		return common.TxStatusPending
+	}
+
+	return common.TxStatusSuccess
+}
+
+func hasCrossShardScr(nodes []*data.ScrNode, shardID uint32) bool {
+	for _, node := range nodes {
+		if isCrossShardAddress(node.Scr.RcvAddr, shardID) {
+			return true
+		}
+		if hasCrossShardScr(node.Children, shardID) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isCrossShardAddress(address []byte, shardID uint32) bool {
+	if len(address) != addressLength {
+		return false
+	}
+
+	return getShardOfAddress(hex.EncodeToString(address)) != int(shardID)
+}
@@ -0,0 +1,156 @@
+package process_test
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func createAddressInShard(lastByte byte) []byte {
+	address := make([]byte, 32)
+	address[0] = 1
+	address[31] = lastByte
+
+	return address
+}
+
+func TestCreateTxBundles(t *testing.T) {
+	t.Parallel()
+
+	args := process.ArgsEventsInterceptor{
+		PubKeyConverter: mocks.NewPubkeyConverterMock(32),
+		HexKeyConvertor: mocks.NewPubkeyConverterMock(32),
+	}
+	eventsInterceptor, err := process.NewEventsInterceptor(args)
+	require.Nil(t, err)
+
+	addrShard1 := createAddressInShard(1)
+	otherAddrShard1 := createAddressInShard(5)
+	addrShard2 := createAddressInShard(2)
+
+	txHash1 := hex.EncodeToString([]byte("txHash1"))
+	txHash2 := hex.EncodeToString([]byte("txHash2"))
+	txHash3 := hex.EncodeToString([]byte("txHash3"))
+	crossShardTxHash := hex.EncodeToString([]byte("crossShardTxHash"))
+	scrHash1 := hex.EncodeToString([]byte("scrHash1"))
+	scrHash2 := hex.EncodeToString([]byte("scrHash2"))
+	scrHash3 := hex.EncodeToString([]byte("scrHash3"))
+
+	tx1 := &transaction.Transaction{Nonce: 1, SndAddr: addrShard1, RcvAddr: otherAddrShard1}
+	tx2 := &transaction.Transaction{Nonce: 2, SndAddr: addrShard1, RcvAddr: addrShard2}
+	tx3 := &transaction.Transaction{Nonce: 3, SndAddr: otherAddrShard1, RcvAddr: addrShard1}
+	scr1 := &smartContractResult.SmartContractResult{
+		Nonce:          4,
+		RcvAddr:        otherAddrShard1,
+		OriginalTxHash: []byte("txHash1"),
+		PrevTxHash:     []byte("txHash1"),
+	}
+	scr2 := &smartContractResult.SmartContractResult{
+		Nonce:          5,
+		RcvAddr:        addrShard1,
+		OriginalTxHash: []byte("txHash1"),
+		PrevTxHash:     []byte("scrHash1"),
+	}
+	scr3 := &smartContractResult.SmartContractResult{
+		Nonce:          6,
+		RcvAddr:        addrShard1,
+		OriginalSender: addrShard2,
+		OriginalTxHash: []byte("crossShardTxHash"),
+		PrevTxHash:     []byte("crossShardTxHash"),
+	}
+	feeInfo := outport.FeeInfo{GasUsed: 10, Fee: big.NewInt(100)}
+
+	pool := &data.TransactionsPool{
+		Txs: map[string]*data.NodeTransaction{
+			txHash1: {TransactionHandler: tx1, FeeInfo: feeInfo, ExecutionOrder: 1},
+			txHash2: {TransactionHandler: tx2, ExecutionOrder: 4},
+			txHash3: {TransactionHandler: tx3, ExecutionOrder: 0},
+		},
+		Scrs: map[string]*data.NodeSmartContractResult{
+			scrHash1: {TransactionHandler: scr1, ExecutionOrder: 2},
+			scrHash2: {TransactionHandler: scr2, ExecutionOrder: 3},
+			scrHash3: {TransactionHandler: scr3, ExecutionOrder: 5},
+		},
+	}
+	events := []data.Event{
+		{Identifier: "signalError", TxHash: txHash3},
+		{Identifier: "ESDTTransfer", TxHash: txHash1},
+		{Identifier: "writeLog", TxHash: scrHash2},
+		{Identifier: "ESDTTransfer", TxHash: scrHash3},
+		{Identifier: "unknown", TxHash: "unknownHash"},
+	}
+
+	bundles := eventsInterceptor.CreateTxBundles(pool, events, 1)
+
+	expectedBundles := []*data.TxBundle{
+		{
+			TxHash:         txHash3,
+			Sender:         hex.EncodeToString(otherAddrShard1),
+			Receiver:       hex.EncodeToString(addrShard1),
+			Tx:             tx3,
+			ExecutionOrder: 0,
+			Status:         common.TxStatusFailed,
+			Scrs:           []*data.ScrNode{},
+			Events:         []data.Event{events[0]},
+		},
+		{
+			TxHash:         txHash1,
+			Sender:         hex.EncodeToString(addrShard1),
+			Receiver:       hex.EncodeToString(otherAddrShard1),
+			Tx:             tx1,
+			FeeInfo:        feeInfo,
+			ExecutionOrder: 1,
+			Status:         common.TxStatusSuccess,
+			Scrs: []*data.ScrNode{
+				{
+					Hash:           scrHash1,
+					Scr:            scr1,
+					ExecutionOrder: 2,
+					Children: []*data.ScrNode{
+						{
+							Hash:           scrHash2,
+							Scr:            scr2,
+							ExecutionOrder: 3,
+						},
+					},
+				},
+			},
+			Events: []data.Event{events[1], events[2]},
+		},
+		{
+			TxHash:         txHash2,
+			Sender:         hex.EncodeToString(addrShard1),
+			Receiver:       hex.EncodeToString(addrShard2),
+			Tx:             tx2,
+			ExecutionOrder: 4,
+			Status:         common.TxStatusPending,
+			Scrs:           []*data.ScrNode{},
+			Events:         []data.Event{},
+		},
+		{
+			TxHash:         crossShardTxHash,
+			Sender:         hex.EncodeToString(addrShard2),
+			Receiver:       hex.EncodeToString(addrShard1),
+			ExecutionOrder: 5,
+			Status:         common.TxStatusSuccess,
+			Scrs: []*data.ScrNode{
+				{
+					Hash:           scrHash3,
+					Scr:            scr3,
+					ExecutionOrder: 5,
+				},
+			},
+			Events: []data.Event{events[3]},
+		},
+	}
+	require.Equal(t, expectedBundles, bundles)
+}
@@ -0,0 +1,131 @@
+package process
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+type trackedTxEntry struct {
+	entry      data.TrackedTx
+	deadlineMs int64
+}
+
+type expiringKey struct {
+	key        string
+	deadlineMs int64
+}
+
+type txStatusMemoryStorer struct {
+	mut      sync.Mutex
+	claimTTL time.Duration
+
+	trackedTxs map[string]trackedTxEntry
+	deadlines  []expiringKey
+	claims     map[string]int64
+	claimsTTL  []expiringKey
+}
+
+// NewTxStatusMemoryStorer creates a storer which keeps the tracked transactions in memory, to be used
+// when the tracker state does not have to be shared with other notifier instances. The final status
+// claims are kept for the provided TTL
+func NewTxStatusMemoryStorer(claimTTL time.Duration) *txStatusMemoryStorer {
+	return &txStatusMemoryStorer{
+		claimTTL:   claimTTL,
+		trackedTxs: make(map[string]trackedTxEntry),
+		deadlines:  make([]expiringKey, 0),
+		claims:     make(map[string]int64),
+		claimsTTL:  make([]expiringKey, 0),
+	}
+}
+
+// Track stores the pending transaction together with its deadline, if it is not already tracked
+func (ms *txStatusMemoryStorer) Track(_ context.Context, entry data.TrackedTx, deadlineMs int64) error {
+	ms.mut.Lock()
+	defer ms.mut.Unlock()
+
+	_, exists := ms.trackedTxs[entry.TxHash]
+	if exists {
+		return nil
+	}
+
+	ms.trackedTxs[entry.TxHash] = trackedTxEntry{
+		entry:      entry,
+		deadlineMs: deadlineMs,
+	}
+	ms.deadlines = append(ms.deadlines, expiringKey{key: entry.TxHash, deadlineMs: deadlineMs})
+
+	return nil
+}
+
+// ClaimFinalStatus marks the transaction as having a final status. It returns true only for the first caller
+func (ms *txStatusMemoryStorer) ClaimFinalStatus(_ context.Context, txHash string) (bool, error) {
+	ms.mut.Lock()
+	defer ms.mut.Unlock()
+
+	_, exists := ms.claims[txHash]
+	if exists {
+		return false, nil
+	}
+
+	expiryMs := nowInMs() + ms.claimTTL.Milliseconds()
+	ms.claims[txHash] = expiryMs
+	ms.claimsTTL = append(ms.claimsTTL, expiringKey{key: txHash, deadlineMs: expiryMs})
+
+	return true, nil
+}
+
+// Remove removes the tracked transaction and returns it, or nil if it is not tracked. The deadline
+// is dropped when it is reached
+func (ms *txStatusMemoryStorer) Remove(_ context.Context, txHash string) (*data.TrackedTx, error) {
+	ms.mut.Lock()
+	defer ms.mut.Unlock()
+
+	tracked, exists := ms.trackedTxs[txHash]
+	if !exists {
+		return nil, nil
+	}
+	delete(ms.trackedTxs, txHash)
+
+	return &tracked.entry, nil
+}
+
+// PopExpired removes and returns at most maxNum tracked transactions with the deadline before nowMs. The
+// expired final status claims are removed as well. The deadlines are appended in increasing order, so
+// only the expired ones are visited
+func (ms *txStatusMemoryStorer) PopExpired(_ context.Context, nowMs int64, maxNum int) ([]*data.TrackedTx, error) {
+	ms.mut.Lock()
+	defer ms.mut.Unlock()
+
+	for len(ms.claimsTTL) > 0 && ms.claimsTTL[0].deadlineMs <= nowMs {
+		claim := ms.claimsTTL[0]
+		if ms.claims[claim.key] == claim.deadlineMs {
+			delete(ms.claims, claim.key)
+		}
+		ms.claimsTTL = ms.claimsTTL[1:]
+	}
+
+	entries := make([]*data.TrackedTx, 0)
+	for len(ms.deadlines) > 0 && ms.deadlines[0].deadlineMs <= nowMs && len(entries) < maxNum {
+		deadline := ms.deadlines[0]
+		ms.deadlines = ms.deadlines[1:]
+
+		tracked, exists := ms.trackedTxs[deadline.key]
+		if !exists || tracked.deadlineMs != deadline.deadlineMs {
+			continue
+		}
+		delete(ms.trackedTxs, deadline.key)
+
+		entry := tracked.entry
+		entries = append(entries, &entry)
+	}
+
+	return entries, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ms *txStatusMemoryStorer) IsInterfaceNil() bool {
+	return ms == nil
+}
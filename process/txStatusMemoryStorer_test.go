@@ -0,0 +1,73 @@
+package process_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxStatusMemoryStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should pop the expired transactions in deadline order", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		storer := process.NewTxStatusMemoryStorer(time.Minute)
+		require.False(t, check.IfNil(storer))
+
+		_ = storer.Track(ctx, data.TrackedTx{TxHash: "txHash1", Sender: "sender1"}, 10)
+		_ = storer.Track(ctx, data.TrackedTx{TxHash: "txHash2"}, 20)
+		_ = storer.Track(ctx, data.TrackedTx{TxHash: "txHash3"}, 30)
+		_ = storer.Track(ctx, data.TrackedTx{TxHash: "txHash4"}, 40)
+		// already tracked, the first deadline is kept
+		_ = storer.Track(ctx, data.TrackedTx{TxHash: "txHash1"}, 50)
+
+		entry, err := storer.Remove(ctx, "txHash2")
+		require.Nil(t, err)
+		require.Equal(t, "txHash2", entry.TxHash)
+
+		entries, err := storer.PopExpired(ctx, 35, 1)
+		require.Nil(t, err)
+		require.Equal(t, []*data.TrackedTx{{TxHash: "txHash1", Sender: "sender1"}}, entries)
+
+		entries, err = storer.PopExpired(ctx, 35, 10)
+		require.Nil(t, err)
+		require.Equal(t, []*data.TrackedTx{{TxHash: "txHash3"}}, entries)
+
+		entries, err = storer.PopExpired(ctx, 35, 10)
+		require.Nil(t, err)
+		require.Equal(t, 0, len(entries))
+
+		entry, err = storer.Remove(ctx, "txHash1")
+		require.Nil(t, err)
+		require.Nil(t, entry)
+	})
+
+	t.Run("final status should be claimed once until expiry", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.Background()
+		storer := process.NewTxStatusMemoryStorer(time.Minute)
+
+		claimed, err := storer.ClaimFinalStatus(ctx, "txHash1")
+		require.Nil(t, err)
+		require.True(t, claimed)
+
+		claimed, err = storer.ClaimFinalStatus(ctx, "txHash1")
+		require.Nil(t, err)
+		require.False(t, claimed)
+
+		nowMs := time.Now().UnixNano() / int64(time.Millisecond)
+		_, _ = storer.PopExpired(ctx, nowMs+time.Minute.Milliseconds(), 10)
+
+		claimed, err = storer.ClaimFinalStatus(ctx, "txHash1")
+		require.Nil(t, err)
+		require.True(t, claimed)
+	})
+}
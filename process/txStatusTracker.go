@@ -0,0 +1,234 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+const (
+	completedTxEventIdentifier = "completedTxEvent"
+	maxExpiredTxsPerCheck      = 1000
+	minTxStatusTimeout         = time.Second
+)
+
+// ArgsTxStatusTracker defines the arguments needed for creating a tx status tracker component
+type ArgsTxStatusTracker struct {
+	Storer          TxStatusStorer
+	TxStatusHandler TxStatusHandler
+	Timeout         time.Duration
+}
+
+type txStatusTracker struct {
+	storer          TxStatusStorer
+	txStatusHandler TxStatusHandler
+	timeout         time.Duration
+
+	cancelFunc func()
+}
+
+// NewTxStatusTracker creates a component which follows the transactions across blocks and shards.
+// The final status of a transaction is handled once it is completed or failed, while the transactions
+// which are not completed within the timeout are handled with the timeout status
+func NewTxStatusTracker(args ArgsTxStatusTracker) (*txStatusTracker, error) {
+	err := checkTxStatusTrackerArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	tst := &txStatusTracker{
+		storer:          args.Storer,
+		txStatusHandler: args.TxStatusHandler,
+		timeout:         args.Timeout,
+	}
+
+	var ctx context.Context
+	ctx, tst.cancelFunc = context.WithCancel(context.Background())
+	go tst.runExpiryLoop(ctx)
+
+	return tst, nil
+}
+
+func checkTxStatusTrackerArgs(args ArgsTxStatusTracker) error {
+	if check.IfNil(args.Storer) {
+		return ErrNilTxStatusStorer
+	}
+	if check.IfNil(args.TxStatusHandler) {
+		return ErrNilTxStatusHandler
+	}
+	if args.Timeout < minTxStatusTimeout {
+		return fmt.Errorf("%w for tx status timeout: %v, minimum: %v", ErrInvalidValue, args.Timeout, minTxStatusTimeout)
+	}
+
+	return nil
+}
+
+// ProcessTxBundles updates the tracked transactions from the bundles of a block. The pending transactions
+// are tracked until completed, while the completed and failed ones are handled, unless already handled
+func (tst *txStatusTracker) ProcessTxBundles(blockTxBundles data.BlockTxBundles) {
+	for _, bundle := range blockTxBundles.Bundles {
+		if bundle == nil {
+			continue
+		}
+
+		status := getTrackedTxStatus(bundle)
+		if status == common.TxStatusPending {
+			tst.trackPendingTx(bundle)
+			continue
+		}
+
+		tst.completeTx(bundle, status, blockTxBundles)
+	}
+}
+
+// getTrackedTxStatus returns the status of the bundle, considering completed the pending transactions
+// with a completedTxEvent event
+func getTrackedTxStatus(bundle *data.TxBundle) string {
+	if bundle.Status != common.TxStatusPending {
+		return bundle.Status
+	}
+
+	for _, event := range bundle.Events {
+		if event.Identifier == completedTxEventIdentifier {
+			return common.TxStatusSuccess
+		}
+	}
+
+	return common.TxStatusPending
+}
+
+func (tst *txStatusTracker) trackPendingTx(bundle *data.TxBundle) {
+	nowMs := nowInMs()
+	entry := data.TrackedTx{
+		TxHash:      bundle.TxHash,
+		Sender:      bundle.Sender,
+		Receiver:    bundle.Receiver,
+		FirstSeenMs: nowMs,
+	}
+
+	err := tst.storer.Track(context.Background(), entry, nowMs+tst.timeout.Milliseconds())
+	if err != nil {
+		log.Error("could not track pending transaction", "tx hash", bundle.TxHash, "err", err.Error())
+	}
+}
+
+// completeTx handles the final status of the transaction, if the status was not already claimed. The claim
+// is atomic, so the status is handled once, even if several notifier instances or the expiry loop race for it
+func (tst *txStatusTracker) completeTx(bundle *data.TxBundle, status string, blockTxBundles data.BlockTxBundles) {
+	ctx := context.Background()
+
+	claimed, err := tst.storer.ClaimFinalStatus(ctx, bundle.TxHash)
+	if err != nil {
+		log.Error("could not claim transaction final status", "tx hash", bundle.TxHash, "err", err.Error())
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	txStatus := data.TxStatus{
+		TxHash:       bundle.TxHash,
+		Sender:       bundle.Sender,
+		Receiver:     bundle.Receiver,
+		Status:       status,
+		BlockHash:    blockTxBundles.Hash,
+		ShardID:      blockTxBundles.ShardID,
+		BlockNonce:   blockTxBundles.Nonce,
+		TimeStamp:    blockTxBundles.TimeStamp,
+		TraceContext: blockTxBundles.TraceContext,
+	}
+
+	entry, err := tst.storer.Remove(ctx, bundle.TxHash)
+	if err != nil {
+		log.Error("could not remove tracked transaction", "tx hash", bundle.TxHash, "err", err.Error())
+	}
+	// the bundles from the destination shard do not have the original sender
+	if entry != nil && txStatus.Sender == "" {
+		txStatus.Sender = entry.Sender
+	}
+	if entry != nil && txStatus.Receiver == "" {
+		txStatus.Receiver = entry.Receiver
+	}
+
+	tst.txStatusHandler.HandleTxStatus(txStatus)
+}
+
+func (tst *txStatusTracker) runExpiryLoop(ctx context.Context) {
+	ticker := time.NewTicker(tst.timeout / 4)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug("tx status tracker expiry loop is stopping...")
+			return
+		case <-ticker.C:
+			tst.checkExpiredTxs()
+		}
+	}
+}
+
+// checkExpiredTxs handles the timeout status for the pending transactions which reached the deadline. Each
+// expired transaction is popped by a single instance, and the timeout is handled only if the final status
+// was not claimed already
+func (tst *txStatusTracker) checkExpiredTxs() {
+	tst.checkExpiredTxsAt(time.Now())
+}
+
+func (tst *txStatusTracker) checkExpiredTxsAt(now time.Time) {
+	ctx := context.Background()
+	nowMs := now.UnixNano() / int64(time.Millisecond)
+
+	for {
+		entries, err := tst.storer.PopExpired(ctx, nowMs, maxExpiredTxsPerCheck)
+		if err != nil {
+			log.Error("could not get expired transactions", "err", err.Error())
+		}
+
+		for _, entry := range entries {
+			tst.timeoutTx(ctx, entry)
+		}
+
+		if err != nil || len(entries) < maxExpiredTxsPerCheck {
+			return
+		}
+	}
+}
+
+func (tst *txStatusTracker) timeoutTx(ctx context.Context, entry *data.TrackedTx) {
+	claimed, err := tst.storer.ClaimFinalStatus(ctx, entry.TxHash)
+	if err != nil {
+		log.Error("could not claim transaction timeout status", "tx hash", entry.TxHash, "err", err.Error())
+		return
+	}
+	if !claimed {
+		return
+	}
+
+	tst.txStatusHandler.HandleTxStatus(data.TxStatus{
+		TxHash:   entry.TxHash,
+		Sender:   entry.Sender,
+		Receiver: entry.Receiver,
+		Status:   common.TxStatusTimeout,
+	})
+}
+
+func nowInMs() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// Close stops the expiry loop
+func (tst *txStatusTracker) Close() error {
+	tst.cancelFunc()
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tst *txStatusTracker) IsInterfaceNil() bool {
+	return tst == nil
+}
@@ -0,0 +1,315 @@
+package process_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/process"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockTxStatusTrackerArgs() process.ArgsTxStatusTracker {
+	return process.ArgsTxStatusTracker{
+		Storer:          process.NewTxStatusMemoryStorer(2 * time.Hour),
+		TxStatusHandler: &mocks.EventsHandlerStub{},
+		Timeout:         time.Hour,
+	}
+}
+
+func createBlockTxBundles(bundles ...*data.TxBundle) data.BlockTxBundles {
+	return data.BlockTxBundles{
+		Hash:      "blockHash1",
+		ShardID:   1,
+		Nonce:     10,
+		TimeStamp: 1234,
+		Bundles:   bundles,
+	}
+}
+
+func TestNewTxStatusTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTxStatusTrackerArgs()
+		args.Storer = nil
+
+		tst, err := process.NewTxStatusTracker(args)
+		require.True(t, check.IfNil(tst))
+		require.Equal(t, process.ErrNilTxStatusStorer, err)
+	})
+
+	t.Run("nil tx status handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTxStatusTrackerArgs()
+		args.TxStatusHandler = nil
+
+		tst, err := process.NewTxStatusTracker(args)
+		require.True(t, check.IfNil(tst))
+		require.Equal(t, process.ErrNilTxStatusHandler, err)
+	})
+
+	t.Run("zero timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTxStatusTrackerArgs()
+		args.Timeout = 0
+
+		tst, err := process.NewTxStatusTracker(args)
+		require.True(t, check.IfNil(tst))
+		require.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+
+	t.Run("negative timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTxStatusTrackerArgs()
+		args.Timeout = -time.Second
+
+		tst, err := process.NewTxStatusTracker(args)
+		require.True(t, check.IfNil(tst))
+		require.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+
+	t.Run("timeout below minimum", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTxStatusTrackerArgs()
+		args.Timeout = time.Nanosecond * 3
+
+		tst, err := process.NewTxStatusTracker(args)
+		require.True(t, check.IfNil(tst))
+		require.True(t, errors.Is(err, process.ErrInvalidValue))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tst, err := process.NewTxStatusTracker(createMockTxStatusTrackerArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(tst))
+		require.Nil(t, tst.Close())
+	})
+}
+
+func TestTxStatusTracker_ProcessTxBundles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("completed and failed transactions should be handled with block info", func(t *testing.T) {
+		t.Parallel()
+
+		handled := make([]data.TxStatus, 0)
+		args := createMockTxStatusTrackerArgs()
+		args.TxStatusHandler = &mocks.EventsHandlerStub{
+			HandleTxStatusCalled: func(txStatus data.TxStatus) {
+				handled = append(handled, txStatus)
+			},
+		}
+
+		tst, err := process.NewTxStatusTracker(args)
+		require.Nil(t, err)
+		defer func() { _ = tst.Close() }()
+
+		tst.ProcessTxBundles(createBlockTxBundles(
+			&data.TxBundle{TxHash: "txHash1", Sender: "sender1", Receiver: "receiver1", Status: common.TxStatusSuccess},
+			&data.TxBundle{TxHash: "txHash2", Sender: "sender2", Receiver: "receiver2", Status: common.TxStatusFailed},
+		))
+
+		expectedStatuses := []data.TxStatus{
+			{
+				TxHash:     "txHash1",
+				Sender:     "sender1",
+				Receiver:   "receiver1",
+				Status:     common.TxStatusSuccess,
+				BlockHash:  "blockHash1",
+				ShardID:    1,
+				BlockNonce: 10,
+				TimeStamp:  1234,
+			},
+			{
+				TxHash:     "txHash2",
+				Sender:     "sender2",
+				Receiver:   "receiver2",
+				Status:     common.TxStatusFailed,
+				BlockHash:  "blockHash1",
+				ShardID:    1,
+				BlockNonce: 10,
+				TimeStamp:  1234,
+			},
+		}
+		require.Equal(t, expectedStatuses, handled)
+	})
+
+	t.Run("pending transaction should be tracked until completed", func(t *testing.T) {
+		t.Parallel()
+
+		handled := make([]data.TxStatus, 0)
+		args := createMockTxStatusTrackerArgs()
+		args.TxStatusHandler = &mocks.EventsHandlerStub{
+			HandleTxStatusCalled: func(txStatus data.TxStatus) {
+				handled = append(handled, txStatus)
+			},
+		}
+
+		tst, err := process.NewTxStatusTracker(args)
+		require.Nil(t, err)
+		defer func() { _ = tst.Close() }()
+
+		tst.ProcessTxBundles(createBlockTxBundles(
+			&data.TxBundle{TxHash: "txHash1", Sender: "sender1", Receiver: "receiver1", Status: common.TxStatusPending},
+		))
+		require.Equal(t, 0, len(handled))
+
+		// the bundle on the destination shard does not have the original sender
+		tst.ProcessTxBundles(createBlockTxBundles(
+			&data.TxBundle{TxHash: "txHash1", Receiver: "receiver1", Status: common.TxStatusSuccess},
+		))
+		require.Equal(t, 1, len(handled))
+		require.Equal(t, "sender1", handled[0].Sender)
+		require.Equal(t, common.TxStatusSuccess, handled[0].Status)
+	})
+
+	t.Run("pending transaction with completedTxEvent should be handled as success", func(t *testing.T) {
+		t.Parallel()
+
+		handled := make([]data.TxStatus, 0)
+		args := createMockTxStatusTrackerArgs()
+		args.TxStatusHandler = &mocks.EventsHandlerStub{
+			HandleTxStatusCalled: func(txStatus data.TxStatus) {
+				handled = append(handled, txStatus)
+			},
+		}
+
+		tst, err := process.NewTxStatusTracker(args)
+		require.Nil(t, err)
+		defer func() { _ = tst.Close() }()
+
+		tst.ProcessTxBundles(createBlockTxBundles(
+			&data.TxBundle{
+				TxHash: "txHash1",
+				Status: common.TxStatusPending,
+				Events: []data.Event{{Identifier: "completedTxEvent", TxHash: "txHash1"}},
+			},
+		))
+		require.Equal(t, 1, len(handled))
+		require.Equal(t, common.TxStatusSuccess, handled[0].Status)
+	})
+
+	t.Run("completed transaction should not be handled or tracked again", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := 0
+		args := createMockTxStatusTrackerArgs()
+		args.TxStatusHandler = &mocks.EventsHandlerStub{
+			HandleTxStatusCalled: func(txStatus data.TxStatus) {
+				numCalls++
+			},
+		}
+
+		tst, err := process.NewTxStatusTracker(args)
+		require.Nil(t, err)
+		defer func() { _ = tst.Close() }()
+
+		bundles := createBlockTxBundles(
+			&data.TxBundle{TxHash: "txHash1", Status: common.TxStatusSuccess},
+		)
+		tst.ProcessTxBundles(bundles)
+		tst.ProcessTxBundles(bundles)
+		tst.ProcessTxBundles(createBlockTxBundles(
+			&data.TxBundle{TxHash: "txHash1", Status: common.TxStatusPending},
+		))
+		require.Equal(t, 1, numCalls)
+
+		// the late pending bundle should not be handled as timeout
+		tst.CheckExpiredTxsAt(time.Now().Add(time.Hour + time.Minute))
+		require.Equal(t, 1, numCalls)
+	})
+
+	t.Run("instances sharing the storer should handle the final status once", func(t *testing.T) {
+		t.Parallel()
+
+		numCalls := uint32(0)
+		args := createMockTxStatusTrackerArgs()
+		args.TxStatusHandler = &mocks.EventsHandlerStub{
+			HandleTxStatusCalled: func(txStatus data.TxStatus) {
+				atomic.AddUint32(&numCalls, 1)
+			},
+		}
+
+		numInstances := 10
+		wg := sync.WaitGroup{}
+		wg.Add(numInstances)
+		for i := 0; i < numInstances; i++ {
+			tst, err := process.NewTxStatusTracker(args)
+			require.Nil(t, err)
+			defer func() { _ = tst.Close() }()
+
+			go func() {
+				defer wg.Done()
+				tst.ProcessTxBundles(createBlockTxBundles(
+					&data.TxBundle{TxHash: "txHash1", Status: common.TxStatusSuccess},
+				))
+				tst.CheckExpiredTxsAt(time.Now().Add(time.Hour + time.Minute))
+			}()
+		}
+		wg.Wait()
+
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+	})
+}
+
+func TestTxStatusTracker_CheckExpiredTxs(t *testing.T) {
+	t.Parallel()
+
+	handled := make([]data.TxStatus, 0)
+	args := createMockTxStatusTrackerArgs()
+	args.TxStatusHandler = &mocks.EventsHandlerStub{
+		HandleTxStatusCalled: func(txStatus data.TxStatus) {
+			handled = append(handled, txStatus)
+		},
+	}
+
+	tst, err := process.NewTxStatusTracker(args)
+	require.Nil(t, err)
+	defer func() { _ = tst.Close() }()
+
+	tst.ProcessTxBundles(createBlockTxBundles(
+		&data.TxBundle{TxHash: "expiredPending", Sender: "sender1", Receiver: "receiver1", Status: common.TxStatusPending},
+		&data.TxBundle{TxHash: "completedPending", Sender: "sender2", Status: common.TxStatusPending},
+	))
+	tst.ProcessTxBundles(createBlockTxBundles(
+		&data.TxBundle{TxHash: "completedPending", Status: common.TxStatusSuccess},
+	))
+	require.Equal(t, 1, len(handled))
+
+	// not expired yet
+	tst.CheckExpiredTxsAt(time.Now())
+	require.Equal(t, 1, len(handled))
+
+	tst.CheckExpiredTxsAt(time.Now().Add(time.Hour))
+
+	expectedStatus := data.TxStatus{
+		TxHash:   "expiredPending",
+		Sender:   "sender1",
+		Receiver: "receiver1",
+		Status:   common.TxStatusTimeout,
+	}
+	require.Equal(t, 2, len(handled))
+	require.Equal(t, expectedStatus, handled[1])
+
+	// already expired transactions should not be handled again, even if completed later
+	tst.CheckExpiredTxsAt(time.Now().Add(time.Hour))
+	tst.ProcessTxBundles(createBlockTxBundles(
+		&data.TxBundle{TxHash: "expiredPending", Status: common.TxStatusSuccess},
+	))
+	require.Equal(t, 2, len(handled))
+}
@@ -0,0 +1,59 @@
+package rabbitmq
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-notifier-go/common/tlsclient"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/streadway/amqp"
+)
+
+const (
+	defaultHeartbeat       = 10 * time.Second
+	defaultLocale          = "en_US"
+	connectionNameProperty = "connection_name"
+	amqpsScheme            = "amqps"
+)
+
+// createAMQPConfig builds the amqp dial config based on the provided rabbitMQ config.
+// The returned config is reused on every reconnect attempt.
+func createAMQPConfig(cfg config.RabbitMQConfig) (amqp.Config, error) {
+	heartbeat := defaultHeartbeat
+	if cfg.HeartbeatInSec > 0 {
+		heartbeat = time.Second * time.Duration(cfg.HeartbeatInSec)
+	}
+
+	amqpConfig := amqp.Config{
+		Heartbeat:  heartbeat,
+		ChannelMax: int(cfg.ChannelMax),
+		Locale:     defaultLocale,
+		Properties: amqp.Table{},
+	}
+	if cfg.ConnectionName != "" {
+		amqpConfig.Properties[connectionNameProperty] = cfg.ConnectionName
+	}
+
+	if !cfg.TLS.Enabled {
+		return amqpConfig, nil
+	}
+
+	uri, err := amqp.ParseURI(cfg.Url)
+	if err != nil {
+		return amqp.Config{}, err
+	}
+	if uri.Scheme != amqpsScheme {
+		return amqp.Config{}, fmt.Errorf("%w: url scheme should be %s when TLS is enabled", ErrInvalidTLSConfig, amqpsScheme)
+	}
+
+	tlsConfig, err := tlsclient.CreateTLSClientConfig(cfg.TLS)
+	if err != nil {
+		return amqp.Config{}, err
+	}
+	if cfg.TLS.InsecureSkipVerify {
+		log.Warn("rabbitMQ TLS certificate verification is disabled")
+	}
+	amqpConfig.TLSClientConfig = tlsConfig
+
+	return amqpConfig, nil
+}
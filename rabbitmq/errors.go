@@ -10,3 +10,21 @@ var ErrInvalidRabbitMqExchangeName = errors.New("invalid rabbitmq exchange name"
 
 // ErrInvalidRabbitMqExchangeType signals that an empty rabbitmq exchange type has been provided
 var ErrInvalidRabbitMqExchangeType = errors.New("invalid rabbitmq exchange type")
+
+// ErrNilServiceBusClient signals that a nil service bus client has been provided
+var ErrNilServiceBusClient = errors.New("nil service bus client")
+
+// ErrInvalidValue signals that an invalid value has been provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrInvalidTLSConfig signals that an invalid TLS configuration has been provided
+var ErrInvalidTLSConfig = errors.New("invalid TLS config")
+
+// ErrServiceBusUnavailable signals that the last operation towards service bus failed
+var ErrServiceBusUnavailable = errors.New("service bus unavailable")
+
+// ErrRabbitMqDisconnected signals that the connection to rabbitMQ is not open
+var ErrRabbitMqDisconnected = errors.New("rabbitmq disconnected")
+
+// ErrPublisherNotResponding signals that the publisher loop did not respond in time
+var ErrPublisherNotResponding = errors.New("publisher loop not responding")
@@ -0,0 +1,27 @@
+package rabbitmq
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/streadway/amqp"
+)
+
+// SendWithRetry -
+func (sbc *serviceBusClient) SendWithRetry(ctx context.Context, topic string, handler func(sender *azservicebus.Sender) error) error {
+	return sbc.sendWithRetry(ctx, topic, handler)
+}
+
+// NumSenders -
+func (sbc *serviceBusClient) NumSenders() int {
+	sbc.mutSenders.Lock()
+	defer sbc.mutSenders.Unlock()
+
+	return len(sbc.senders)
+}
+
+// CreateAMQPConfig -
+func CreateAMQPConfig(cfg config.RabbitMQConfig) (amqp.Config, error) {
+	return createAMQPConfig(cfg)
+}
@@ -1,6 +1,9 @@
 package rabbitmq
 
 import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/streadway/amqp"
 )
@@ -13,10 +16,19 @@ type RabbitMqClient interface {
 	CloseErrChan() chan *amqp.Error
 	Reconnect()
 	ReopenChannel()
+	IsConnected() bool
 	Close()
 	IsInterfaceNil() bool
 }
 
+// ServiceBusClient defines the behaviour of an azure service bus client
+type ServiceBusClient interface {
+	SendMessages(ctx context.Context, topic string, messages []*azservicebus.Message) error
+	CheckHealth(ctx context.Context) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // PublisherService defines the behaviour of a publisher component which should be
 // able to publish received events and broadcast them to channels
 type PublisherService interface {
@@ -26,7 +38,18 @@ type PublisherService interface {
 	BroadcastFinalized(event data.FinalizedBlock)
 	BroadcastTxs(event data.BlockTxs)
 	BroadcastScrs(event data.BlockScrs)
+	BroadcastRewards(event data.BlockRewards)
+	BroadcastReceipts(event data.BlockReceipts)
+	BroadcastInvalidTxs(event data.BlockInvalidTxs)
+	BroadcastAlteredAccounts(event data.BlockAlteredAccounts)
+	BroadcastBlockHeader(event data.BlockHeader)
+	BroadcastEpochStart(event data.EpochStart)
+	BroadcastTxBundles(event data.BlockTxBundles)
+	BroadcastTxStatus(event data.TxStatus)
 	BroadcastBlockEventsWithOrder(event data.BlockEventsWithOrder)
+	BroadcastSourceStale(event data.SourceStaleEvent)
+	CheckHealth(ctx context.Context) error
+	Drain(ctx context.Context) error
 	Close() error
 	IsInterfaceNil() bool
 }
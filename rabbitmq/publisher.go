@@ -1,32 +1,50 @@
 package rabbitmq
 
 import (
-	"context"
-	"encoding/json"
-	"errors"
-
 	"bytes"
+	"context"
 	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/tracing"
 	"github.com/streadway/amqp"
 )
 
 const (
 	emptyStr = ""
+
+	hexPubKeyLength    = 32
+	receiverTopicIndex = 3
+
+	defaultServiceBusQueueSize = 1000
 )
 
 var log = logger.GetOrCreate("rabbitmq")
 
 // ArgsRabbitMqPublisher defines the arguments needed for rabbitmq publisher creation
 type ArgsRabbitMqPublisher struct {
-	Client RabbitMqClient
-	Config config.RabbitMQConfig
+	Client               RabbitMqClient
+	ServiceBus           ServiceBusClient
+	StatusMetricsHandler common.StatusMetricsHandler
+	Config               config.RabbitMQConfig
+}
+
+// serviceBusBatch holds the messages of a block waiting to be sent to service bus
+type serviceBusBatch struct {
+	blockHash    string
+	messages     []*azservicebus.Message
+	traceContext map[string]string
 }
 
 type rabbitMqPublisher struct {
@@ -39,11 +57,27 @@ type rabbitMqPublisher struct {
 	broadcastTxs                  chan data.BlockTxs
 	broadcastBlockEventsWithOrder chan data.BlockEventsWithOrder
 	broadcastScrs                 chan data.BlockScrs
-
-	azure      *azservicebus.Client
-	topic      string
-	cancelFunc func()
-	closeChan  chan struct{}
+	broadcastRewards              chan data.BlockRewards
+	broadcastReceipts             chan data.BlockReceipts
+	broadcastInvalidTxs           chan data.BlockInvalidTxs
+	broadcastAlteredAccounts      chan data.BlockAlteredAccounts
+	broadcastBlockHeader          chan data.BlockHeader
+	broadcastEpochStart           chan data.EpochStart
+	broadcastTxBundles            chan data.BlockTxBundles
+	broadcastTxStatus             chan data.TxStatus
+	broadcastSourceStale          chan data.SourceStaleEvent
+	checkHealth                   chan struct{}
+
+	serviceBus               ServiceBusClient
+	serviceBusQueue          chan serviceBusBatch
+	serviceBusDone           chan struct{}
+	numUndeliveredServiceBus uint32
+	metricsHandler           common.StatusMetricsHandler
+	hexPubKeyConverter       core.PubkeyConverter
+	cancelFunc               func()
+	serviceBusCancelFunc     func()
+	closeChan                chan struct{}
+	runDone                  chan struct{}
 }
 
 // NewRabbitMqPublisher creates a new rabbitMQ publisher instance
@@ -53,24 +87,42 @@ func NewRabbitMqPublisher(args ArgsRabbitMqPublisher) (*rabbitMqPublisher, error
 		return nil, err
 	}
 
-	client, err := azservicebus.NewClientFromConnectionString(args.Config.AzureCredentials, nil)
-
+	hexPubKeyConverter, err := pubkeyConverter.NewHexPubkeyConverter(hexPubKeyLength)
 	if err != nil {
 		return nil, err
 	}
 
+	serviceBusQueueSize := args.Config.ServiceBusQueueSize
+	if serviceBusQueueSize == 0 {
+		serviceBusQueueSize = defaultServiceBusQueueSize
+	}
+
 	rp := &rabbitMqPublisher{
 		broadcast:                     make(chan data.BlockEvents),
 		broadcastRevert:               make(chan data.RevertBlock),
 		broadcastFinalized:            make(chan data.FinalizedBlock),
 		broadcastTxs:                  make(chan data.BlockTxs),
 		broadcastScrs:                 make(chan data.BlockScrs),
+		broadcastRewards:              make(chan data.BlockRewards),
+		broadcastReceipts:             make(chan data.BlockReceipts),
+		broadcastInvalidTxs:           make(chan data.BlockInvalidTxs),
+		broadcastAlteredAccounts:      make(chan data.BlockAlteredAccounts),
+		broadcastBlockHeader:          make(chan data.BlockHeader),
+		broadcastEpochStart:           make(chan data.EpochStart),
+		broadcastTxBundles:            make(chan data.BlockTxBundles),
+		broadcastTxStatus:             make(chan data.TxStatus),
 		broadcastBlockEventsWithOrder: make(chan data.BlockEventsWithOrder),
+		broadcastSourceStale:          make(chan data.SourceStaleEvent),
+		checkHealth:                   make(chan struct{}),
 		cfg:                           args.Config,
 		client:                        args.Client,
 		closeChan:                     make(chan struct{}),
-		azure:                         client,
-		topic:                         args.Config.Topic,
+		runDone:                       make(chan struct{}),
+		serviceBus:                    args.ServiceBus,
+		serviceBusQueue:               make(chan serviceBusBatch, serviceBusQueueSize),
+		serviceBusDone:                make(chan struct{}),
+		metricsHandler:                args.StatusMetricsHandler,
+		hexPubKeyConverter:            hexPubKeyConverter,
 	}
 
 	// err = rp.createExchanges()
@@ -78,6 +130,11 @@ func NewRabbitMqPublisher(args ArgsRabbitMqPublisher) (*rabbitMqPublisher, error
 	// 	return nil, err
 	// }
 
+	err = rp.declareOptionalExchanges()
+	if err != nil {
+		return nil, err
+	}
+
 	return rp, nil
 }
 
@@ -85,6 +142,12 @@ func checkArgs(args ArgsRabbitMqPublisher) error {
 	if check.IfNil(args.Client) {
 		return ErrNilRabbitMqClient
 	}
+	if check.IfNil(args.ServiceBus) {
+		return ErrNilServiceBusClient
+	}
+	if check.IfNil(args.StatusMetricsHandler) {
+		return common.ErrNilStatusMetricsHandler
+	}
 
 	if args.Config.EventsExchange.Name == "" {
 		return ErrInvalidRabbitMqExchangeName
@@ -122,6 +185,46 @@ func checkArgs(args ArgsRabbitMqPublisher) error {
 	if args.Config.BlockEventsExchange.Type == "" {
 		return ErrInvalidRabbitMqExchangeType
 	}
+	for _, exchange := range getOptionalExchanges(args.Config) {
+		if exchange.Name != "" && exchange.Type == "" {
+			return ErrInvalidRabbitMqExchangeType
+		}
+	}
+
+	return nil
+}
+
+// getOptionalExchanges returns the exchanges which can be left out of the config, so that the
+// existing configs keep working. The events of an exchange without name are not published
+func getOptionalExchanges(cfg config.RabbitMQConfig) []config.RabbitMQExchangeConfig {
+	return []config.RabbitMQExchangeConfig{
+		cfg.BlockRewardsExchange,
+		cfg.BlockReceiptsExchange,
+		cfg.BlockInvalidTxsExchange,
+		cfg.AlteredAccountsExchange,
+		cfg.BlockHeaderExchange,
+		cfg.EpochStartExchange,
+		cfg.TxBundlesExchange,
+		cfg.TxStatusExchange,
+		cfg.SourceStaleExchange,
+	}
+}
+
+// declareOptionalExchanges declares the configured optional exchanges, since the messages are
+// published as mandatory and the broker closes the channel when publishing to an unknown exchange
+func (rp *rabbitMqPublisher) declareOptionalExchanges() error {
+	for _, exchange := range getOptionalExchanges(rp.cfg) {
+		if exchange.Name == "" {
+			continue
+		}
+
+		err := rp.client.ExchangeDeclare(exchange.Name, exchange.Type)
+		if err != nil {
+			return fmt.Errorf("%w while declaring exchange %s", err, exchange.Name)
+		}
+
+		log.Info("checked and declared rabbitMQ exchange", "name", exchange.Name, "type", exchange.Type)
+	}
 
 	return nil
 }
@@ -167,21 +270,29 @@ func checkArgs(args ArgsRabbitMqPublisher) error {
 // 	return nil
 // }
 
-// Run is launched as a goroutine and listens for events on the exposed channels
+// Run is launched as a goroutine and listens for events on the exposed channels. The service bus
+// messages are sent from a separate goroutine, so that a slow service bus does not block the exchanges
 func (rp *rabbitMqPublisher) Run() {
-	var ctx context.Context
+	var ctx, serviceBusCtx context.Context
 	ctx, rp.cancelFunc = context.WithCancel(context.Background())
+	serviceBusCtx, rp.serviceBusCancelFunc = context.WithCancel(context.Background())
 
 	go rp.run(ctx)
+	go rp.runServiceBus(serviceBusCtx)
 }
 
 func (rp *rabbitMqPublisher) run(ctx context.Context) {
+	defer close(rp.runDone)
 
 	for {
 		select {
 		case <-ctx.Done():
 			log.Debug("RabbitMQ publisher is stopping...")
+			rp.publishPending()
 			rp.client.Close()
+			// the service bus queue is written only by this loop, so it can be closed once the loop stops
+			close(rp.serviceBusQueue)
+			return
 		case events := <-rp.broadcast:
 			rp.publishToExchanges(events)
 		case revertBlock := <-rp.broadcastRevert:
@@ -192,8 +303,27 @@ func (rp *rabbitMqPublisher) run(ctx context.Context) {
 			rp.publishTxsToExchange(blockTxs)
 		case blockScrs := <-rp.broadcastScrs:
 			rp.publishScrsToExchange(blockScrs)
+		case blockRewards := <-rp.broadcastRewards:
+			rp.publishRewardsToExchange(blockRewards)
+		case blockReceipts := <-rp.broadcastReceipts:
+			rp.publishReceiptsToExchange(blockReceipts)
+		case blockInvalidTxs := <-rp.broadcastInvalidTxs:
+			rp.publishInvalidTxsToExchange(blockInvalidTxs)
+		case blockAlteredAccounts := <-rp.broadcastAlteredAccounts:
+			rp.publishAlteredAccountsToExchange(blockAlteredAccounts)
+		case blockHeader := <-rp.broadcastBlockHeader:
+			rp.publishBlockHeaderToExchange(blockHeader)
+		case epochStart := <-rp.broadcastEpochStart:
+			rp.publishEpochStartToExchange(epochStart)
+		case blockTxBundles := <-rp.broadcastTxBundles:
+			rp.publishTxBundlesToExchange(blockTxBundles)
+		case txStatus := <-rp.broadcastTxStatus:
+			rp.publishTxStatusToExchange(txStatus)
 		case blockEvents := <-rp.broadcastBlockEventsWithOrder:
-			rp.publishBlockEventsWithOrderToExchange(blockEvents)
+			rp.publishBlockEventsWithOrderToExchange(ctx, blockEvents)
+		case sourceStale := <-rp.broadcastSourceStale:
+			rp.publishSourceStaleToExchange(sourceStale)
+		case <-rp.checkHealth:
 		case err := <-rp.client.ConnErrChan():
 			if err != nil {
 				log.Error("rabbitMQ connection failure", "err", err.Error())
@@ -208,6 +338,47 @@ func (rp *rabbitMqPublisher) run(ctx context.Context) {
 	}
 }
 
+// publishPending publishes the events of the producers which are already waiting on the broadcast
+// channels, without waiting for new ones
+func (rp *rabbitMqPublisher) publishPending() {
+	for {
+		select {
+		case events := <-rp.broadcast:
+			rp.publishToExchanges(events)
+		case revertBlock := <-rp.broadcastRevert:
+			rp.publishRevertToExchange(revertBlock)
+		case finalizedBlock := <-rp.broadcastFinalized:
+			rp.publishFinalizedToExchange(finalizedBlock)
+		case blockTxs := <-rp.broadcastTxs:
+			rp.publishTxsToExchange(blockTxs)
+		case blockScrs := <-rp.broadcastScrs:
+			rp.publishScrsToExchange(blockScrs)
+		case blockRewards := <-rp.broadcastRewards:
+			rp.publishRewardsToExchange(blockRewards)
+		case blockReceipts := <-rp.broadcastReceipts:
+			rp.publishReceiptsToExchange(blockReceipts)
+		case blockInvalidTxs := <-rp.broadcastInvalidTxs:
+			rp.publishInvalidTxsToExchange(blockInvalidTxs)
+		case blockAlteredAccounts := <-rp.broadcastAlteredAccounts:
+			rp.publishAlteredAccountsToExchange(blockAlteredAccounts)
+		case blockHeader := <-rp.broadcastBlockHeader:
+			rp.publishBlockHeaderToExchange(blockHeader)
+		case epochStart := <-rp.broadcastEpochStart:
+			rp.publishEpochStartToExchange(epochStart)
+		case blockTxBundles := <-rp.broadcastTxBundles:
+			rp.publishTxBundlesToExchange(blockTxBundles)
+		case txStatus := <-rp.broadcastTxStatus:
+			rp.publishTxStatusToExchange(txStatus)
+		case blockEvents := <-rp.broadcastBlockEventsWithOrder:
+			rp.publishBlockEventsWithOrderToExchange(context.Background(), blockEvents)
+		case sourceStale := <-rp.broadcastSourceStale:
+			rp.publishSourceStaleToExchange(sourceStale)
+		default:
+			return
+		}
+	}
+}
+
 // Broadcast will handle the block events pushed by producers and sends them to rabbitMQ channel
 func (rp *rabbitMqPublisher) Broadcast(events data.BlockEvents) {
 	select {
@@ -248,6 +419,70 @@ func (rp *rabbitMqPublisher) BroadcastScrs(events data.BlockScrs) {
 	}
 }
 
+// BroadcastRewards will handle the rewards event pushed by producers and sends them to rabbitMQ channel
+func (rp *rabbitMqPublisher) BroadcastRewards(events data.BlockRewards) {
+	select {
+	case rp.broadcastRewards <- events:
+	case <-rp.closeChan:
+	}
+}
+
+// BroadcastReceipts will handle the receipts event pushed by producers and sends them to rabbitMQ channel
+func (rp *rabbitMqPublisher) BroadcastReceipts(events data.BlockReceipts) {
+	select {
+	case rp.broadcastReceipts <- events:
+	case <-rp.closeChan:
+	}
+}
+
+// BroadcastInvalidTxs will handle the invalid txs event pushed by producers and sends them to rabbitMQ channel
+func (rp *rabbitMqPublisher) BroadcastInvalidTxs(events data.BlockInvalidTxs) {
+	select {
+	case rp.broadcastInvalidTxs <- events:
+	case <-rp.closeChan:
+	}
+}
+
+// BroadcastAlteredAccounts will handle the altered accounts event pushed by producers and sends them to rabbitMQ channel
+func (rp *rabbitMqPublisher) BroadcastAlteredAccounts(events data.BlockAlteredAccounts) {
+	select {
+	case rp.broadcastAlteredAccounts <- events:
+	case <-rp.closeChan:
+	}
+}
+
+// BroadcastBlockHeader will handle the block header event pushed by producers and sends them to rabbitMQ channel
+func (rp *rabbitMqPublisher) BroadcastBlockHeader(events data.BlockHeader) {
+	select {
+	case rp.broadcastBlockHeader <- events:
+	case <-rp.closeChan:
+	}
+}
+
+// BroadcastEpochStart will handle the epoch start event pushed by producers and sends them to rabbitMQ channel
+func (rp *rabbitMqPublisher) BroadcastEpochStart(events data.EpochStart) {
+	select {
+	case rp.broadcastEpochStart <- events:
+	case <-rp.closeChan:
+	}
+}
+
+// BroadcastTxBundles will handle the tx bundles event pushed by producers and sends them to rabbitMQ channel
+func (rp *rabbitMqPublisher) BroadcastTxBundles(events data.BlockTxBundles) {
+	select {
+	case rp.broadcastTxBundles <- events:
+	case <-rp.closeChan:
+	}
+}
+
+// BroadcastTxStatus will handle the tx status event pushed by producers and sends them to rabbitMQ channel
+func (rp *rabbitMqPublisher) BroadcastTxStatus(events data.TxStatus) {
+	select {
+	case rp.broadcastTxStatus <- events:
+	case <-rp.closeChan:
+	}
+}
+
 // BroadcastBlockEventsWithOrder will handle the full block events pushed by producers and sends them to rabbitMQ channel
 func (rp *rabbitMqPublisher) BroadcastBlockEventsWithOrder(events data.BlockEventsWithOrder) {
 	select {
@@ -256,6 +491,31 @@ func (rp *rabbitMqPublisher) BroadcastBlockEventsWithOrder(events data.BlockEven
 	}
 }
 
+// BroadcastSourceStale will handle the source stale event and sends it to rabbitMQ channel
+func (rp *rabbitMqPublisher) BroadcastSourceStale(event data.SourceStaleEvent) {
+	select {
+	case rp.broadcastSourceStale <- event:
+	case <-rp.closeChan:
+	}
+}
+
+// CheckHealth returns an error if the rabbitMQ connection is not open or if the publishing loop
+// does not respond before the context is done
+func (rp *rabbitMqPublisher) CheckHealth(ctx context.Context) error {
+	if !rp.client.IsConnected() {
+		return ErrRabbitMqDisconnected
+	}
+
+	select {
+	case rp.checkHealth <- struct{}{}:
+		return nil
+	case <-rp.closeChan:
+		return ErrPublisherNotResponding
+	case <-ctx.Done():
+		return fmt.Errorf("%w: %s", ErrPublisherNotResponding, ctx.Err().Error())
+	}
+}
+
 func (rp *rabbitMqPublisher) publishToExchanges(events data.BlockEvents) {
 	eventsBytes, err := json.Marshal(events)
 	if err != nil {
@@ -263,7 +523,8 @@ func (rp *rabbitMqPublisher) publishToExchanges(events data.BlockEvents) {
 		return
 	}
 
-	err = rp.publishFanout(rp.cfg.EventsExchange.Name, eventsBytes)
+	ctx := tracing.ExtractContext(context.Background(), events.TraceContext)
+	err = rp.publishFanout(ctx, rp.cfg.EventsExchange.Name, events.Hash, eventsBytes)
 	if err != nil {
 		log.Error("failed to publish events to rabbitMQ", "err", err.Error())
 	}
@@ -276,7 +537,8 @@ func (rp *rabbitMqPublisher) publishRevertToExchange(revertBlock data.RevertBloc
 		return
 	}
 
-	err = rp.publishFanout(rp.cfg.RevertEventsExchange.Name, revertBlockBytes)
+	ctx := tracing.ExtractContext(context.Background(), revertBlock.TraceContext)
+	err = rp.publishFanout(ctx, rp.cfg.RevertEventsExchange.Name, revertBlock.Hash, revertBlockBytes)
 	if err != nil {
 		log.Error("failed to publish revert event to rabbitMQ", "err", err.Error())
 	}
@@ -289,7 +551,8 @@ func (rp *rabbitMqPublisher) publishFinalizedToExchange(finalizedBlock data.Fina
 		return
 	}
 
-	err = rp.publishFanout(rp.cfg.FinalizedEventsExchange.Name, finalizedBlockBytes)
+	ctx := tracing.ExtractContext(context.Background(), finalizedBlock.TraceContext)
+	err = rp.publishFanout(ctx, rp.cfg.FinalizedEventsExchange.Name, finalizedBlock.Hash, finalizedBlockBytes)
 	if err != nil {
 		log.Error("failed to publish finalized event to rabbitMQ", "err", err.Error())
 	}
@@ -302,7 +565,8 @@ func (rp *rabbitMqPublisher) publishTxsToExchange(blockTxs data.BlockTxs) {
 		return
 	}
 
-	err = rp.publishFanout(rp.cfg.BlockTxsExchange.Name, txsBlockBytes)
+	ctx := tracing.ExtractContext(context.Background(), blockTxs.TraceContext)
+	err = rp.publishFanout(ctx, rp.cfg.BlockTxsExchange.Name, blockTxs.Hash, txsBlockBytes)
 	if err != nil {
 		log.Error("failed to publish block txs event to rabbitMQ", "err", err.Error())
 	}
@@ -315,142 +579,381 @@ func (rp *rabbitMqPublisher) publishScrsToExchange(blockScrs data.BlockScrs) {
 		return
 	}
 
-	err = rp.publishFanout(rp.cfg.BlockScrsExchange.Name, scrsBlockBytes)
+	ctx := tracing.ExtractContext(context.Background(), blockScrs.TraceContext)
+	err = rp.publishFanout(ctx, rp.cfg.BlockScrsExchange.Name, blockScrs.Hash, scrsBlockBytes)
 	if err != nil {
 		log.Error("failed to publish block scrs event to rabbitMQ", "err", err.Error())
 	}
 }
 
-func (rp *rabbitMqPublisher) publishBlockEventsWithOrderToExchange(blockTxs data.BlockEventsWithOrder) {
+func (rp *rabbitMqPublisher) publishRewardsToExchange(blockRewards data.BlockRewards) {
+	if rp.cfg.BlockRewardsExchange.Name == "" {
+		return
+	}
+
+	blockRewardsBytes, err := json.Marshal(blockRewards)
+	if err != nil {
+		log.Error("could not marshal block rewards event", "err", err.Error())
+		return
+	}
+
+	ctx := tracing.ExtractContext(context.Background(), blockRewards.TraceContext)
+	err = rp.publishFanout(ctx, rp.cfg.BlockRewardsExchange.Name, blockRewards.Hash, blockRewardsBytes)
+	if err != nil {
+		log.Error("failed to publish block rewards event to rabbitMQ", "err", err.Error())
+	}
+}
+
+func (rp *rabbitMqPublisher) publishReceiptsToExchange(blockReceipts data.BlockReceipts) {
+	if rp.cfg.BlockReceiptsExchange.Name == "" {
+		return
+	}
+
+	blockReceiptsBytes, err := json.Marshal(blockReceipts)
+	if err != nil {
+		log.Error("could not marshal block receipts event", "err", err.Error())
+		return
+	}
+
+	ctx := tracing.ExtractContext(context.Background(), blockReceipts.TraceContext)
+	err = rp.publishFanout(ctx, rp.cfg.BlockReceiptsExchange.Name, blockReceipts.Hash, blockReceiptsBytes)
+	if err != nil {
+		log.Error("failed to publish block receipts event to rabbitMQ", "err", err.Error())
+	}
+}
+
+func (rp *rabbitMqPublisher) publishInvalidTxsToExchange(blockInvalidTxs data.BlockInvalidTxs) {
+	if rp.cfg.BlockInvalidTxsExchange.Name == "" {
+		return
+	}
+
+	blockInvalidTxsBytes, err := json.Marshal(blockInvalidTxs)
+	if err != nil {
+		log.Error("could not marshal block invalid txs event", "err", err.Error())
+		return
+	}
+
+	ctx := tracing.ExtractContext(context.Background(), blockInvalidTxs.TraceContext)
+	err = rp.publishFanout(ctx, rp.cfg.BlockInvalidTxsExchange.Name, blockInvalidTxs.Hash, blockInvalidTxsBytes)
+	if err != nil {
+		log.Error("failed to publish block invalid txs event to rabbitMQ", "err", err.Error())
+	}
+}
+
+func (rp *rabbitMqPublisher) publishAlteredAccountsToExchange(blockAlteredAccounts data.BlockAlteredAccounts) {
+	if rp.cfg.AlteredAccountsExchange.Name == "" {
+		return
+	}
+
+	blockAlteredAccountsBytes, err := json.Marshal(blockAlteredAccounts)
+	if err != nil {
+		log.Error("could not marshal block altered accounts event", "err", err.Error())
+		return
+	}
+
+	ctx := tracing.ExtractContext(context.Background(), blockAlteredAccounts.TraceContext)
+	err = rp.publishFanout(ctx, rp.cfg.AlteredAccountsExchange.Name, blockAlteredAccounts.Hash, blockAlteredAccountsBytes)
+	if err != nil {
+		log.Error("failed to publish block altered accounts event to rabbitMQ", "err", err.Error())
+	}
+}
+
+func (rp *rabbitMqPublisher) publishBlockHeaderToExchange(blockHeader data.BlockHeader) {
+	if rp.cfg.BlockHeaderExchange.Name == "" {
+		return
+	}
+
+	blockHeaderBytes, err := json.Marshal(blockHeader)
+	if err != nil {
+		log.Error("could not marshal block header event", "err", err.Error())
+		return
+	}
+
+	ctx := tracing.ExtractContext(context.Background(), blockHeader.TraceContext)
+	err = rp.publishFanout(ctx, rp.cfg.BlockHeaderExchange.Name, blockHeader.Hash, blockHeaderBytes)
+	if err != nil {
+		log.Error("failed to publish block header event to rabbitMQ", "err", err.Error())
+	}
+}
+
+func (rp *rabbitMqPublisher) publishEpochStartToExchange(epochStart data.EpochStart) {
+	if rp.cfg.EpochStartExchange.Name == "" {
+		return
+	}
+
+	epochStartBytes, err := json.Marshal(epochStart)
+	if err != nil {
+		log.Error("could not marshal epoch start event", "err", err.Error())
+		return
+	}
+
+	ctx := tracing.ExtractContext(context.Background(), epochStart.TraceContext)
+	err = rp.publishFanout(ctx, rp.cfg.EpochStartExchange.Name, epochStart.Hash, epochStartBytes)
+	if err != nil {
+		log.Error("failed to publish epoch start event to rabbitMQ", "err", err.Error())
+	}
+}
+
+func (rp *rabbitMqPublisher) publishTxBundlesToExchange(blockTxBundles data.BlockTxBundles) {
+	if rp.cfg.TxBundlesExchange.Name == "" {
+		return
+	}
+
+	blockTxBundlesBytes, err := json.Marshal(blockTxBundles)
+	if err != nil {
+		log.Error("could not marshal tx bundles event", "err", err.Error())
+		return
+	}
+
+	ctx := tracing.ExtractContext(context.Background(), blockTxBundles.TraceContext)
+	err = rp.publishFanout(ctx, rp.cfg.TxBundlesExchange.Name, blockTxBundles.Hash, blockTxBundlesBytes)
+	if err != nil {
+		log.Error("failed to publish tx bundles event to rabbitMQ", "err", err.Error())
+	}
+}
+
+func (rp *rabbitMqPublisher) publishTxStatusToExchange(txStatus data.TxStatus) {
+	if rp.cfg.TxStatusExchange.Name == "" {
+		return
+	}
+
+	txStatusBytes, err := json.Marshal(txStatus)
+	if err != nil {
+		log.Error("could not marshal tx status event", "err", err.Error())
+		return
+	}
+
+	ctx := tracing.ExtractContext(context.Background(), txStatus.TraceContext)
+	err = rp.publishFanout(ctx, rp.cfg.TxStatusExchange.Name, txStatus.TxHash, txStatusBytes)
+	if err != nil {
+		log.Error("failed to publish tx status event to rabbitMQ", "err", err.Error())
+	}
+}
+
+func (rp *rabbitMqPublisher) publishSourceStaleToExchange(event data.SourceStaleEvent) {
+	if rp.cfg.SourceStaleExchange.Name == "" {
+		return
+	}
+
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		log.Error("could not marshal source stale event", "err", err.Error())
+		return
+	}
+
+	err = rp.publishFanout(context.Background(), rp.cfg.SourceStaleExchange.Name, "", eventBytes)
+	if err != nil {
+		log.Error("failed to publish source stale event to rabbitMQ", "err", err.Error())
+	}
+}
+
+func (rp *rabbitMqPublisher) publishBlockEventsWithOrderToExchange(ctx context.Context, blockTxs data.BlockEventsWithOrder) {
 	txsBlockBytes, err := json.Marshal(blockTxs)
 	if err != nil {
 		log.Error("could not marshal block txs event", "err", err.Error())
 		return
 	}
 
-	err = rp.publishFanout(rp.cfg.BlockEventsExchange.Name, txsBlockBytes)
+	ctx = tracing.ExtractContext(ctx, blockTxs.TraceContext)
+	rp.publishToServiceBus(blockTxs)
+
+	err = rp.publishFanout(ctx, rp.cfg.BlockEventsExchange.Name, blockTxs.Hash, txsBlockBytes)
 	if err != nil {
 		log.Error("failed to publish full block events to rabbitMQ", "err", err.Error())
 	}
 }
 
-func (rp *rabbitMqPublisher) publishFanout(exchangeName string, payload []byte) error {
+// publishToServiceBus queues the service bus messages of the block. If the queue is full, the block
+// is dropped and reported as undelivered, instead of blocking the exchanges
+func (rp *rabbitMqPublisher) publishToServiceBus(events data.BlockEventsWithOrder) {
+	messages := rp.createServiceBusMessages(events)
+	if len(messages) == 0 {
+		return
+	}
 
-	if exchangeName == rp.cfg.BlockEventsExchange.Name {
-		sender, err := rp.azure.NewSender(rp.cfg.Topic, nil)
-		if err != nil {
-			log.Error("could not send the payload to azure service bus", "err", err.Error())
-			return err
-		}
-		var events data.BlockEventsWithOrder
-		err = json.Unmarshal(payload, &events)
-		if err != nil {
-			log.Error("Error unmarshalling JSON data for service bus:", err)
+	batch := serviceBusBatch{
+		blockHash:    events.Hash,
+		messages:     messages,
+		traceContext: events.TraceContext,
+	}
+
+	select {
+	case rp.serviceBusQueue <- batch:
+	default:
+		atomic.AddUint32(&rp.numUndeliveredServiceBus, 1)
+		rp.metricsHandler.AddStageError(common.ServiceBusSink)
+		log.Error("service bus queue is full, dropping block events",
+			"block hash", events.Hash,
+			"num messages", len(messages),
+		)
+	}
+}
+
+func (rp *rabbitMqPublisher) runServiceBus(ctx context.Context) {
+	defer close(rp.serviceBusDone)
+
+	for batch := range rp.serviceBusQueue {
+		rp.sendServiceBusBatch(ctx, batch)
+	}
+
+	log.Debug("service bus publisher is stopping...")
+}
+
+func (rp *rabbitMqPublisher) sendServiceBusBatch(ctx context.Context, batch serviceBusBatch) {
+	ctx = tracing.ExtractContext(ctx, batch.traceContext)
+	ctx, span := tracing.StartSpan(ctx, "serviceBus.SendMessages", batch.blockHash)
+	defer span.End()
+
+	for key, value := range tracing.InjectContext(ctx) {
+		for _, msg := range batch.messages {
+			msg.ApplicationProperties[key] = value
 		}
-		currentMessageBatch, err := sender.NewMessageBatch(context.Background(), nil)
+	}
 
-		if err != nil {
-			log.Error("error creating message batch for service bus:", err)
+	err := rp.serviceBus.SendMessages(ctx, rp.cfg.Topic, batch.messages)
+	if err != nil {
+		tracing.RecordError(span, err)
+		atomic.AddUint32(&rp.numUndeliveredServiceBus, 1)
+		log.Error("failed to publish block events to service bus",
+			"block hash", batch.blockHash,
+			"num messages", len(batch.messages),
+			"err", err.Error(),
+		)
+	}
+}
+
+func (rp *rabbitMqPublisher) createServiceBusMessages(events data.BlockEventsWithOrder) []*azservicebus.Message {
+	messages := make([]*azservicebus.Message, 0, len(events.Events))
+	for _, event := range events.Events {
+		identifier := event.Identifier
+		sessionId := event.Address
+		isNFT := "true"
+		if identifier == "completedTxEvent" || identifier == "signalError" || identifier == "internalVMErrors" || identifier == "writeLog" {
+			continue
 		}
-		for i := 0; i < len(events.Events); i++ {
-			identifier := events.Events[i].Identifier
-			sessionId := events.Events[i].Address
-			isNFT := "true"
-			if identifier == "completedTxEvent" || identifier == "signalError" || identifier == "internalVMErrors" || identifier == "writeLog" {
-				continue
-			}
 
-			if identifier == "MultiESDTNFTTransfer" || identifier == "ESDTNFTTransfer" || identifier == "ESDTTransfer" {
-				pubKeyConverter, err := pubkeyConverter.NewHexPubkeyConverter(32)
+		if identifier == "MultiESDTNFTTransfer" || identifier == "ESDTNFTTransfer" || identifier == "ESDTTransfer" {
+			if len(event.Topics) > receiverTopicIndex {
+				receiverShard, err := rp.getShardOfReceiver(event.Topics[receiverTopicIndex])
 				if err != nil {
-					return err
-				}
-				reciver := pubKeyConverter.Encode(events.Events[i].Topics[3])
-				receiverShard := getShardOfAddress(reciver)
-
-				if receiverShard != events.Events[i].LogAddressShard {
+					log.Warn("skipped service bus event with invalid receiver",
+						"identifier", identifier,
+						"block hash", events.Hash,
+						"event index", event.EventIndex,
+						"err", err.Error(),
+					)
 					continue
 				}
-			}
 
-			if identifier == "ESDTNFTCreate" || identifier == "ESDTNFTBurn" || identifier == "ESDTNFTUpdateAttributes" || identifier == "ESDTNFTAddURI" || identifier == "ESDTNFTAddQuantity" || identifier == "MultiESDTNFTTransfer" || identifier == "ESDTNFTTransfer" || identifier == "ESDTTransfer" {
-				hexStr := hex.EncodeToString(events.Events[i].Topics[1])
-				if hexStr == ""  {
-					isNFT = "false"
+				if receiverShard != event.LogAddressShard {
+					continue
 				}
-				sessionId = string(events.Events[i].Topics[0])
 			}
+		}
 
-			event, err := json.Marshal(events.Events[i])
-			if err != nil {
-				log.Error("Error marshalling JSON data for service bus:", err)
+		if identifier == "ESDTNFTCreate" || identifier == "ESDTNFTBurn" || identifier == "ESDTNFTUpdateAttributes" || identifier == "ESDTNFTAddURI" || identifier == "ESDTNFTAddQuantity" || identifier == "MultiESDTNFTTransfer" || identifier == "ESDTNFTTransfer" || identifier == "ESDTTransfer" {
+			if len(event.Topics) < 2 || len(event.Topics[1]) == 0 {
+				isNFT = "false"
 			}
-
-			msg := &azservicebus.Message{
-				Body:                  event,
-				SessionID:             &sessionId,
-				ApplicationProperties: make(map[string]interface{})}
-			msg.ApplicationProperties["Address"] = events.Events[i].Address
-
-			if identifier == "MultiESDTNFTTransfer" {
-				msg.ApplicationProperties["isNFT"] = isNFT
+			if len(event.Topics) > 0 {
+				sessionId = string(event.Topics[0])
 			}
+		}
 
-			msg.ApplicationProperties["Identifier"] = events.Events[i].Identifier
-			err = currentMessageBatch.AddMessage(msg, nil)
-
-			if errors.Is(err, azservicebus.ErrMessageTooLarge) {
-				if currentMessageBatch.NumMessages() == 0 {
-					log.Error("Single message is too large to be sent in a batch.")
-					return err
-				}
-
-				log.Info("Message batch is full. Sending it and creating a new one.")
-
-				// send what we have since the batch is full
-				err := sender.SendMessageBatch(context.Background(), currentMessageBatch, nil)
+		eventBytes, err := json.Marshal(event)
+		if err != nil {
+			log.Error("could not marshal event for service bus", "err", err.Error())
+			continue
+		}
 
-				if err != nil {
-					log.Error("Error sending the batch of messages", err)
-					return err
-				}
+		messageID := getServiceBusMessageID(events.Hash, event.EventIndex)
+		msg := &azservicebus.Message{
+			Body:                  eventBytes,
+			MessageID:             &messageID,
+			SessionID:             &sessionId,
+			ApplicationProperties: make(map[string]interface{}),
+		}
+		msg.ApplicationProperties["Address"] = event.Address
+		if identifier == "MultiESDTNFTTransfer" {
+			msg.ApplicationProperties["isNFT"] = isNFT
+		}
+		msg.ApplicationProperties["Identifier"] = event.Identifier
+		msg.ApplicationProperties["ExecutionOrder"] = event.ExecutionOrder
+		msg.ApplicationProperties["EventIndex"] = event.EventIndex
 
-				// Create a new batch and retry adding this message to our batch.
-				newBatch, err := sender.NewMessageBatch(context.Background(), nil)
+		messages = append(messages, msg)
+	}
 
-				if err != nil {
-					log.Error("Error creating a new batch of messages", err)
-				}
+	return messages
+}
 
-				currentMessageBatch = newBatch
+// getServiceBusMessageID returns a message ID which is stable across retries and replicas,
+// so that service bus duplicate detection drops re-sent messages. The event index is the position
+// of the event in the block, so the ID does not depend on which events are filtered out
+func getServiceBusMessageID(blockHash string, eventIndex int) string {
+	return fmt.Sprintf("%s-%d", blockHash, eventIndex)
+}
 
-				// rewind the counter and attempt to add the message again (this batch
-				// was full so it didn't go out with the previous SendMessageBatch call).
-				i--
-			} else if err != nil {
-				log.Error("Error adding message to batch", currentMessageBatch.NumMessages(), err)
-			}
-		}
-		// check if any messages are remaining to be sent.
-		if currentMessageBatch.NumMessages() > 0 {
-			err := sender.SendMessageBatch(context.Background(), currentMessageBatch, nil)
+func (rp *rabbitMqPublisher) publishFanout(ctx context.Context, exchangeName string, blockHash string, payload []byte) error {
+	ctx, span := tracing.StartSpan(ctx, "rabbitmq.Publish", blockHash)
+	defer span.End()
 
-			if err != nil {
-				log.Error("Error send remaining messages in batch", err)
-			}
+	msg := amqp.Publishing{
+		Body: payload,
+	}
+	traceContext := tracing.InjectContext(ctx)
+	if len(traceContext) > 0 {
+		msg.Headers = make(amqp.Table, len(traceContext))
+		for key, value := range traceContext {
+			msg.Headers[key] = value
 		}
-		sender.Close(context.Background())
 	}
 
-	return rp.client.Publish(
+	startTime := time.Now()
+	err := rp.client.Publish(
 		exchangeName,
 		emptyStr,
 		true,  // mandatory
 		false, // immediate
-		amqp.Publishing{
-			Body: payload,
-		},
+		msg,
 	)
+	rp.metricsHandler.ObservePublish(common.RabbitMQSink, time.Since(startTime))
+	if err != nil {
+		tracing.RecordError(span, err)
+		rp.metricsHandler.AddStageError(common.RabbitMQSink)
+	}
+
+	return err
+}
+
+// Drain stops the publishing loop after the pending events are published, then waits for the queued
+// service bus messages to be sent. An error is returned if the context is done before, or if any block
+// could not be delivered to service bus
+func (rp *rabbitMqPublisher) Drain(ctx context.Context) error {
+	if rp.cancelFunc == nil {
+		return nil
+	}
+
+	rp.cancelFunc()
+
+	select {
+	case <-rp.runDone:
+	case <-ctx.Done():
+		return fmt.Errorf("%w: rabbitMQ publisher did not finish publishing: %s", common.ErrUndeliveredData, ctx.Err().Error())
+	}
+
+	select {
+	case <-rp.serviceBusDone:
+	case <-ctx.Done():
+		return fmt.Errorf("%w: %d blocks not sent to service bus: %s", common.ErrUndeliveredData, len(rp.serviceBusQueue), ctx.Err().Error())
+	}
+
+	numUndelivered := atomic.LoadUint32(&rp.numUndeliveredServiceBus)
+	if numUndelivered > 0 {
+		return fmt.Errorf("%w: %d blocks not delivered to service bus", common.ErrUndeliveredData, numUndelivered)
+	}
+
+	return nil
 }
 
 // Close will close the channels
@@ -458,10 +961,14 @@ func (rp *rabbitMqPublisher) Close() error {
 	if rp.cancelFunc != nil {
 		rp.cancelFunc()
 	}
+	if rp.serviceBusCancelFunc != nil {
+		rp.serviceBusCancelFunc()
+		<-rp.serviceBusDone
+	}
 
 	close(rp.closeChan)
 
-	return nil
+	return rp.serviceBus.Close()
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -481,21 +988,40 @@ func isAddressOfMetachain(pubKey []byte) bool {
 	return bytes.Equal(pubKey, zeroAddress)
 }
 
-func getShardOfAddress(hexPubKey string) int {
+// getShardOfReceiver returns the shard of the receiver topic of a transfer event
+func (rp *rabbitMqPublisher) getShardOfReceiver(receiverTopic []byte) (int, error) {
+	receiver, err := rp.hexPubKeyConverter.Encode(receiverTopic)
+	if err != nil {
+		return 0, err
+	}
+
+	return getShardOfAddress(receiver)
+}
+
+// getShardOfAddress returns an error if the address is not a hex encoded public key of
+// the expected length, since the topics of the events are set by the contracts
+func getShardOfAddress(hexPubKey string) (int, error) {
 	numShards := 3
 	maskHigh := 0b11
 	maskLow := 0b01
 
-	pubKey, _ := hex.DecodeString(hexPubKey)
-	lastByteOfPubKey := pubKey[31]
+	pubKey, err := hex.DecodeString(hexPubKey)
+	if err != nil {
+		return 0, err
+	}
+	if len(pubKey) != hexPubKeyLength {
+		return 0, fmt.Errorf("%w for address length: %d", ErrInvalidValue, len(pubKey))
+	}
+
+	lastByteOfPubKey := pubKey[hexPubKeyLength-1]
 
 	if isAddressOfMetachain(pubKey) {
-		return METACHAIN_SHARD_ID
+		return METACHAIN_SHARD_ID, nil
 	}
 
 	shard := int(lastByteOfPubKey) & maskHigh
 	if shard > numShards-1 {
 		shard = int(lastByteOfPubKey) & maskLow
 	}
-	return shard
+	return shard, nil
 }
@@ -1,17 +1,21 @@
 package rabbitmq_test
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
 	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/multiversx/mx-chain-notifier-go/data"
 	"github.com/multiversx/mx-chain-notifier-go/mocks"
 	"github.com/multiversx/mx-chain-notifier-go/rabbitmq"
+	"github.com/multiversx/mx-chain-notifier-go/tracing"
 	"github.com/streadway/amqp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -19,7 +23,9 @@ import (
 
 func createMockArgsRabbitMqPublisher() rabbitmq.ArgsRabbitMqPublisher {
 	return rabbitmq.ArgsRabbitMqPublisher{
-		Client: &mocks.RabbitClientStub{},
+		Client:               &mocks.RabbitClientStub{},
+		ServiceBus:           &mocks.ServiceBusClientStub{},
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
 		Config: config.RabbitMQConfig{
 			EventsExchange: config.RabbitMQExchangeConfig{
 				Name: "allevents",
@@ -41,10 +47,46 @@ func createMockArgsRabbitMqPublisher() rabbitmq.ArgsRabbitMqPublisher {
 				Name: "blockscrs",
 				Type: "fanout",
 			},
+			BlockRewardsExchange: config.RabbitMQExchangeConfig{
+				Name: "blockrewards",
+				Type: "fanout",
+			},
+			BlockReceiptsExchange: config.RabbitMQExchangeConfig{
+				Name: "blockreceipts",
+				Type: "fanout",
+			},
+			BlockInvalidTxsExchange: config.RabbitMQExchangeConfig{
+				Name: "blockinvalidtxs",
+				Type: "fanout",
+			},
+			AlteredAccountsExchange: config.RabbitMQExchangeConfig{
+				Name: "alteredaccounts",
+				Type: "fanout",
+			},
+			BlockHeaderExchange: config.RabbitMQExchangeConfig{
+				Name: "blockheader",
+				Type: "fanout",
+			},
+			EpochStartExchange: config.RabbitMQExchangeConfig{
+				Name: "epochstart",
+				Type: "fanout",
+			},
+			TxBundlesExchange: config.RabbitMQExchangeConfig{
+				Name: "txbundles",
+				Type: "fanout",
+			},
+			TxStatusExchange: config.RabbitMQExchangeConfig{
+				Name: "txstatus",
+				Type: "fanout",
+			},
 			BlockEventsExchange: config.RabbitMQExchangeConfig{
 				Name: "blockeventswithorder",
 				Type: "fanout",
 			},
+			SourceStaleExchange: config.RabbitMQExchangeConfig{
+				Name: "sourcestale",
+				Type: "fanout",
+			},
 		},
 	}
 }
@@ -63,6 +105,28 @@ func TestRabbitMqPublisher(t *testing.T) {
 		require.Equal(t, rabbitmq.ErrNilRabbitMqClient, err)
 	})
 
+	t.Run("nil service bus client", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.ServiceBus = nil
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.True(t, check.IfNil(client))
+		require.Equal(t, rabbitmq.ErrNilServiceBusClient, err)
+	})
+
+	t.Run("nil status metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.StatusMetricsHandler = nil
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.True(t, check.IfNil(client))
+		require.Equal(t, common.ErrNilStatusMetricsHandler, err)
+	})
+
 	t.Run("invalid events exchange name", func(t *testing.T) {
 		t.Parallel()
 
@@ -118,6 +182,151 @@ func TestRabbitMqPublisher(t *testing.T) {
 		require.True(t, errors.Is(err, rabbitmq.ErrInvalidRabbitMqExchangeName))
 	})
 
+	t.Run("empty rewards exchange name should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.Config.BlockRewardsExchange.Name = ""
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(client))
+	})
+
+	t.Run("empty receipts exchange name should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.Config.BlockReceiptsExchange.Name = ""
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(client))
+	})
+
+	t.Run("empty invalid txs exchange name should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.Config.BlockInvalidTxsExchange.Name = ""
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(client))
+	})
+
+	t.Run("empty altered accounts exchange name should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.Config.AlteredAccountsExchange.Name = ""
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(client))
+	})
+
+	t.Run("empty block header exchange name should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.Config.BlockHeaderExchange.Name = ""
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(client))
+	})
+
+	t.Run("empty epoch start exchange name should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.Config.EpochStartExchange.Name = ""
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(client))
+	})
+
+	t.Run("empty tx bundles exchange name should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.Config.TxBundlesExchange.Name = ""
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(client))
+	})
+
+	t.Run("empty tx status exchange name should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.Config.TxStatusExchange.Name = ""
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(client))
+	})
+
+	t.Run("empty source stale exchange name should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.Config.SourceStaleExchange.Name = ""
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(client))
+	})
+
+	t.Run("invalid optional exchange type", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.Config.SourceStaleExchange.Type = ""
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.True(t, check.IfNil(client))
+		require.True(t, errors.Is(err, rabbitmq.ErrInvalidRabbitMqExchangeType))
+	})
+
+	t.Run("optional exchange declare error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsRabbitMqPublisher()
+		args.Client = &mocks.RabbitClientStub{
+			ExchangeDeclareCalled: func(name, kind string) error {
+				return expectedErr
+			},
+		}
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.True(t, check.IfNil(client))
+		require.True(t, errors.Is(err, expectedErr))
+	})
+
+	t.Run("should declare the configured optional exchanges", func(t *testing.T) {
+		t.Parallel()
+
+		declaredExchanges := make([]string, 0)
+		args := createMockArgsRabbitMqPublisher()
+		args.Client = &mocks.RabbitClientStub{
+			ExchangeDeclareCalled: func(name, kind string) error {
+				assert.Equal(t, "fanout", kind)
+				declaredExchanges = append(declaredExchanges, name)
+				return nil
+			},
+		}
+
+		client, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+		require.False(t, check.IfNil(client))
+		require.Equal(t, []string{"blockrewards", "blockreceipts", "blockinvalidtxs", "alteredaccounts", "blockheader", "epochstart", "txbundles", "txstatus", "sourcestale"}, declaredExchanges)
+	})
+
 	t.Run("invalid exchange type", func(t *testing.T) {
 		t.Parallel()
 
@@ -321,14 +530,17 @@ func TestBroadcastScrs(t *testing.T) {
 	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
 }
 
-func TestBroadcastBlockEventsWithOrder(t *testing.T) {
+func TestBroadcastRewards(t *testing.T) {
 	t.Parallel()
 
+	wg := sync.WaitGroup{}
 	numCalls := uint32(0)
 
 	client := &mocks.RabbitClientStub{
 		PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+			assert.Equal(t, "blockrewards", exchange)
 			atomic.AddUint32(&numCalls, 1)
+			wg.Done()
 			return nil
 		},
 	}
@@ -341,24 +553,781 @@ func TestBroadcastBlockEventsWithOrder(t *testing.T) {
 
 	rabbitmq.Run()
 	defer rabbitmq.Close()
+	wg.Add(1)
 
-	rabbitmq.BroadcastBlockEventsWithOrder(data.BlockEventsWithOrder{})
+	rabbitmq.BroadcastRewards(data.BlockRewards{})
 
-	time.Sleep(time.Millisecond * 200)
+	wg.Wait()
 
 	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
 }
+func TestBroadcastReceipts(t *testing.T) {
+	t.Parallel()
 
-func TestClose(t *testing.T) {
+	wg := sync.WaitGroup{}
+	numCalls := uint32(0)
+
+	client := &mocks.RabbitClientStub{
+		PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+			assert.Equal(t, "blockreceipts", exchange)
+			atomic.AddUint32(&numCalls, 1)
+			wg.Done()
+			return nil
+		},
+	}
+
+	args := createMockArgsRabbitMqPublisher()
+	args.Client = client
+
+	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+	require.Nil(t, err)
+
+	rabbitmq.Run()
+	defer rabbitmq.Close()
+	wg.Add(1)
+
+	rabbitmq.BroadcastReceipts(data.BlockReceipts{})
+
+	wg.Wait()
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+func TestBroadcastInvalidTxs(t *testing.T) {
 	t.Parallel()
 
+	wg := sync.WaitGroup{}
+	numCalls := uint32(0)
+
+	client := &mocks.RabbitClientStub{
+		PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+			assert.Equal(t, "blockinvalidtxs", exchange)
+			atomic.AddUint32(&numCalls, 1)
+			wg.Done()
+			return nil
+		},
+	}
+
 	args := createMockArgsRabbitMqPublisher()
+	args.Client = client
 
 	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
 	require.Nil(t, err)
 
 	rabbitmq.Run()
+	defer rabbitmq.Close()
+	wg.Add(1)
 
-	err = rabbitmq.Close()
+	rabbitmq.BroadcastInvalidTxs(data.BlockInvalidTxs{})
+
+	wg.Wait()
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestBroadcastAlteredAccounts(t *testing.T) {
+	t.Parallel()
+
+	wg := sync.WaitGroup{}
+	numCalls := uint32(0)
+
+	client := &mocks.RabbitClientStub{
+		PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+			assert.Equal(t, "alteredaccounts", exchange)
+			atomic.AddUint32(&numCalls, 1)
+			wg.Done()
+			return nil
+		},
+	}
+
+	args := createMockArgsRabbitMqPublisher()
+	args.Client = client
+
+	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+	require.Nil(t, err)
+
+	rabbitmq.Run()
+	defer rabbitmq.Close()
+	wg.Add(1)
+
+	rabbitmq.BroadcastAlteredAccounts(data.BlockAlteredAccounts{})
+
+	wg.Wait()
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestBroadcastTxBundles(t *testing.T) {
+	t.Parallel()
+
+	wg := sync.WaitGroup{}
+	numCalls := uint32(0)
+
+	client := &mocks.RabbitClientStub{
+		PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+			assert.Equal(t, "txbundles", exchange)
+			atomic.AddUint32(&numCalls, 1)
+			wg.Done()
+			return nil
+		},
+	}
+
+	args := createMockArgsRabbitMqPublisher()
+	args.Client = client
+
+	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
 	require.Nil(t, err)
+
+	rabbitmq.Run()
+	defer rabbitmq.Close()
+	wg.Add(1)
+
+	rabbitmq.BroadcastTxBundles(data.BlockTxBundles{})
+
+	wg.Wait()
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestBroadcastTxStatus(t *testing.T) {
+	t.Parallel()
+
+	wg := sync.WaitGroup{}
+	numCalls := uint32(0)
+
+	client := &mocks.RabbitClientStub{
+		PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+			assert.Equal(t, "txstatus", exchange)
+			assert.Contains(t, string(msg.Body), `"txHash":"txHash1","sender":"","receiver":"","status":"timeout"`)
+			atomic.AddUint32(&numCalls, 1)
+			wg.Done()
+			return nil
+		},
+	}
+
+	args := createMockArgsRabbitMqPublisher()
+	args.Client = client
+
+	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+	require.Nil(t, err)
+
+	rabbitmq.Run()
+	defer rabbitmq.Close()
+	wg.Add(1)
+
+	rabbitmq.BroadcastTxStatus(data.TxStatus{TxHash: "txHash1", Status: "timeout"})
+
+	wg.Wait()
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestBroadcastEpochStart(t *testing.T) {
+	t.Parallel()
+
+	wg := sync.WaitGroup{}
+	numCalls := uint32(0)
+
+	client := &mocks.RabbitClientStub{
+		PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+			assert.Equal(t, "epochstart", exchange)
+			atomic.AddUint32(&numCalls, 1)
+			wg.Done()
+			return nil
+		},
+	}
+
+	args := createMockArgsRabbitMqPublisher()
+	args.Client = client
+
+	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+	require.Nil(t, err)
+
+	rabbitmq.Run()
+	defer rabbitmq.Close()
+	wg.Add(1)
+
+	rabbitmq.BroadcastEpochStart(data.EpochStart{})
+
+	wg.Wait()
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestBroadcastBlockHeader(t *testing.T) {
+	t.Parallel()
+
+	wg := sync.WaitGroup{}
+	numCalls := uint32(0)
+
+	client := &mocks.RabbitClientStub{
+		PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+			assert.Equal(t, "blockheader", exchange)
+			atomic.AddUint32(&numCalls, 1)
+			wg.Done()
+			return nil
+		},
+	}
+
+	args := createMockArgsRabbitMqPublisher()
+	args.Client = client
+
+	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+	require.Nil(t, err)
+
+	rabbitmq.Run()
+	defer rabbitmq.Close()
+	wg.Add(1)
+
+	rabbitmq.BroadcastBlockHeader(data.BlockHeader{})
+
+	wg.Wait()
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestBroadcastBlockEventsWithOrder(t *testing.T) {
+	t.Parallel()
+
+	numCalls := uint32(0)
+
+	client := &mocks.RabbitClientStub{
+		PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+			atomic.AddUint32(&numCalls, 1)
+			return nil
+		},
+	}
+
+	args := createMockArgsRabbitMqPublisher()
+	args.Client = client
+
+	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+	require.Nil(t, err)
+
+	rabbitmq.Run()
+	defer rabbitmq.Close()
+
+	rabbitmq.BroadcastBlockEventsWithOrder(data.BlockEventsWithOrder{})
+
+	time.Sleep(time.Millisecond * 200)
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestBroadcastSourceStale(t *testing.T) {
+	t.Parallel()
+
+	wg := sync.WaitGroup{}
+	numCalls := uint32(0)
+
+	client := &mocks.RabbitClientStub{
+		PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+			assert.Equal(t, "sourcestale", exchange)
+			atomic.AddUint32(&numCalls, 1)
+			wg.Done()
+			return nil
+		},
+	}
+
+	args := createMockArgsRabbitMqPublisher()
+	args.Client = client
+
+	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+	require.Nil(t, err)
+
+	rabbitmq.Run()
+	defer rabbitmq.Close()
+	wg.Add(1)
+
+	rabbitmq.BroadcastSourceStale(data.SourceStaleEvent{ShardID: 1})
+
+	wg.Wait()
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numCalls))
+}
+
+func TestBroadcast_OptionalExchangeWithoutName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		disable   func(cfg *config.RabbitMQConfig)
+		broadcast func(publisher rabbitmq.PublisherService)
+	}{
+		{
+			name:    "block rewards",
+			disable: func(cfg *config.RabbitMQConfig) { cfg.BlockRewardsExchange.Name = "" },
+			broadcast: func(publisher rabbitmq.PublisherService) {
+				publisher.BroadcastRewards(data.BlockRewards{Hash: "hash1"})
+			},
+		},
+		{
+			name:    "block receipts",
+			disable: func(cfg *config.RabbitMQConfig) { cfg.BlockReceiptsExchange.Name = "" },
+			broadcast: func(publisher rabbitmq.PublisherService) {
+				publisher.BroadcastReceipts(data.BlockReceipts{Hash: "hash1"})
+			},
+		},
+		{
+			name:    "block invalid txs",
+			disable: func(cfg *config.RabbitMQConfig) { cfg.BlockInvalidTxsExchange.Name = "" },
+			broadcast: func(publisher rabbitmq.PublisherService) {
+				publisher.BroadcastInvalidTxs(data.BlockInvalidTxs{Hash: "hash1"})
+			},
+		},
+		{
+			name:    "altered accounts",
+			disable: func(cfg *config.RabbitMQConfig) { cfg.AlteredAccountsExchange.Name = "" },
+			broadcast: func(publisher rabbitmq.PublisherService) {
+				publisher.BroadcastAlteredAccounts(data.BlockAlteredAccounts{Hash: "hash1"})
+			},
+		},
+		{
+			name:    "block header",
+			disable: func(cfg *config.RabbitMQConfig) { cfg.BlockHeaderExchange.Name = "" },
+			broadcast: func(publisher rabbitmq.PublisherService) {
+				publisher.BroadcastBlockHeader(data.BlockHeader{Hash: "hash1"})
+			},
+		},
+		{
+			name:    "epoch start",
+			disable: func(cfg *config.RabbitMQConfig) { cfg.EpochStartExchange.Name = "" },
+			broadcast: func(publisher rabbitmq.PublisherService) {
+				publisher.BroadcastEpochStart(data.EpochStart{Hash: "hash1"})
+			},
+		},
+		{
+			name:    "tx bundles",
+			disable: func(cfg *config.RabbitMQConfig) { cfg.TxBundlesExchange.Name = "" },
+			broadcast: func(publisher rabbitmq.PublisherService) {
+				publisher.BroadcastTxBundles(data.BlockTxBundles{Hash: "hash1"})
+			},
+		},
+		{
+			name:    "tx status",
+			disable: func(cfg *config.RabbitMQConfig) { cfg.TxStatusExchange.Name = "" },
+			broadcast: func(publisher rabbitmq.PublisherService) {
+				publisher.BroadcastTxStatus(data.TxStatus{TxHash: "txHash1"})
+			},
+		},
+		{
+			name:    "source stale",
+			disable: func(cfg *config.RabbitMQConfig) { cfg.SourceStaleExchange.Name = "" },
+			broadcast: func(publisher rabbitmq.PublisherService) {
+				publisher.BroadcastSourceStale(data.SourceStaleEvent{ShardID: 1})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name+" should not publish", func(t *testing.T) {
+			t.Parallel()
+
+			numPublishCalls := uint32(0)
+			args := createMockArgsRabbitMqPublisher()
+			tc.disable(&args.Config)
+			args.Client = &mocks.RabbitClientStub{
+				PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+					atomic.AddUint32(&numPublishCalls, 1)
+					return nil
+				},
+			}
+
+			publisher, err := rabbitmq.NewRabbitMqPublisher(args)
+			require.Nil(t, err)
+
+			publisher.Run()
+			defer publisher.Close()
+
+			tc.broadcast(publisher)
+
+			err = publisher.Drain(context.Background())
+			require.Nil(t, err)
+			require.Equal(t, uint32(0), atomic.LoadUint32(&numPublishCalls))
+		})
+	}
+}
+
+func TestBroadcast_PublishMetrics(t *testing.T) {
+	t.Parallel()
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+
+	numPublishObservations := uint32(0)
+	args := createMockArgsRabbitMqPublisher()
+	args.Client = &mocks.RabbitClientStub{
+		PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+			return errors.New("publish error")
+		},
+	}
+	args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+		ObservePublishCalled: func(sink string, duration time.Duration) {
+			assert.Equal(t, common.RabbitMQSink, sink)
+			atomic.AddUint32(&numPublishObservations, 1)
+		},
+		AddStageErrorCalled: func(stage string) {
+			assert.Equal(t, common.RabbitMQSink, stage)
+			wg.Done()
+		},
+	}
+
+	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+	require.Nil(t, err)
+
+	rabbitmq.Run()
+	defer rabbitmq.Close()
+
+	rabbitmq.BroadcastSourceStale(data.SourceStaleEvent{ShardID: 1})
+
+	wg.Wait()
+
+	assert.Equal(t, uint32(1), atomic.LoadUint32(&numPublishObservations))
+}
+
+func TestBroadcast_TraceContextPropagation(t *testing.T) {
+	t.Parallel()
+
+	_, _ = tracing.NewTracerProvider(config.TracingConfig{})
+	traceParent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+
+	var publishedMsg amqp.Publishing
+	var sentMessages []*azservicebus.Message
+	args := createMockArgsRabbitMqPublisher()
+	args.Config.Topic = "topic"
+	args.Client = &mocks.RabbitClientStub{
+		PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+			publishedMsg = msg
+			wg.Done()
+			return nil
+		},
+	}
+	args.ServiceBus = &mocks.ServiceBusClientStub{
+		SendMessagesCalled: func(ctx context.Context, topic string, messages []*azservicebus.Message) error {
+			sentMessages = messages
+			wg.Done()
+			return nil
+		},
+	}
+
+	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+	require.Nil(t, err)
+
+	rabbitmq.Run()
+	defer rabbitmq.Close()
+
+	rabbitmq.BroadcastBlockEventsWithOrder(data.BlockEventsWithOrder{
+		Hash:         "hash",
+		Events:       []data.Event{{Identifier: "transfer", Address: "addr"}},
+		TraceContext: map[string]string{"traceparent": traceParent},
+	})
+
+	wg.Wait()
+
+	assert.Equal(t, traceParent, publishedMsg.Headers["traceparent"])
+	require.Equal(t, 1, len(sentMessages))
+	assert.Equal(t, traceParent, sentMessages[0].ApplicationProperties["traceparent"])
+}
+
+func TestBroadcastBlockEventsWithOrder_ServiceBusMessages(t *testing.T) {
+	t.Parallel()
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+
+	var sentMessages []*azservicebus.Message
+	args := createMockArgsRabbitMqPublisher()
+	args.Config.Topic = "topic"
+	args.ServiceBus = &mocks.ServiceBusClientStub{
+		SendMessagesCalled: func(ctx context.Context, topic string, messages []*azservicebus.Message) error {
+			assert.Equal(t, "topic", topic)
+			sentMessages = messages
+			wg.Done()
+			return nil
+		},
+	}
+
+	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+	require.Nil(t, err)
+
+	rabbitmq.Run()
+	defer rabbitmq.Close()
+
+	rabbitmq.BroadcastBlockEventsWithOrder(data.BlockEventsWithOrder{
+		Hash: "blockHash",
+		Events: []data.Event{
+			{Identifier: "completedTxEvent", Address: "erd1a", EventIndex: 0},
+			{Identifier: "ESDTNFTCreate", Address: "erd1b", Topics: [][]byte{[]byte("NFT-abcdef"), {1}}, EventIndex: 1},
+			{Identifier: "writeLog", Address: "erd1a", EventIndex: 2},
+			{Identifier: "swap", Address: "erd1c", BlockHash: "blockHash", BlockNonce: 10, ExecutionOrder: 4, EventIndex: 3},
+		},
+	})
+
+	wg.Wait()
+
+	// the message IDs are built from the event index, not from the position among the sent messages
+	require.Len(t, sentMessages, 2)
+	assert.Equal(t, "blockHash-1", *sentMessages[0].MessageID)
+	assert.Equal(t, "NFT-abcdef", *sentMessages[0].SessionID)
+	assert.Equal(t, "blockHash-3", *sentMessages[1].MessageID)
+	assert.Equal(t, "erd1c", *sentMessages[1].SessionID)
+	assert.Equal(t, "swap", sentMessages[1].ApplicationProperties["Identifier"])
+	assert.Equal(t, 4, sentMessages[1].ApplicationProperties["ExecutionOrder"])
+	assert.Equal(t, 3, sentMessages[1].ApplicationProperties["EventIndex"])
+	assert.Contains(t, string(sentMessages[1].Body), `"blockHash":"blockHash","shardId":0,"blockNonce":10`)
+	assert.Contains(t, string(sentMessages[1].Body), `"eventIndex":3`)
+}
+
+func TestBroadcastBlockEventsWithOrder_ServiceBusInvalidReceiverShouldSkipEvent(t *testing.T) {
+	t.Parallel()
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+
+	var sentMessages []*azservicebus.Message
+	args := createMockArgsRabbitMqPublisher()
+	args.Config.Topic = "topic"
+	args.ServiceBus = &mocks.ServiceBusClientStub{
+		SendMessagesCalled: func(ctx context.Context, topic string, messages []*azservicebus.Message) error {
+			sentMessages = messages
+			wg.Done()
+			return nil
+		},
+	}
+
+	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+	require.Nil(t, err)
+
+	rabbitmq.Run()
+	defer rabbitmq.Close()
+
+	receiverInShard0 := make([]byte, 32)
+	receiverInShard0[31] = 4
+	// a malformed receiver is set by the contract and must not stop the publisher
+	rabbitmq.BroadcastBlockEventsWithOrder(data.BlockEventsWithOrder{
+		Hash: "blockHash",
+		Events: []data.Event{
+			{Identifier: "ESDTTransfer", Address: "erd1a", Topics: [][]byte{[]byte("TKN-abcdef"), {}, {1}, {1, 2}}, EventIndex: 0},
+			{Identifier: "ESDTTransfer", Address: "erd1b", Topics: [][]byte{[]byte("TKN-abcdef"), {}, {1}, {}}, EventIndex: 1},
+			{Identifier: "ESDTTransfer", Address: "erd1c", Topics: [][]byte{[]byte("TKN-abcdef"), {}, {1}, receiverInShard0}, EventIndex: 2},
+		},
+	})
+
+	wg.Wait()
+
+	require.Len(t, sentMessages, 1)
+	assert.Equal(t, "blockHash-2", *sentMessages[0].MessageID)
+}
+
+func TestClose(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRabbitMqPublisher()
+
+	rabbitmq, err := rabbitmq.NewRabbitMqPublisher(args)
+	require.Nil(t, err)
+
+	rabbitmq.Run()
+
+	err = rabbitmq.Close()
+	require.Nil(t, err)
+}
+
+func TestDrain(t *testing.T) {
+	t.Parallel()
+
+	t.Run("loop not running should work", func(t *testing.T) {
+		t.Parallel()
+
+		publisher, err := rabbitmq.NewRabbitMqPublisher(createMockArgsRabbitMqPublisher())
+		require.Nil(t, err)
+
+		err = publisher.Drain(context.Background())
+		require.Nil(t, err)
+	})
+
+	t.Run("publishing not finished before deadline should error", func(t *testing.T) {
+		t.Parallel()
+
+		publishStarted := make(chan struct{})
+		releasePublish := make(chan struct{})
+		args := createMockArgsRabbitMqPublisher()
+		args.Client = &mocks.RabbitClientStub{
+			PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+				close(publishStarted)
+				<-releasePublish
+				return nil
+			},
+		}
+
+		publisher, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+
+		publisher.Run()
+		defer publisher.Close()
+		defer close(releasePublish)
+
+		publisher.Broadcast(data.BlockEvents{Hash: "hash1"})
+		<-publishStarted
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err = publisher.Drain(ctx)
+		require.True(t, errors.Is(err, common.ErrUndeliveredData))
+	})
+
+	t.Run("should stop the loop and close the client", func(t *testing.T) {
+		t.Parallel()
+
+		numPublishCalls := uint32(0)
+		wasClosed := uint32(0)
+		args := createMockArgsRabbitMqPublisher()
+		args.Client = &mocks.RabbitClientStub{
+			PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+				atomic.AddUint32(&numPublishCalls, 1)
+				return nil
+			},
+			CloseCalled: func() {
+				atomic.StoreUint32(&wasClosed, 1)
+			},
+		}
+
+		publisher, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+
+		publisher.Run()
+		defer publisher.Close()
+
+		publisher.Broadcast(data.BlockEvents{Hash: "hash1"})
+
+		err = publisher.Drain(context.Background())
+		require.Nil(t, err)
+		require.Equal(t, uint32(1), atomic.LoadUint32(&numPublishCalls))
+		require.Equal(t, uint32(1), atomic.LoadUint32(&wasClosed))
+	})
+
+	t.Run("failed service bus send should be reported as undelivered", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.ServiceBus = &mocks.ServiceBusClientStub{
+			SendMessagesCalled: func(ctx context.Context, topic string, messages []*azservicebus.Message) error {
+				return errors.New("service bus unavailable")
+			},
+		}
+
+		publisher, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+
+		publisher.Run()
+		defer publisher.Close()
+
+		publisher.BroadcastBlockEventsWithOrder(data.BlockEventsWithOrder{
+			Hash:   "hash1",
+			Events: []data.Event{{Identifier: "swap", Address: "erd1a"}},
+		})
+
+		err = publisher.Drain(context.Background())
+		require.True(t, errors.Is(err, common.ErrUndeliveredData))
+	})
+}
+
+func TestBroadcastBlockEventsWithOrder_SlowServiceBus(t *testing.T) {
+	t.Parallel()
+
+	sendStarted := make(chan struct{}, 1)
+	releaseSend := make(chan struct{})
+	numPublishCalls := uint32(0)
+	numSendCalls := uint32(0)
+	args := createMockArgsRabbitMqPublisher()
+	args.Config.ServiceBusQueueSize = 1
+	args.Client = &mocks.RabbitClientStub{
+		PublishCalled: func(exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
+			atomic.AddUint32(&numPublishCalls, 1)
+			return nil
+		},
+	}
+	args.ServiceBus = &mocks.ServiceBusClientStub{
+		SendMessagesCalled: func(ctx context.Context, topic string, messages []*azservicebus.Message) error {
+			atomic.AddUint32(&numSendCalls, 1)
+			sendStarted <- struct{}{}
+			<-releaseSend
+			return nil
+		},
+	}
+
+	publisher, err := rabbitmq.NewRabbitMqPublisher(args)
+	require.Nil(t, err)
+
+	publisher.Run()
+	defer publisher.Close()
+
+	events := []data.Event{{Identifier: "swap", Address: "erd1a"}}
+	publisher.BroadcastBlockEventsWithOrder(data.BlockEventsWithOrder{Hash: "hash1", Events: events})
+	<-sendStarted
+
+	// the first block is being sent, the second one is queued and the third one is dropped
+	publisher.BroadcastBlockEventsWithOrder(data.BlockEventsWithOrder{Hash: "hash2", Events: events})
+	publisher.BroadcastBlockEventsWithOrder(data.BlockEventsWithOrder{Hash: "hash3", Events: events})
+
+	err = publisher.CheckHealth(context.Background())
+	require.Nil(t, err)
+	require.Equal(t, uint32(3), atomic.LoadUint32(&numPublishCalls))
+
+	close(releaseSend)
+
+	err = publisher.Drain(context.Background())
+	require.True(t, errors.Is(err, common.ErrUndeliveredData))
+	require.Equal(t, uint32(2), atomic.LoadUint32(&numSendCalls))
+}
+
+func TestCheckHealth(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disconnected client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRabbitMqPublisher()
+		args.Client = &mocks.RabbitClientStub{
+			IsConnectedCalled: func() bool {
+				return false
+			},
+		}
+
+		publisher, err := rabbitmq.NewRabbitMqPublisher(args)
+		require.Nil(t, err)
+
+		err = publisher.CheckHealth(context.Background())
+		require.Equal(t, rabbitmq.ErrRabbitMqDisconnected, err)
+	})
+
+	t.Run("loop not running should error on timeout", func(t *testing.T) {
+		t.Parallel()
+
+		publisher, err := rabbitmq.NewRabbitMqPublisher(createMockArgsRabbitMqPublisher())
+		require.Nil(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err = publisher.CheckHealth(ctx)
+		require.True(t, errors.Is(err, rabbitmq.ErrPublisherNotResponding))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		publisher, err := rabbitmq.NewRabbitMqPublisher(createMockArgsRabbitMqPublisher())
+		require.Nil(t, err)
+
+		publisher.Run()
+		defer publisher.Close()
+
+		err = publisher.CheckHealth(context.Background())
+		require.Nil(t, err)
+	})
 }
@@ -2,8 +2,10 @@ package rabbitmq
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/multiversx/mx-chain-notifier-go/config"
 	"github.com/streadway/amqp"
 )
 
@@ -18,8 +20,9 @@ const (
 )
 
 type rabbitMqClient struct {
-	url    string
-	pubMut sync.Mutex
+	url        string
+	amqpConfig amqp.Config
+	pubMut     sync.Mutex
 
 	conn *amqp.Connection
 	ch   *amqp.Channel
@@ -28,16 +31,25 @@ type rabbitMqClient struct {
 	chanErr   chan *amqp.Error
 	ackCh     chan uint64
 	nackCh    chan uint64
+
+	// isConnected is 1 while both the connection and the channel are open
+	isConnected uint32
 }
 
 // NewRabbitMQClient creates a new rabbitMQ client instance
-func NewRabbitMQClient(url string) (*rabbitMqClient, error) {
+func NewRabbitMQClient(cfg config.RabbitMQConfig) (*rabbitMqClient, error) {
+	amqpConfig, err := createAMQPConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	rc := &rabbitMqClient{
-		url:    url,
-		pubMut: sync.Mutex{},
+		url:        cfg.Url,
+		amqpConfig: amqpConfig,
+		pubMut:     sync.Mutex{},
 	}
 
-	err := rc.connect()
+	err = rc.connect()
 	if err != nil {
 		return nil, err
 	}
@@ -102,9 +114,9 @@ func (rc *rabbitMqClient) Publish(exchange, key string, mandatory, immediate boo
 	}
 }
 
-// dial will return a rabbitMq connection
+// dial will return a rabbitMq connection, using the same config (including TLS) on every call
 func (rc *rabbitMqClient) dial(url string) (*amqp.Connection, error) {
-	return amqp.Dial(url)
+	return amqp.DialConfig(url, rc.amqpConfig)
 }
 
 // ConnErrChan will return connection error channel
@@ -146,11 +158,20 @@ func (rc *rabbitMqClient) openChannel() error {
 	rc.ch.NotifyClose(rc.chanErr)
 	rc.ackCh, rc.nackCh = rc.ch.NotifyConfirm(make(chan uint64), make(chan uint64))
 
-	return rc.ch.Confirm(false)
+	err = rc.ch.Confirm(false)
+	if err != nil {
+		return err
+	}
+
+	atomic.StoreUint32(&rc.isConnected, 1)
+
+	return nil
 }
 
 // Reconnect will try to reconnect to rabbitmq
 func (rc *rabbitMqClient) Reconnect() {
+	atomic.StoreUint32(&rc.isConnected, 0)
+
 	for {
 		time.Sleep(time.Millisecond * reconnectRetryMs)
 
@@ -166,6 +187,8 @@ func (rc *rabbitMqClient) Reconnect() {
 
 // ReopenChannel will try to reopen communication channel
 func (rc *rabbitMqClient) ReopenChannel() {
+	atomic.StoreUint32(&rc.isConnected, 0)
+
 	for {
 		time.Sleep(time.Millisecond * reconnectRetryMs)
 
@@ -179,8 +202,15 @@ func (rc *rabbitMqClient) ReopenChannel() {
 	}
 }
 
+// IsConnected returns true if the connection and the channel to rabbitMQ are open
+func (rc *rabbitMqClient) IsConnected() bool {
+	return atomic.LoadUint32(&rc.isConnected) == 1
+}
+
 // Close will close rabbitMq client connection
 func (rc *rabbitMqClient) Close() {
+	atomic.StoreUint32(&rc.isConnected, 0)
+
 	err := rc.ch.Close()
 	if err != nil {
 		log.Error("failed to close rabbitMQ channel", "err", err.Error())
@@ -0,0 +1,294 @@
+package rabbitmq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+)
+
+const (
+	serviceBusMetricPrefix = "ServiceBus"
+	sendBatchOperation     = "send_batch"
+	batchMessagesOperation = "batch_messages"
+	sendRetriesOperation   = "send_retries"
+	sendFailuresOperation  = "send_failures"
+
+	maxServiceBusRetryDelay = 10 * time.Second
+)
+
+// ArgsServiceBusClient defines the arguments needed for service bus client creation
+type ArgsServiceBusClient struct {
+	ConnectionString     string
+	SendRetries          uint32
+	RetryDelay           time.Duration
+	StatusMetricsHandler common.StatusMetricsHandler
+}
+
+type serviceBusClient struct {
+	client         *azservicebus.Client
+	mutSenders     sync.Mutex
+	senders        map[string]*azservicebus.Sender
+	sendRetries    uint32
+	retryDelay     time.Duration
+	metricsHandler common.StatusMetricsHandler
+
+	mutLastSendErr sync.RWMutex
+	lastSendErr    error
+}
+
+// NewServiceBusClient creates a new azure service bus client which keeps one long-lived sender per topic
+func NewServiceBusClient(args ArgsServiceBusClient) (*serviceBusClient, error) {
+	if check.IfNil(args.StatusMetricsHandler) {
+		return nil, common.ErrNilStatusMetricsHandler
+	}
+	if args.RetryDelay == 0 {
+		return nil, fmt.Errorf("%w for service bus retry delay", ErrInvalidValue)
+	}
+
+	client, err := azservicebus.NewClientFromConnectionString(args.ConnectionString, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &serviceBusClient{
+		client:         client,
+		senders:        make(map[string]*azservicebus.Sender),
+		sendRetries:    args.SendRetries,
+		retryDelay:     args.RetryDelay,
+		metricsHandler: args.StatusMetricsHandler,
+	}, nil
+}
+
+// SendMessages will split the provided messages in batches and send them to the topic.
+// A failed batch is retried with backoff; every message is expected to carry a deterministic
+// MessageID so that service bus duplicate detection makes the retries safe.
+func (sbc *serviceBusClient) SendMessages(ctx context.Context, topic string, messages []*azservicebus.Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+
+	batch, err := sbc.newMessageBatch(ctx, topic)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for i := 0; i < len(messages); i++ {
+		err = batch.AddMessage(messages[i], nil)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, azservicebus.ErrMessageTooLarge) {
+			return err
+		}
+
+		if batch.NumMessages() == 0 {
+			log.Error("service bus message is too large to be sent in a batch, skipping it",
+				"topic", topic,
+				"index", i,
+			)
+			sbc.metricsHandler.AddCounter(getServiceBusOpID(sendFailuresOperation), 1)
+			lastErr = err
+			continue
+		}
+
+		log.Debug("service bus message batch is full, sending it", "topic", topic, "num messages", batch.NumMessages())
+		err = sbc.sendBatchWithRetry(ctx, topic, batch)
+		if err != nil {
+			lastErr = err
+		}
+
+		batch, err = sbc.newMessageBatch(ctx, topic)
+		if err != nil {
+			return err
+		}
+
+		// the message did not fit in the previous batch, so it has to be added again
+		i--
+	}
+
+	if batch.NumMessages() > 0 {
+		err = sbc.sendBatchWithRetry(ctx, topic, batch)
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+func (sbc *serviceBusClient) newMessageBatch(ctx context.Context, topic string) (*azservicebus.MessageBatch, error) {
+	var batch *azservicebus.MessageBatch
+	err := sbc.sendWithRetry(ctx, topic, func(sender *azservicebus.Sender) error {
+		var errCreate error
+		batch, errCreate = sender.NewMessageBatch(ctx, nil)
+		return errCreate
+	})
+
+	return batch, err
+}
+
+func (sbc *serviceBusClient) sendBatchWithRetry(ctx context.Context, topic string, batch *azservicebus.MessageBatch) error {
+	t := time.Now()
+	err := sbc.sendWithRetry(ctx, topic, func(sender *azservicebus.Sender) error {
+		return sender.SendMessageBatch(ctx, batch, nil)
+	})
+	sbc.metricsHandler.ObservePublish(common.ServiceBusSink, time.Since(t))
+	if err != nil {
+		sbc.metricsHandler.AddStageError(common.ServiceBusSink)
+		log.Error("failed to send service bus message batch", "topic", topic, "num messages", batch.NumMessages(), "err", err.Error())
+		return err
+	}
+
+	sbc.metricsHandler.AddRequest(getServiceBusOpID(sendBatchOperation), time.Since(t))
+	sbc.metricsHandler.AddCounter(getServiceBusOpID(batchMessagesOperation), uint64(batch.NumMessages()))
+
+	return nil
+}
+
+func (sbc *serviceBusClient) sendWithRetry(ctx context.Context, topic string, handler func(sender *azservicebus.Sender) error) error {
+	var err error
+	delay := sbc.retryDelay
+
+	for attempt := uint32(0); attempt <= sbc.sendRetries; attempt++ {
+		if attempt > 0 {
+			log.Warn("retrying service bus operation", "topic", topic, "attempt", attempt, "err", err.Error())
+			sbc.metricsHandler.AddCounter(getServiceBusOpID(sendRetriesOperation), 1)
+
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+
+			delay *= 2
+			if delay > maxServiceBusRetryDelay {
+				delay = maxServiceBusRetryDelay
+			}
+		}
+
+		var sender *azservicebus.Sender
+		sender, err = sbc.getSender(topic)
+		if err == nil {
+			err = handler(sender)
+		}
+		if err == nil {
+			sbc.setLastSendErr(nil)
+			return nil
+		}
+		if !isRetriableServiceBusError(err) {
+			break
+		}
+		if isConnectionLostError(err) {
+			sbc.removeSender(topic)
+		}
+	}
+
+	sbc.metricsHandler.AddCounter(getServiceBusOpID(sendFailuresOperation), 1)
+	sbc.setLastSendErr(err)
+
+	return err
+}
+
+func (sbc *serviceBusClient) setLastSendErr(err error) {
+	sbc.mutLastSendErr.Lock()
+	sbc.lastSendErr = err
+	sbc.mutLastSendErr.Unlock()
+}
+
+// CheckHealth returns an error if the last operation towards service bus failed after all the retries.
+// Service bus does not expose a ping, so the client is considered healthy until a send fails
+func (sbc *serviceBusClient) CheckHealth(_ context.Context) error {
+	sbc.mutLastSendErr.RLock()
+	defer sbc.mutLastSendErr.RUnlock()
+
+	if sbc.lastSendErr != nil {
+		return fmt.Errorf("%w: %s", ErrServiceBusUnavailable, sbc.lastSendErr.Error())
+	}
+
+	return nil
+}
+
+func (sbc *serviceBusClient) getSender(topic string) (*azservicebus.Sender, error) {
+	sbc.mutSenders.Lock()
+	defer sbc.mutSenders.Unlock()
+
+	sender, ok := sbc.senders[topic]
+	if ok {
+		return sender, nil
+	}
+
+	sender, err := sbc.client.NewSender(topic, nil)
+	if err != nil {
+		return nil, err
+	}
+	sbc.senders[topic] = sender
+
+	log.Debug("created service bus sender", "topic", topic)
+
+	return sender, nil
+}
+
+func (sbc *serviceBusClient) removeSender(topic string) {
+	sbc.mutSenders.Lock()
+	defer sbc.mutSenders.Unlock()
+
+	sender, ok := sbc.senders[topic]
+	if !ok {
+		return
+	}
+	delete(sbc.senders, topic)
+
+	err := sender.Close(context.Background())
+	if err != nil {
+		log.Debug("failed to close service bus sender", "topic", topic, "err", err.Error())
+	}
+}
+
+func isRetriableServiceBusError(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var sbErr *azservicebus.Error
+	if errors.As(err, &sbErr) {
+		return sbErr.Code != azservicebus.CodeUnauthorizedAccess
+	}
+
+	return true
+}
+
+func isConnectionLostError(err error) bool {
+	var sbErr *azservicebus.Error
+	return errors.As(err, &sbErr) && sbErr.Code == azservicebus.CodeConnectionLost
+}
+
+func getServiceBusOpID(operation string) string {
+	return fmt.Sprintf("%s-%s", serviceBusMetricPrefix, operation)
+}
+
+// Close will close all the senders and the underlying service bus client
+func (sbc *serviceBusClient) Close() error {
+	sbc.mutSenders.Lock()
+	for topic, sender := range sbc.senders {
+		err := sender.Close(context.Background())
+		if err != nil {
+			log.Error("failed to close service bus sender", "topic", topic, "err", err.Error())
+		}
+	}
+	sbc.senders = make(map[string]*azservicebus.Sender)
+	sbc.mutSenders.Unlock()
+
+	return sbc.client.Close(context.Background())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sbc *serviceBusClient) IsInterfaceNil() bool {
+	return sbc == nil
+}
@@ -0,0 +1,165 @@
+package rabbitmq_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/common"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/rabbitmq"
+	"github.com/stretchr/testify/require"
+)
+
+const testConnectionString = "Endpoint=sb://notifier.servicebus.windows.net/;SharedAccessKeyName=key;SharedAccessKey=secret"
+
+func createMockArgsServiceBusClient() rabbitmq.ArgsServiceBusClient {
+	return rabbitmq.ArgsServiceBusClient{
+		ConnectionString:     testConnectionString,
+		SendRetries:          2,
+		RetryDelay:           time.Millisecond,
+		StatusMetricsHandler: &mocks.StatusMetricsStub{},
+	}
+}
+
+func TestNewServiceBusClient(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil status metrics handler", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsServiceBusClient()
+		args.StatusMetricsHandler = nil
+
+		client, err := rabbitmq.NewServiceBusClient(args)
+		require.True(t, check.IfNil(client))
+		require.Equal(t, common.ErrNilStatusMetricsHandler, err)
+	})
+
+	t.Run("zero retry delay", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsServiceBusClient()
+		args.RetryDelay = 0
+
+		client, err := rabbitmq.NewServiceBusClient(args)
+		require.True(t, check.IfNil(client))
+		require.True(t, errors.Is(err, rabbitmq.ErrInvalidValue))
+	})
+
+	t.Run("empty connection string", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsServiceBusClient()
+		args.ConnectionString = ""
+
+		client, err := rabbitmq.NewServiceBusClient(args)
+		require.True(t, check.IfNil(client))
+		require.Error(t, err)
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		client, err := rabbitmq.NewServiceBusClient(createMockArgsServiceBusClient())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(client))
+		require.Nil(t, client.SendMessages(context.Background(), "topic", nil))
+	})
+}
+
+func TestServiceBusClient_SendWithRetry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("transient failure should retry and reuse the sender", func(t *testing.T) {
+		t.Parallel()
+
+		numRetries := uint64(0)
+		args := createMockArgsServiceBusClient()
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddCounterCalled: func(operation string, value uint64) {
+				if operation == "ServiceBus-send_retries" {
+					atomic.AddUint64(&numRetries, value)
+				}
+			},
+		}
+		client, _ := rabbitmq.NewServiceBusClient(args)
+
+		numCalls := 0
+		var senders []*azservicebus.Sender
+		err := client.SendWithRetry(context.Background(), "topic", func(sender *azservicebus.Sender) error {
+			numCalls++
+			senders = append(senders, sender)
+			if numCalls < 2 {
+				return errors.New("transient error")
+			}
+			return nil
+		})
+		require.Nil(t, err)
+		require.Equal(t, 2, numCalls)
+		require.Equal(t, uint64(1), atomic.LoadUint64(&numRetries))
+		require.True(t, senders[0] == senders[1])
+		require.Equal(t, 1, client.NumSenders())
+	})
+
+	t.Run("should give up after the configured retries", func(t *testing.T) {
+		t.Parallel()
+
+		numFailures := uint64(0)
+		args := createMockArgsServiceBusClient()
+		args.StatusMetricsHandler = &mocks.StatusMetricsStub{
+			AddCounterCalled: func(operation string, value uint64) {
+				if operation == "ServiceBus-send_failures" {
+					atomic.AddUint64(&numFailures, value)
+				}
+			},
+		}
+		client, _ := rabbitmq.NewServiceBusClient(args)
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		err := client.SendWithRetry(context.Background(), "topic", func(sender *azservicebus.Sender) error {
+			numCalls++
+			return expectedErr
+		})
+		require.Equal(t, expectedErr, err)
+		require.Equal(t, 3, numCalls)
+		require.Equal(t, uint64(1), atomic.LoadUint64(&numFailures))
+	})
+
+	t.Run("unauthorized error should not retry", func(t *testing.T) {
+		t.Parallel()
+
+		client, _ := rabbitmq.NewServiceBusClient(createMockArgsServiceBusClient())
+
+		numCalls := 0
+		err := client.SendWithRetry(context.Background(), "topic", func(sender *azservicebus.Sender) error {
+			numCalls++
+			return &azservicebus.Error{Code: azservicebus.CodeUnauthorizedAccess}
+		})
+		require.Error(t, err)
+		require.Equal(t, 1, numCalls)
+	})
+
+	t.Run("connection lost should recreate the sender", func(t *testing.T) {
+		t.Parallel()
+
+		client, _ := rabbitmq.NewServiceBusClient(createMockArgsServiceBusClient())
+
+		var senders []*azservicebus.Sender
+		err := client.SendWithRetry(context.Background(), "topic", func(sender *azservicebus.Sender) error {
+			senders = append(senders, sender)
+			if len(senders) == 1 {
+				return &azservicebus.Error{Code: azservicebus.CodeConnectionLost}
+			}
+			return nil
+		})
+		require.Nil(t, err)
+		require.Len(t, senders, 2)
+		require.False(t, senders[0] == senders[1])
+	})
+}
@@ -9,6 +9,7 @@ import (
 // It makes sure that a duplicated entry is not processed multiple times.
 type LockService interface {
 	IsEventProcessed(ctx context.Context, blockHash string) (bool, error)
+	AddToSet(ctx context.Context, key string, member string) (int64, error)
 	HasConnection(ctx context.Context) bool
 	IsInterfaceNil() bool
 }
@@ -16,6 +17,13 @@ type LockService interface {
 // RedLockClient defines the behaviour of a cache handler component
 type RedLockClient interface {
 	SetEntry(ctx context.Context, key string, value bool, ttl time.Duration) (bool, error)
+	AddToSet(ctx context.Context, key string, member string, ttl time.Duration) (int64, error)
+	SetValueIfNotExists(ctx context.Context, key string, value string, ttl time.Duration) (bool, error)
+	GetValue(ctx context.Context, key string) (string, error)
+	DeleteKey(ctx context.Context, key string) error
+	AddToSortedSet(ctx context.Context, key string, member string, score float64, ttl time.Duration) error
+	GetSortedSetMembersByMaxScore(ctx context.Context, key string, maxScore float64, count int64) ([]string, error)
+	RemoveFromSortedSet(ctx context.Context, key string, member string) (bool, error)
 	Ping(ctx context.Context) (string, error)
 	IsConnected(ctx context.Context) bool
 	IsInterfaceNil() bool
@@ -2,6 +2,7 @@ package redis
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/go-redis/redis/v8"
@@ -28,6 +29,75 @@ func (rc *redisClientWrapper) SetEntry(ctx context.Context, key string, value bo
 	return rc.redis.SetNX(ctx, key, value, ttl).Result()
 }
 
+// AddToSet will add the member to the set stored at key and return the set cardinality.
+// The expiration of the set is refreshed on each call
+func (rc *redisClientWrapper) AddToSet(ctx context.Context, key string, member string, ttl time.Duration) (int64, error) {
+	var card *redis.IntCmd
+	_, err := rc.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SAdd(ctx, key, member)
+		pipe.Expire(ctx, key, ttl)
+		card = pipe.SCard(ctx, key)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return card.Val(), nil
+}
+
+// SetValueIfNotExists will set the value of the key, only if the key does not exist. It returns true if the value was set
+func (rc *redisClientWrapper) SetValueIfNotExists(ctx context.Context, key string, value string, ttl time.Duration) (bool, error) {
+	return rc.redis.SetNX(ctx, key, value, ttl).Result()
+}
+
+// GetValue will return the value of the key, or an empty string if the key does not exist
+func (rc *redisClientWrapper) GetValue(ctx context.Context, key string) (string, error) {
+	value, err := rc.redis.Get(ctx, key).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+
+	return value, err
+}
+
+// DeleteKey will remove the key
+func (rc *redisClientWrapper) DeleteKey(ctx context.Context, key string) error {
+	return rc.redis.Del(ctx, key).Err()
+}
+
+// AddToSortedSet will add the member with the provided score to the sorted set stored at key, if the member
+// does not exist already. The expiration of the sorted set is refreshed on each call
+func (rc *redisClientWrapper) AddToSortedSet(ctx context.Context, key string, member string, score float64, ttl time.Duration) error {
+	_, err := rc.redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZAddNX(ctx, key, &redis.Z{Score: score, Member: member})
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+
+	return err
+}
+
+// GetSortedSetMembersByMaxScore will return at most count members of the sorted set stored at key,
+// having a score lower or equal than maxScore, ordered by score
+func (rc *redisClientWrapper) GetSortedSetMembersByMaxScore(ctx context.Context, key string, maxScore float64, count int64) ([]string, error) {
+	return rc.redis.ZRangeByScore(ctx, key, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatFloat(maxScore, 'f', -1, 64),
+		Count: count,
+	}).Result()
+}
+
+// RemoveFromSortedSet will remove the member of the sorted set stored at key. It returns true if the member was removed
+func (rc *redisClientWrapper) RemoveFromSortedSet(ctx context.Context, key string, member string) (bool, error) {
+	numRemoved, err := rc.redis.ZRem(ctx, key, member).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return numRemoved > 0, nil
+}
+
 // Ping will check if Redis instance is reponding
 func (rc *redisClientWrapper) Ping(ctx context.Context) (string, error) {
 	return rc.redis.Ping(ctx).Result()
@@ -40,6 +40,11 @@ func (r *redlockWrapper) IsEventProcessed(ctx context.Context, blockHash string)
 	return r.client.SetEntry(ctx, blockHash, true, r.ttl)
 }
 
+// AddToSet adds the member to the set stored at key and returns the number of members in the set
+func (r *redlockWrapper) AddToSet(ctx context.Context, key string, member string) (int64, error) {
+	return r.client.AddToSet(ctx, key, member, r.ttl)
+}
+
 // HasConnection returns true if the redis client is connected
 func (r *redlockWrapper) HasConnection(ctx context.Context) bool {
 	return r.client.IsConnected(ctx)
@@ -104,6 +104,30 @@ func TestRedlockWrapper_IsBlockProcessed(t *testing.T) {
 	})
 }
 
+func TestRedlockWrapper_AddToSet(t *testing.T) {
+	t.Parallel()
+
+	wasCalled := false
+	args := createMockRedlockWrapperArgs()
+	args.Client = &mocks.RedisClientStub{
+		AddToSetCalled: func(key string, member string, ttl time.Duration) (int64, error) {
+			wasCalled = true
+			assert.Equal(t, "key", key)
+			assert.Equal(t, "member", member)
+			assert.Equal(t, 30*time.Minute, ttl)
+			return 2, nil
+		},
+	}
+
+	redlock, err := redis.NewRedlockWrapper(args)
+	require.Nil(t, err)
+
+	size, err := redlock.AddToSet(context.Background(), "key", "member")
+	require.Nil(t, err)
+	require.Equal(t, int64(2), size)
+	require.True(t, wasCalled)
+}
+
 func TestRedlockWrapper_HasConnection(t *testing.T) {
 	t.Parallel()
 
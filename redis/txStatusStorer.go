@@ -0,0 +1,138 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+)
+
+const (
+	txStatusTrackedTxPrefix  = "txStatusTracker:tx:"
+	txStatusFinalClaimPrefix = "txStatusTracker:final:"
+	txStatusTrackerDeadlines = "txStatusTracker:deadlines"
+)
+
+// ArgsTxStatusStorer defines the arguments needed for creating a redis tx status storer
+type ArgsTxStatusStorer struct {
+	Client RedLockClient
+	TTL    time.Duration
+}
+
+type txStatusStorer struct {
+	client RedLockClient
+	ttl    time.Duration
+}
+
+// NewTxStatusStorer creates a storer which keeps the tracked transactions in redis, so that the tracker
+// state is shared by all the notifier instances. Each tracked transaction and each final status claim is
+// kept in its own key, expiring after the TTL, while the deadlines of the pending transactions are kept
+// in a sorted set, so that only the expired transactions are scanned
+func NewTxStatusStorer(args ArgsTxStatusStorer) (*txStatusStorer, error) {
+	if check.IfNil(args.Client) {
+		return nil, ErrNilRedlockClient
+	}
+	if args.TTL == 0 {
+		return nil, fmt.Errorf("%w for TTL", ErrZeroValueReceived)
+	}
+
+	return &txStatusStorer{
+		client: args.Client,
+		ttl:    args.TTL,
+	}, nil
+}
+
+// Track stores the pending transaction together with its deadline, if it is not already tracked
+func (ts *txStatusStorer) Track(ctx context.Context, entry data.TrackedTx, deadlineMs int64) error {
+	entryBytes, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	wasSet, err := ts.client.SetValueIfNotExists(ctx, txStatusTrackedTxPrefix+entry.TxHash, string(entryBytes), ts.ttl)
+	if err != nil || !wasSet {
+		return err
+	}
+
+	return ts.client.AddToSortedSet(ctx, txStatusTrackerDeadlines, entry.TxHash, float64(deadlineMs), ts.ttl)
+}
+
+// ClaimFinalStatus atomically marks the transaction as having a final status. It returns true only for
+// the first caller, across all the notifier instances
+func (ts *txStatusStorer) ClaimFinalStatus(ctx context.Context, txHash string) (bool, error) {
+	return ts.client.SetEntry(ctx, txStatusFinalClaimPrefix+txHash, true, ts.ttl)
+}
+
+// Remove removes the tracked transaction and returns it, or nil if it is not tracked
+func (ts *txStatusStorer) Remove(ctx context.Context, txHash string) (*data.TrackedTx, error) {
+	_, err := ts.client.RemoveFromSortedSet(ctx, txStatusTrackerDeadlines, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.removeTrackedTx(ctx, txHash)
+}
+
+// PopExpired removes and returns at most maxNum tracked transactions with the deadline before nowMs. Each
+// expired transaction is returned to a single caller, across all the notifier instances
+func (ts *txStatusStorer) PopExpired(ctx context.Context, nowMs int64, maxNum int) ([]*data.TrackedTx, error) {
+	txHashes, err := ts.client.GetSortedSetMembersByMaxScore(ctx, txStatusTrackerDeadlines, float64(nowMs), int64(maxNum))
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*data.TrackedTx, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		wasRemoved, errRemove := ts.client.RemoveFromSortedSet(ctx, txStatusTrackerDeadlines, txHash)
+		if errRemove != nil {
+			return entries, errRemove
+		}
+		if !wasRemoved {
+			continue
+		}
+
+		entry, errRemove := ts.removeTrackedTx(ctx, txHash)
+		if errRemove != nil {
+			log.Warn("could not get expired transaction", "tx hash", txHash, "err", errRemove.Error())
+		}
+		if entry == nil {
+			entry = &data.TrackedTx{TxHash: txHash}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (ts *txStatusStorer) removeTrackedTx(ctx context.Context, txHash string) (*data.TrackedTx, error) {
+	key := txStatusTrackedTxPrefix + txHash
+	value, err := ts.client.GetValue(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if value == "" {
+		return nil, nil
+	}
+
+	err = ts.client.DeleteKey(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	entry := &data.TrackedTx{}
+	err = json.Unmarshal([]byte(value), entry)
+	if err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ts *txStatusStorer) IsInterfaceNil() bool {
+	return ts == nil
+}
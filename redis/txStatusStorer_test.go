@@ -0,0 +1,139 @@
+package redis_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/data"
+	"github.com/multiversx/mx-chain-notifier-go/mocks"
+	"github.com/multiversx/mx-chain-notifier-go/redis"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockTxStatusStorerArgs() redis.ArgsTxStatusStorer {
+	return redis.ArgsTxStatusStorer{
+		Client: mocks.NewRedisClientMock(),
+		TTL:    time.Minute,
+	}
+}
+
+func TestNewTxStatusStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil redlock client, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTxStatusStorerArgs()
+		args.Client = nil
+
+		storer, err := redis.NewTxStatusStorer(args)
+		require.True(t, check.IfNil(storer))
+		require.Equal(t, redis.ErrNilRedlockClient, err)
+	})
+
+	t.Run("zero ttl, should fail", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockTxStatusStorerArgs()
+		args.TTL = 0
+
+		storer, err := redis.NewTxStatusStorer(args)
+		require.True(t, check.IfNil(storer))
+		require.True(t, errors.Is(err, redis.ErrZeroValueReceived))
+	})
+
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := redis.NewTxStatusStorer(createMockTxStatusStorerArgs())
+		require.Nil(t, err)
+		require.False(t, check.IfNil(storer))
+	})
+}
+
+func TestTxStatusStorer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should track, remove and pop the expired transactions", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := redis.NewTxStatusStorer(createMockTxStatusStorerArgs())
+		require.Nil(t, err)
+
+		ctx := context.Background()
+		entry1 := data.TrackedTx{TxHash: "txHash1", Sender: "sender1", Receiver: "receiver1", FirstSeenMs: 5}
+		entry2 := data.TrackedTx{TxHash: "txHash2", Sender: "sender2", FirstSeenMs: 6}
+		entry3 := data.TrackedTx{TxHash: "txHash3", FirstSeenMs: 7}
+
+		require.Nil(t, storer.Track(ctx, entry1, 10))
+		require.Nil(t, storer.Track(ctx, entry2, 20))
+		require.Nil(t, storer.Track(ctx, entry3, 30))
+		// already tracked, the first entry and deadline are kept
+		require.Nil(t, storer.Track(ctx, data.TrackedTx{TxHash: "txHash1"}, 5))
+
+		removed, err := storer.Remove(ctx, "txHash2")
+		require.Nil(t, err)
+		require.Equal(t, &entry2, removed)
+
+		removed, err = storer.Remove(ctx, "txHash2")
+		require.Nil(t, err)
+		require.Nil(t, removed)
+
+		entries, err := storer.PopExpired(ctx, 25, 10)
+		require.Nil(t, err)
+		require.Equal(t, []*data.TrackedTx{&entry1}, entries)
+
+		entries, err = storer.PopExpired(ctx, 25, 10)
+		require.Nil(t, err)
+		require.Equal(t, 0, len(entries))
+
+		entries, err = storer.PopExpired(ctx, 30, 10)
+		require.Nil(t, err)
+		require.Equal(t, []*data.TrackedTx{&entry3}, entries)
+	})
+
+	t.Run("expired transaction should be popped by a single caller", func(t *testing.T) {
+		t.Parallel()
+
+		numRemoveCalls := 0
+		args := createMockTxStatusStorerArgs()
+		args.Client = &mocks.RedisClientStub{
+			GetSortedSetMembersByMaxScoreCalled: func(key string, maxScore float64, count int64) ([]string, error) {
+				require.Equal(t, float64(25), maxScore)
+				require.Equal(t, int64(10), count)
+				return []string{"txHash1", "txHash2"}, nil
+			},
+			RemoveFromSortedSetCalled: func(key string, member string) (bool, error) {
+				numRemoveCalls++
+				// txHash2 was popped by another instance
+				return member == "txHash1", nil
+			},
+		}
+
+		storer, err := redis.NewTxStatusStorer(args)
+		require.Nil(t, err)
+
+		entries, err := storer.PopExpired(context.Background(), 25, 10)
+		require.Nil(t, err)
+		require.Equal(t, []*data.TrackedTx{{TxHash: "txHash1"}}, entries)
+		require.Equal(t, 2, numRemoveCalls)
+	})
+
+	t.Run("final status should be claimed once", func(t *testing.T) {
+		t.Parallel()
+
+		storer, err := redis.NewTxStatusStorer(createMockTxStatusStorerArgs())
+		require.Nil(t, err)
+
+		claimed, err := storer.ClaimFinalStatus(context.Background(), "txHash1")
+		require.Nil(t, err)
+		require.True(t, claimed)
+
+		claimed, err = storer.ClaimFinalStatus(context.Background(), "txHash1")
+		require.Nil(t, err)
+		require.False(t, claimed)
+	})
+}
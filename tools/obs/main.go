@@ -3,7 +3,6 @@ package main
 import (
 	"fmt"
 
-	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
@@ -34,8 +33,8 @@ func main() {
 	fmt.Println(data)
 }
 
-func getShardV1Data() *outport.ArgsSaveBlockData {
-	return &outport.ArgsSaveBlockData{
+func getShardV1Data() *notifierData.ArgsSaveBlockData {
+	return &notifierData.ArgsSaveBlockData{
 		HeaderHash: []byte("hash2"),
 		Body: &block.Body{
 			MiniBlocks: []*block.MiniBlock{
@@ -54,22 +53,22 @@ func getShardV1Data() *outport.ArgsSaveBlockData {
 		SignersIndexes:         []uint64{},
 		NotarizedHeadersHashes: []string{},
 		HeaderGasConsumption:   outport.HeaderGasConsumption{},
-		TransactionsPool: &outport.Pool{
-			Txs: map[string]data.TransactionHandlerWithGasUsedAndFee{
-				"txhash1": &outport.TransactionHandlerWithGasAndFee{
+		TransactionsPool: &notifierData.TransactionsPool{
+			Txs: map[string]*notifierData.NodeTransaction{
+				"txhash1": {
 					TransactionHandler: &transaction.Transaction{
 						Nonce: 1,
 					},
 				},
 			},
-			Scrs: map[string]data.TransactionHandlerWithGasUsedAndFee{
-				"scrHash1": &outport.TransactionHandlerWithGasAndFee{
-					TransactionHandler: &transaction.Transaction{
+			Scrs: map[string]*notifierData.NodeSmartContractResult{
+				"scrHash1": {
+					TransactionHandler: &smartContractResult.SmartContractResult{
 						Nonce: 1,
 					},
 				},
 			},
-			Logs: []*data.LogData{
+			Logs: []*notifierData.LogData{
 				{
 					LogHandler: &transaction.Log{
 						Address: []byte("logaddr1"),
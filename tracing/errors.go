@@ -0,0 +1,9 @@
+package tracing
+
+import "errors"
+
+// ErrEmptyEndpoint signals that an empty OTLP endpoint was provided
+var ErrEmptyEndpoint = errors.New("empty tracing endpoint")
+
+// ErrInvalidSamplingRatio signals that the provided sampling ratio is not between 0 and 1
+var ErrInvalidSamplingRatio = errors.New("invalid tracing sampling ratio")
@@ -0,0 +1,87 @@
+package tracing
+
+import (
+	"context"
+
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+var log = logger.GetOrCreate("tracing")
+
+const serviceNameAttribute = "service.name"
+
+type tracerProvider struct {
+	provider *sdktrace.TracerProvider
+}
+
+// NewTracerProvider registers the W3C trace context propagator and, if tracing is enabled,
+// a tracer provider exporting the spans via OTLP over HTTP. If tracing is disabled, the spans
+// are not recorded, while the received trace context is still propagated
+func NewTracerProvider(cfg config.TracingConfig) (*tracerProvider, error) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	if !cfg.Enabled {
+		return &tracerProvider{}, nil
+	}
+
+	err := checkConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	options := []otlptracehttp.Option{
+		otlptracehttp.WithEndpoint(cfg.Endpoint),
+	}
+	if cfg.Insecure {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	exporter, err := otlptracehttp.New(context.Background(), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SamplingRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String(serviceNameAttribute, cfg.ServiceName))),
+	)
+	otel.SetTracerProvider(provider)
+
+	log.Info("tracing enabled", "endpoint", cfg.Endpoint, "sampling ratio", cfg.SamplingRatio)
+
+	return &tracerProvider{
+		provider: provider,
+	}, nil
+}
+
+func checkConfig(cfg config.TracingConfig) error {
+	if cfg.Endpoint == "" {
+		return ErrEmptyEndpoint
+	}
+	if cfg.SamplingRatio < 0 || cfg.SamplingRatio > 1 {
+		return ErrInvalidSamplingRatio
+	}
+
+	return nil
+}
+
+// Close flushes the pending spans and stops the exporter
+func (tp *tracerProvider) Close() error {
+	if tp.provider == nil {
+		return nil
+	}
+
+	return tp.provider.Shutdown(context.Background())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tp *tracerProvider) IsInterfaceNil() bool {
+	return tp == nil
+}
@@ -0,0 +1,67 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/multiversx/mx-chain-notifier-go"
+
+	// BlockHashAttribute defines the span attribute holding the hash of the processed block
+	BlockHashAttribute = "block.hash"
+)
+
+// StartSpan starts a span as a child of the span from the provided context, if any.
+// The block hash is attached as attribute, if not empty
+func StartSpan(ctx context.Context, spanName string, blockHash string) (context.Context, trace.Span) {
+	ctx, span := otel.Tracer(instrumentationName).Start(ctx, spanName)
+	if blockHash != "" {
+		span.SetAttributes(attribute.String(BlockHashAttribute, blockHash))
+	}
+
+	return ctx, span
+}
+
+// SetBlockHash attaches the block hash attribute to the span, for spans started before the hash is known
+func SetBlockHash(span trace.Span, blockHash string) {
+	span.SetAttributes(attribute.String(BlockHashAttribute, blockHash))
+}
+
+// RecordError marks the span as failed, if the provided error is not nil
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// InjectContext returns the trace context of the provided context as a string map,
+// or nil if there is no trace context to propagate
+func InjectContext(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+
+	return carrier
+}
+
+// ExtractContext returns a copy of the provided context holding the trace context from the provided string map
+func ExtractContext(ctx context.Context, traceContext map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(traceContext))
+}
+
+// ExtractContextFromHeaders returns a context holding the trace context from the provided http headers
+func ExtractContextFromHeaders(ctx context.Context, headers http.Header) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.HeaderCarrier(headers))
+}
@@ -0,0 +1,111 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-notifier-go/config"
+	"github.com/multiversx/mx-chain-notifier-go/tracing"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// the tests are not run in parallel since they change the global tracer provider and propagator
+
+const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestNewTracerProvider(t *testing.T) {
+	t.Run("disabled tracing should work", func(t *testing.T) {
+		tp, err := tracing.NewTracerProvider(config.TracingConfig{})
+		require.Nil(t, err)
+		require.False(t, check.IfNil(tp))
+		require.Nil(t, tp.Close())
+	})
+
+	t.Run("empty endpoint should error", func(t *testing.T) {
+		tp, err := tracing.NewTracerProvider(config.TracingConfig{
+			Enabled:       true,
+			SamplingRatio: 1,
+		})
+		require.True(t, check.IfNil(tp))
+		require.Equal(t, tracing.ErrEmptyEndpoint, err)
+	})
+
+	t.Run("invalid sampling ratio should error", func(t *testing.T) {
+		tp, err := tracing.NewTracerProvider(config.TracingConfig{
+			Enabled:       true,
+			Endpoint:      "localhost:4318",
+			SamplingRatio: 1.5,
+		})
+		require.True(t, check.IfNil(tp))
+		require.Equal(t, tracing.ErrInvalidSamplingRatio, err)
+	})
+
+	t.Run("enabled tracing should work", func(t *testing.T) {
+		defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+		tp, err := tracing.NewTracerProvider(config.TracingConfig{
+			Enabled:       true,
+			Endpoint:      "localhost:4318",
+			Insecure:      true,
+			SamplingRatio: 0.5,
+			ServiceName:   "notifier",
+		})
+		require.Nil(t, err)
+		require.False(t, check.IfNil(tp))
+		require.Nil(t, tp.Close())
+	})
+}
+
+func TestTraceContextPropagation(t *testing.T) {
+	_, _ = tracing.NewTracerProvider(config.TracingConfig{})
+
+	headers := http.Header{}
+	headers.Set("traceparent", traceParent)
+	ctx := tracing.ExtractContextFromHeaders(context.Background(), headers)
+	spanContext := trace.SpanContextFromContext(ctx)
+	require.True(t, spanContext.IsValid())
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spanContext.TraceID().String())
+
+	traceContext := tracing.InjectContext(ctx)
+	assert.Equal(t, traceParent, traceContext["traceparent"])
+
+	ctx = tracing.ExtractContext(context.Background(), traceContext)
+	assert.Equal(t, spanContext, trace.SpanContextFromContext(ctx))
+
+	assert.Nil(t, tracing.InjectContext(context.Background()))
+}
+
+func TestStartSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	ctx, parent := tracing.StartSpan(context.Background(), "parent", "")
+	_, child := tracing.StartSpan(ctx, "child", "hash")
+	tracing.RecordError(child, errors.New("expected error"))
+	tracing.RecordError(child, nil)
+	child.End()
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Equal(t, 2, len(spans))
+
+	childSpan := spans[0]
+	assert.Equal(t, "child", childSpan.Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), childSpan.Parent().SpanID())
+	assert.Equal(t, []attribute.KeyValue{attribute.String(tracing.BlockHashAttribute, "hash")}, childSpan.Attributes())
+	assert.Equal(t, codes.Error, childSpan.Status().Code)
+	assert.Equal(t, "expected error", childSpan.Status().Description)
+
+	assert.Empty(t, spans[1].Attributes())
+}